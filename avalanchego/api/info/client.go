@@ -9,7 +9,9 @@ import (
 	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/rpc"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 )
@@ -29,6 +31,7 @@ type Client interface {
 	IsBootstrapped(context.Context, string, ...rpc.Option) (bool, error)
 	Upgrades(context.Context, ...rpc.Option) (*upgrade.Config, error)
 	Uptime(context.Context, ...rpc.Option) (*UptimeResponse, error)
+	UptimeHistory(context.Context, ids.NodeID, uint64, uint64, ...rpc.Option) ([]uptime.EpochUptime, error)
 	GetVMs(context.Context, ...rpc.Option) (map[ids.ID][]string, error)
 }
 
@@ -110,6 +113,16 @@ func (c *client) Uptime(ctx context.Context, options ...rpc.Option) (*UptimeResp
 	return res, err
 }
 
+func (c *client) UptimeHistory(ctx context.Context, nodeID ids.NodeID, fromEpoch uint64, toEpoch uint64, options ...rpc.Option) ([]uptime.EpochUptime, error) {
+	res := &UptimeResponse{}
+	err := c.requester.SendRequest(ctx, "info.uptime", &UptimeArgs{
+		NodeID:    nodeID,
+		FromEpoch: json.Uint64(fromEpoch),
+		ToEpoch:   json.Uint64(toEpoch),
+	}, res, options...)
+	return res.Epochs, err
+}
+
 func (c *client) GetVMs(ctx context.Context, options ...rpc.Option) (map[ids.ID][]string, error) {
 	res := &GetVMsReply{}
 	err := c.requester.SendRequest(ctx, "info.getVMs", struct{}{}, res, options...)
@@ -18,6 +18,7 @@ import (
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
+	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/upgrade"
 	"github.com/ava-labs/avalanchego/utils"
@@ -121,6 +122,7 @@ type Info struct {
 	chainManager chains.Manager
 	vmManager    vms.Manager
 	benchlist    benchlist.Manager
+	uptimes      uptime.History
 }
 
 type Parameters struct {
@@ -144,6 +146,7 @@ func NewService(
 	myIP *utils.Atomic[netip.AddrPort],
 	network network.Network,
 	benchlist benchlist.Manager,
+	uptimes uptime.History,
 ) (http.Handler, error) {
 	server := rpc.NewServer()
 	codec := json.NewCodec()
@@ -159,6 +162,7 @@ func NewService(
 			myIP:         myIP,
 			networking:   network,
 			benchlist:    benchlist,
+			uptimes:      uptimes,
 		},
 		"info",
 	)
@@ -374,6 +378,15 @@ func (i *Info) Upgrades(_ *http.Request, _ *struct{}, reply *upgrade.Config) err
 	return nil
 }
 
+// UptimeArgs are the arguments for calling Uptime
+type UptimeArgs struct {
+	// If NodeID is set, the uptimes of NodeID recorded by this node in the
+	// epochs between FromEpoch and ToEpoch are returned as well
+	NodeID    ids.NodeID  `json:"nodeID"`
+	FromEpoch json.Uint64 `json:"fromEpoch"`
+	ToEpoch   json.Uint64 `json:"toEpoch"`
+}
+
 // UptimeResponse are the results from calling Uptime
 type UptimeResponse struct {
 	// RewardingStakePercentage shows what percent of network stake thinks we're
@@ -389,14 +402,27 @@ type UptimeResponse struct {
 	// counted (40*weight) in WeightedAveragePercentage but not in
 	// RewardingStakePercentage since 40 < 85
 	WeightedAveragePercentage json.Float64 `json:"weightedAveragePercentage"`
+
+	// Epochs are the uptimes of the requested node in each epoch, see
+	// platform.getValidatorUptimeHistory
+	Epochs []uptime.EpochUptime `json:"epochs,omitempty"`
 }
 
-func (i *Info) Uptime(_ *http.Request, _ *struct{}, reply *UptimeResponse) error {
+func (i *Info) Uptime(_ *http.Request, args *UptimeArgs, reply *UptimeResponse) error {
 	i.log.Debug("API called",
 		zap.String("service", "info"),
 		zap.String("method", "uptime"),
+		zap.Stringer("nodeID", args.NodeID),
 	)
 
+	if args.NodeID != ids.EmptyNodeID {
+		epochs, err := i.uptimes.GetUptimeHistory(args.NodeID, uint64(args.FromEpoch), uint64(args.ToEpoch))
+		if err != nil {
+			return fmt.Errorf("couldn't get uptime history: %w", err)
+		}
+		reply.Epochs = epochs
+	}
+
 	result, err := i.networking.NodeUptime()
 	if err != nil {
 		return fmt.Errorf("couldn't get node uptime: %w", err)
@@ -17,19 +17,43 @@ import (
 var (
 	//go:embed checkpoints.json
 	checkpointsPerNetworkJSON []byte
+	//go:embed checkpoint_heights.json
+	checkpointHeightsPerNetworkJSON []byte
 
-	checkpointsPerNetwork map[string]map[ids.ID]set.Set[ids.ID]
+	checkpointsPerNetwork       map[string]map[ids.ID]set.Set[ids.ID]
+	checkpointHeightsPerNetwork map[string]map[ids.ID]Checkpoints
 )
 
+// Checkpoints maps the heights of blocks accepted on a chain to their IDs.
+//
+// The checkpoints are generated by genesis/generate/checkpoints.
+type Checkpoints map[uint64]ids.ID
+
 func init() {
 	if err := json.Unmarshal(checkpointsPerNetworkJSON, &checkpointsPerNetwork); err != nil {
 		panic(fmt.Sprintf("failed to decode checkpoints.json: %v", err))
 	}
+	if err := json.Unmarshal(checkpointHeightsPerNetworkJSON, &checkpointHeightsPerNetwork); err != nil {
+		panic(fmt.Sprintf("failed to decode checkpoint_heights.json: %v", err))
+	}
 }
 
 // GetCheckpoints returns all known checkpoints for the chain on the requested
-// network.
+// network, with or without their heights.
 func GetCheckpoints(networkID uint32, chainID ids.ID) set.Set[ids.ID] {
 	networkName := constants.NetworkIDToNetworkName[networkID]
-	return checkpointsPerNetwork[networkName][chainID]
+	checkpoints := GetCheckpointHeights(networkID, chainID)
+	blkIDs := set.NewSet[ids.ID](len(checkpoints))
+	blkIDs.Union(checkpointsPerNetwork[networkName][chainID])
+	for _, blkID := range checkpoints {
+		blkIDs.Add(blkID)
+	}
+	return blkIDs
+}
+
+// GetCheckpointHeights returns the known checkpoints for the chain on the
+// requested network whose heights are known.
+func GetCheckpointHeights(networkID uint32, chainID ids.ID) Checkpoints {
+	networkName := constants.NetworkIDToNetworkName[networkID]
+	return checkpointHeightsPerNetwork[networkName][chainID]
 }
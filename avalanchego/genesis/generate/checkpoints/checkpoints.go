@@ -0,0 +1,299 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/perms"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/wallet/chain/x/builder"
+
+	pblock "github.com/ava-labs/avalanchego/vms/platformvm/block"
+	proposerblock "github.com/ava-labs/avalanchego/vms/proposervm/block"
+)
+
+// The number is the 9th field of the header of a C-Chain block, as in Ethereum.
+const cChainNumberField = 8
+
+var (
+	errNotAccepted        = errors.New("checkpoint isn't accepted by the node")
+	errHeightMismatch     = errors.New("checkpoint height mismatch")
+	errUnknownChain       = errors.New("unknown chain")
+	errInvalidCChainBlock = errors.New("invalid C-Chain block")
+)
+
+// checkpointsFile is the content of genesis/checkpoint_heights.json.
+type checkpointsFile map[string]map[ids.ID]genesis.Checkpoints
+
+func readCheckpointsFile(path string) (checkpointsFile, error) {
+	bytes, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return checkpointsFile{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoints checkpointsFile
+	if err := json.Unmarshal(bytes, &checkpoints); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	if checkpoints == nil {
+		checkpoints = checkpointsFile{}
+	}
+	return checkpoints, nil
+}
+
+func (f checkpointsFile) write(path string) error {
+	bytes, err := json.MarshalIndent(f, "", "\t")
+	if err != nil {
+		return fmt.Errorf("failed to marshal checkpoints: %w", err)
+	}
+	return perms.WriteFile(path, append(bytes, '\n'), perms.ReadWrite)
+}
+
+// updateCheckpoints adds the blocks accepted after the last checkpoints of the
+// [chains] of network [networkName] to [checkpoints], writing them to
+// [checkpointsPath] after each chain.
+func updateCheckpoints(
+	ctx context.Context,
+	chains []*chainIndex,
+	checkpoints checkpointsFile,
+	networkName string,
+	checkpointsPath string,
+	interval uint64,
+	maxNumCheckpoints uint64,
+) error {
+	if checkpoints[networkName] == nil {
+		checkpoints[networkName] = make(map[ids.ID]genesis.Checkpoints)
+	}
+	networkCheckpoints := checkpoints[networkName]
+	for _, chain := range chains {
+		if networkCheckpoints[chain.chainID] == nil {
+			networkCheckpoints[chain.chainID] = make(genesis.Checkpoints)
+		}
+		numAdded, err := chain.update(ctx, networkCheckpoints[chain.chainID], interval, maxNumCheckpoints)
+		if err != nil {
+			return fmt.Errorf("failed to fetch %s %s-chain checkpoints: %w", networkName, chain.alias, err)
+		}
+		if err := checkpoints.write(checkpointsPath); err != nil {
+			return fmt.Errorf("failed to write checkpoints: %w", err)
+		}
+		log.Printf("added %d %s-chain checkpoints, %d in total", numAdded, chain.alias, len(networkCheckpoints[chain.chainID]))
+	}
+	return nil
+}
+
+// verifyCheckpoints returns an error for every checkpoint of a network that
+// isn't accepted by the node at its height, or whose chain isn't one of the
+// [chains] of the network.
+func verifyCheckpoints(ctx context.Context, chains []*chainIndex, checkpoints map[ids.ID]genesis.Checkpoints) error {
+	chainIDs := set.NewSet[ids.ID](len(chains))
+	for _, chain := range chains {
+		chainIDs.Add(chain.chainID)
+	}
+	var errs []error
+	for chainID := range checkpoints {
+		if !chainIDs.Contains(chainID) {
+			errs = append(errs, fmt.Errorf("%w %s", errUnknownChain, chainID))
+		}
+	}
+
+	for _, chain := range chains {
+		if err := chain.verify(ctx, checkpoints[chain.chainID]); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		log.Printf("verified %d %s-chain checkpoints", len(checkpoints[chain.chainID]), chain.alias)
+	}
+	return errors.Join(errs...)
+}
+
+// chainIndex is the index API of a chain of the primary network.
+type chainIndex struct {
+	alias   string
+	chainID ids.ID
+	client  indexer.Client
+	// parseHeight returns the height of a block of the VM of the chain.
+	parseHeight func([]byte) (uint64, error)
+}
+
+// networkChains returns the index APIs of the P-Chain, X-Chain and C-Chain of
+// the network with [networkID], served by the node at [uri]. The IDs of the
+// chains are those of the genesis embedded in the node.
+func networkChains(networkID uint32, uri string) ([]*chainIndex, error) {
+	genesisBytes, _, err := genesis.FromConfig(genesis.GetConfig(networkID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build genesis of network %d: %w", networkID, err)
+	}
+	xChainTx, err := genesis.VMGenesis(genesisBytes, constants.AVMID)
+	if err != nil {
+		return nil, err
+	}
+	cChainTx, err := genesis.VMGenesis(genesisBytes, constants.EVMID)
+	if err != nil {
+		return nil, err
+	}
+
+	newChainIndex := func(alias string, chainID ids.ID, parseHeight func([]byte) (uint64, error)) *chainIndex {
+		return &chainIndex{
+			alias:       alias,
+			chainID:     chainID,
+			client:      indexer.NewClient(fmt.Sprintf("%s/ext/index/%s/block", uri, alias)),
+			parseHeight: parseHeight,
+		}
+	}
+	return []*chainIndex{
+		newChainIndex("P", constants.PlatformChainID, pChainBlockHeight),
+		newChainIndex("X", xChainTx.ID(), xChainBlockHeight),
+		newChainIndex("C", cChainTx.ID(), cChainBlockHeight),
+	}, nil
+}
+
+// height returns the height of the block with [bytes], as stored by the
+// indexer: either a proposervm block wrapping a block of the VM, or a block of
+// the VM accepted before the activation of the proposervm.
+func (c *chainIndex) height(bytes []byte) (uint64, error) {
+	if blk, err := proposerblock.ParseWithoutVerification(bytes); err == nil {
+		bytes = blk.Block()
+	}
+	return c.parseHeight(bytes)
+}
+
+// update adds to [checkpoints] a block every [interval] blocks accepted after
+// the last checkpoint, and returns the number of checkpoints added.
+//
+// If [interval] is zero, the interval of the last checkpoints is kept. If
+// there are none, it is chosen so that there are at most [maxNumCheckpoints].
+func (c *chainIndex) update(
+	ctx context.Context,
+	checkpoints genesis.Checkpoints,
+	interval uint64,
+	maxNumCheckpoints uint64,
+) (int, error) {
+	// If there haven't been any blocks accepted, this will return an error.
+	_, lastIndex, err := c.client.GetLastAccepted(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	heights := slices.Sorted(maps.Keys(checkpoints))
+	if interval == 0 && len(heights) >= 2 {
+		interval = heights[len(heights)-1] - heights[len(heights)-2]
+	}
+	if interval == 0 {
+		// interval is rounded up to ensure that the number of checkpoints
+		// fetched is at most maxNumCheckpoints.
+		numAccepted := lastIndex + 1
+		interval = (numAccepted + maxNumCheckpoints - 1) / maxNumCheckpoints
+	}
+
+	startIndex := interval - 1
+	if len(heights) > 0 {
+		lastHeight := heights[len(heights)-1]
+		_, lastCheckpointIndex, err := c.client.GetContainerByID(ctx, checkpoints[lastHeight])
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s-chain block %s at height %d: %w",
+				errNotAccepted,
+				c.alias,
+				checkpoints[lastHeight],
+				lastHeight,
+				err,
+			)
+		}
+		startIndex = lastCheckpointIndex + interval
+	}
+
+	numAdded := 0
+	for index := startIndex; index <= lastIndex; index += interval {
+		container, err := c.client.GetContainerByIndex(ctx, index)
+		if err != nil {
+			return numAdded, err
+		}
+		height, err := c.height(container.Bytes)
+		if err != nil {
+			return numAdded, fmt.Errorf("failed to parse %s-chain block %s: %w", c.alias, container.ID, err)
+		}
+		checkpoints[height] = container.ID
+		numAdded++
+	}
+	return numAdded, nil
+}
+
+// verify returns an error for every checkpoint that isn't accepted by the node
+// at its height.
+func (c *chainIndex) verify(ctx context.Context, checkpoints genesis.Checkpoints) error {
+	var errs []error
+	for _, height := range slices.Sorted(maps.Keys(checkpoints)) {
+		blkID := checkpoints[height]
+		container, _, err := c.client.GetContainerByID(ctx, blkID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%w: %s-chain block %s at height %d: %w", errNotAccepted, c.alias, blkID, height, err))
+			continue
+		}
+		acceptedHeight, err := c.height(container.Bytes)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to parse %s-chain block %s: %w", c.alias, blkID, err))
+			continue
+		}
+		if acceptedHeight != height {
+			errs = append(errs, fmt.Errorf("%w: %s-chain block %s is at height %d, expected %d", errHeightMismatch, c.alias, blkID, acceptedHeight, height))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func pChainBlockHeight(bytes []byte) (uint64, error) {
+	blk, err := pblock.Parse(pblock.GenesisCodec, bytes)
+	if err != nil {
+		return 0, err
+	}
+	return blk.Height(), nil
+}
+
+func xChainBlockHeight(bytes []byte) (uint64, error) {
+	blk, err := builder.Parser.ParseBlock(bytes)
+	if err != nil {
+		return 0, err
+	}
+	return blk.Height(), nil
+}
+
+// cChainBlockHeight returns the number of the RLP encoded C-Chain block with
+// [bytes]. Only the header is decoded, so that the fields added to the
+// blocks by coreth don't need to be known.
+func cChainBlockHeight(bytes []byte) (uint64, error) {
+	blk, _, err := rlp.SplitList(bytes)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", errInvalidCChainBlock, err)
+	}
+	header, _, err := rlp.SplitList(blk)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid header: %w", errInvalidCChainBlock, err)
+	}
+	for i := 0; i < cChainNumberField; i++ {
+		if _, _, header, err = rlp.Split(header); err != nil {
+			return 0, fmt.Errorf("%w: invalid header: %w", errInvalidCChainBlock, err)
+		}
+	}
+	number, _, err := rlp.SplitUint64(header)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid number: %w", errInvalidCChainBlock, err)
+	}
+	return number, nil
+}
@@ -0,0 +1,237 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/gorilla/rpc/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/wallet/chain/x/builder"
+
+	xblock "github.com/ava-labs/avalanchego/vms/avm/block"
+	pblock "github.com/ava-labs/avalanchego/vms/platformvm/block"
+	proposerblock "github.com/ava-labs/avalanchego/vms/proposervm/block"
+)
+
+// stubIndex serves the index API of a chain whose accepted blocks are
+// containers.
+type stubIndex struct {
+	containers []indexer.Container
+}
+
+func (s *stubIndex) reply(index int, reply *indexer.FormattedContainer) error {
+	container := s.containers[index]
+	bytes, err := formatting.Encode(formatting.Hex, container.Bytes)
+	if err != nil {
+		return err
+	}
+	*reply = indexer.FormattedContainer{
+		ID:       container.ID,
+		Bytes:    bytes,
+		Encoding: formatting.Hex,
+		Index:    json.Uint64(index),
+	}
+	return nil
+}
+
+func (s *stubIndex) GetLastAccepted(_ *http.Request, _ *indexer.GetLastAcceptedArgs, reply *indexer.FormattedContainer) error {
+	return s.reply(len(s.containers)-1, reply)
+}
+
+func (s *stubIndex) GetContainerByIndex(_ *http.Request, args *indexer.GetContainerByIndexArgs, reply *indexer.FormattedContainer) error {
+	return s.reply(int(args.Index), reply)
+}
+
+func (s *stubIndex) GetContainerByID(_ *http.Request, args *indexer.GetContainerByIDArgs, reply *indexer.FormattedContainer) error {
+	for index, container := range s.containers {
+		if container.ID == args.ID {
+			return s.reply(index, reply)
+		}
+	}
+	return errNotAccepted
+}
+
+func newStubIndexServer(t *testing.T, index *stubIndex) *httptest.Server {
+	server := rpc.NewServer()
+	server.RegisterCodec(json.NewCodec(), "application/json")
+	require.NoError(t, server.RegisterService(index, "index"))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	return httpServer
+}
+
+// pChainBlock returns a P-Chain block at [height], wrapped in a proposervm
+// block if [postFork].
+func pChainBlock(t *testing.T, height uint64, postFork bool) indexer.Container {
+	require := require.New(t)
+
+	if !postFork {
+		blk, err := pblock.NewApricotStandardBlock(ids.GenerateTestID(), height, nil)
+		require.NoError(err)
+		return indexer.Container{ID: blk.ID(), Bytes: blk.Bytes()}
+	}
+	blk, err := pblock.NewBanffStandardBlock(time.Unix(1_700_000_000, 0), ids.GenerateTestID(), height, nil)
+	require.NoError(err)
+	proposerBlk, err := proposerblock.BuildUnsigned(ids.GenerateTestID(), time.Unix(1_700_000_000, 0), 1, blk.Bytes())
+	require.NoError(err)
+	return indexer.Container{ID: proposerBlk.ID(), Bytes: proposerBlk.Bytes()}
+}
+
+func TestBlockHeight(t *testing.T) {
+	xBlk, err := xblock.NewStandardBlock(ids.GenerateTestID(), 7, time.Unix(1_700_000_000, 0), nil, builder.Parser.Codec())
+	require.NoError(t, err)
+	cBlkBytes, err := rlp.EncodeToBytes(types.NewBlockWithHeader(&types.Header{
+		Number:     big.NewInt(1_000_000),
+		Difficulty: big.NewInt(1),
+		BaseFee:    big.NewInt(25_000_000_000),
+	}))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		parseHeight func([]byte) (uint64, error)
+		bytes       []byte
+		expected    uint64
+	}{
+		{
+			name:        "P-Chain pre-fork",
+			parseHeight: pChainBlockHeight,
+			bytes:       pChainBlock(t, 3, false).Bytes,
+			expected:    3,
+		},
+		{
+			name:        "P-Chain post-fork",
+			parseHeight: pChainBlockHeight,
+			bytes:       pChainBlock(t, 4, true).Bytes,
+			expected:    4,
+		},
+		{
+			name:        "X-Chain",
+			parseHeight: xChainBlockHeight,
+			bytes:       xBlk.Bytes(),
+			expected:    7,
+		},
+		{
+			name:        "C-Chain",
+			parseHeight: cChainBlockHeight,
+			bytes:       cBlkBytes,
+			expected:    1_000_000,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			chain := &chainIndex{parseHeight: test.parseHeight}
+			height, err := chain.height(test.bytes)
+			require.NoError(err)
+			require.Equal(test.expected, height)
+		})
+	}
+}
+
+func TestCChainBlockHeightInvalid(t *testing.T) {
+	_, err := cChainBlockHeight([]byte{0xc0})
+	require.ErrorIs(t, err, errInvalidCChainBlock)
+}
+
+func TestNetworkChains(t *testing.T) {
+	require := require.New(t)
+
+	chainIDs := set.Set[ids.ID]{}
+	for networkID := range constants.ProductionNetworkIDs {
+		chains, err := networkChains(networkID, "http://localhost:9650")
+		require.NoError(err)
+		require.Len(chains, 3)
+		require.Equal(constants.PlatformChainID, chains[0].chainID)
+		chainIDs.Add(chains[1].chainID, chains[2].chainID)
+	}
+	// The X-Chain and C-Chain of every network are different
+	require.Len(chainIDs, 2*constants.ProductionNetworkIDs.Len())
+}
+
+func TestUpdateAndVerifyCheckpoints(t *testing.T) {
+	require := require.New(t)
+
+	// The blocks at heights 1 to 10 are accepted, the first two before the
+	// proposervm fork
+	index := &stubIndex{}
+	for height := uint64(1); height <= 10; height++ {
+		index.containers = append(index.containers, pChainBlock(t, height, height > 2))
+	}
+	server := newStubIndexServer(t, index)
+	chains := []*chainIndex{{
+		alias:       "P",
+		chainID:     constants.PlatformChainID,
+		client:      indexer.NewClient(server.URL),
+		parseHeight: pChainBlockHeight,
+	}}
+
+	checkpointsPath := filepath.Join(t.TempDir(), "checkpoint_heights.json")
+	otherNetwork := map[ids.ID]genesis.Checkpoints{
+		constants.PlatformChainID: {1: ids.GenerateTestID()},
+	}
+	require.NoError(checkpointsFile{constants.CostonName: otherNetwork}.write(checkpointsPath))
+	expected := genesis.Checkpoints{}
+	for _, height := range []uint64{2, 4, 6, 8, 10} {
+		expected[height] = index.containers[height-1].ID
+	}
+
+	ctx := context.Background()
+	checkpoints, err := readCheckpointsFile(checkpointsPath)
+	require.NoError(err)
+	require.NoError(updateCheckpoints(ctx, chains, checkpoints, constants.FlareName, checkpointsPath, 0, 5))
+	checkpoints, err = readCheckpointsFile(checkpointsPath)
+	require.NoError(err)
+	require.Equal(checkpointsFile{
+		constants.CostonName: otherNetwork,
+		constants.FlareName: {
+			constants.PlatformChainID: expected,
+		},
+	}, checkpoints)
+	require.NoError(verifyCheckpoints(ctx, chains, checkpoints[constants.FlareName]))
+
+	// The interval of the existing checkpoints is kept for the new blocks
+	for height := uint64(11); height <= 14; height++ {
+		index.containers = append(index.containers, pChainBlock(t, height, true))
+	}
+	require.NoError(updateCheckpoints(ctx, chains, checkpoints, constants.FlareName, checkpointsPath, 0, 5))
+	checkpoints, err = readCheckpointsFile(checkpointsPath)
+	require.NoError(err)
+	expected[12] = index.containers[11].ID
+	expected[14] = index.containers[13].ID
+	require.Equal(expected, checkpoints[constants.FlareName][constants.PlatformChainID])
+	require.NoError(verifyCheckpoints(ctx, chains, checkpoints[constants.FlareName]))
+
+	// A checkpoint at the wrong height or not accepted by the node is reported
+	checkpoints[constants.FlareName][constants.PlatformChainID][3] = expected[2]
+	err = verifyCheckpoints(ctx, chains, checkpoints[constants.FlareName])
+	require.ErrorIs(err, errHeightMismatch)
+	require.NotErrorIs(err, errNotAccepted)
+
+	delete(checkpoints[constants.FlareName][constants.PlatformChainID], 3)
+	checkpoints[constants.FlareName][constants.PlatformChainID][16] = ids.GenerateTestID()
+	checkpoints[constants.FlareName][ids.GenerateTestID()] = genesis.Checkpoints{}
+	err = verifyCheckpoints(ctx, chains, checkpoints[constants.FlareName])
+	require.ErrorIs(err, errNotAccepted)
+	require.ErrorIs(err, errUnknownChain)
+	require.NotErrorIs(err, errHeightMismatch)
+}
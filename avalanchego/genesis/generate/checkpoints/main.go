@@ -5,117 +5,113 @@ package main
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
+	"os"
+	"time"
 
-	"github.com/ava-labs/avalanchego/ids"
-	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/api/info"
 	"github.com/ava-labs/avalanchego/utils/constants"
-	"github.com/ava-labs/avalanchego/utils/perms"
-	"github.com/ava-labs/avalanchego/utils/set"
 )
 
 const (
-	costwoURI = "http://localhost:9650"
-	flareURI  = "http://localhost:9660"
-
-	maxNumCheckpoints = 100
+	defaultURI               = "http://localhost:9650"
+	defaultCheckpointsFile   = "checkpoint_heights.json"
+	defaultMaxNumCheckpoints = 100
+	defaultTimeout           = time.Hour
 )
 
 var (
-	costwoXChainID = ids.FromStringOrPanic("2JVSBoinj9C2J33VntvzYtVJNZdN2NKiwwKjcumHUWEb5DbBrm")
-	costwoCChainID = ids.FromStringOrPanic("yH8D7ThNJkxmtkuv2jgBa4P1Rn3Qpr4pPr7QYNfcdoS6k6HWp")
-	flareXChainID  = ids.FromStringOrPanic("2oYMBNV4eNHyqk2fjjV5nVQLDbtmNJzq5s3qs3Lo6ftnC6FByM")
-	flareCChainID  = ids.FromStringOrPanic("2q9e4r6Mu3U68nU1fYjgbR6JvwrRx36CohpAX5UQxse55x1Q5")
+	errUnsupportedNetwork = errors.New("unsupported network")
+	errWrongNetwork       = errors.New("node is on another network")
+	errZeroMaxCheckpoints = errors.New("--max-checkpoints must be positive")
 )
 
 // This fetches IDs of blocks periodically accepted on the P-chain, X-chain, and
-// C-chain on both Fuji and Mainnet.
+// C-chain of a Flare network and adds them to the checkpoints file, or checks
+// the checkpoints of the file with --verify.
 //
-// This expects to be able to communicate with a Fuji node at [fujiURI] and a
-// Mainnet node at [mainnetURI]. Both nodes must have the index API enabled.
+// This expects to be able to communicate with a node of the network at [uri]
+// with the index API enabled.
 func main() {
-	ctx := context.Background()
+	var (
+		networkName       string
+		uri               string
+		checkpointsPath   string
+		interval          uint64
+		maxNumCheckpoints uint64
+		verify            bool
+		timeout           time.Duration
+	)
+	cmd := &cobra.Command{
+		Use:   "checkpoints",
+		Short: "Generates or verifies the checkpoints of a Flare network",
+		Long: `Generates or verifies the checkpoints of a Flare network.
 
-	costwoPChainCheckpoints, err := getCheckpoints(ctx, costwoURI, "P")
-	if err != nil {
-		log.Fatalf("failed to fetch Costwo P-chain checkpoints: %v", err)
-	}
-	costwoXChainCheckpoints, err := getCheckpoints(ctx, costwoURI, "X")
-	if err != nil {
-		log.Fatalf("failed to fetch Costwo X-chain checkpoints: %v", err)
-	}
-	costwoCChainCheckpoints, err := getCheckpoints(ctx, costwoURI, "C")
-	if err != nil {
-		log.Fatalf("failed to fetch Costwo C-chain checkpoints: %v", err)
-	}
+Blocks accepted after the last checkpoint of each chain are added to the
+checkpoints file, keeping the checkpoints of the other networks. The file is
+written after each chain, so an interrupted run keeps the chains already done.
 
-	flarePChainCheckpoints, err := getCheckpoints(ctx, flareURI, "P")
-	if err != nil {
-		log.Fatalf("failed to fetch Flare P-chain checkpoints: %v", err)
-	}
-	flareXChainCheckpoints, err := getCheckpoints(ctx, flareURI, "X")
-	if err != nil {
-		log.Fatalf("failed to fetch Flare X-chain checkpoints: %v", err)
-	}
-	flareCChainCheckpoints, err := getCheckpoints(ctx, flareURI, "C")
-	if err != nil {
-		log.Fatalf("failed to fetch Flare C-chain checkpoints: %v", err)
-	}
+With --verify, the checkpoints of the network in the file are checked against
+the index of the node instead.`,
+		Args: cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			networkID, err := constants.NetworkID(networkName)
+			if err != nil {
+				return err
+			}
+			if !constants.ProductionNetworkIDs.Contains(networkID) {
+				return fmt.Errorf("%w %q, expected one of %s, %s, %s or %s",
+					errUnsupportedNetwork,
+					networkName,
+					constants.FlareName,
+					constants.SongbirdName,
+					constants.CostwoName,
+					constants.CostonName,
+				)
+			}
+			if maxNumCheckpoints == 0 {
+				return errZeroMaxCheckpoints
+			}
+			networkName = constants.NetworkName(networkID)
 
-	checkpoints := map[string]map[ids.ID]set.Set[ids.ID]{
-		constants.CostwoName: {
-			constants.PlatformChainID: costwoPChainCheckpoints,
-			costwoXChainID:            costwoXChainCheckpoints,
-			costwoCChainID:            costwoCChainCheckpoints,
-		},
-		constants.MainnetName: {
-			constants.PlatformChainID: flarePChainCheckpoints,
-			flareXChainID:             flareXChainCheckpoints,
-			flareCChainID:             flareCChainCheckpoints,
-		},
-	}
-	checkpointsJSON, err := json.MarshalIndent(checkpoints, "", "\t")
-	if err != nil {
-		log.Fatalf("failed to marshal checkpoints: %v", err)
-	}
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
 
-	if err := perms.WriteFile("checkpoints.json", checkpointsJSON, perms.ReadWrite); err != nil {
-		log.Fatalf("failed to write checkpoints: %v", err)
-	}
-}
+			nodeNetworkID, err := info.NewClient(uri).GetNetworkID(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to fetch network ID of %s: %w", uri, err)
+			}
+			if nodeNetworkID != networkID {
+				return fmt.Errorf("%w: %s is on %s", errWrongNetwork, uri, constants.NetworkName(nodeNetworkID))
+			}
 
-func getCheckpoints(
-	ctx context.Context,
-	uri string,
-	chainAlias string,
-) (set.Set[ids.ID], error) {
-	var (
-		chainURI = fmt.Sprintf("%s/ext/index/%s/block", uri, chainAlias)
-		client   = indexer.NewClient(chainURI)
-	)
+			chains, err := networkChains(networkID, uri)
+			if err != nil {
+				return err
+			}
+			checkpoints, err := readCheckpointsFile(checkpointsPath)
+			if err != nil {
+				return err
+			}
 
-	// If there haven't been any blocks accepted, this will return an error.
-	_, lastIndex, err := client.GetLastAccepted(ctx)
-	if err != nil {
-		return nil, err
+			if verify {
+				return verifyCheckpoints(ctx, chains, checkpoints[networkName])
+			}
+			return updateCheckpoints(ctx, chains, checkpoints, networkName, checkpointsPath, interval, maxNumCheckpoints)
+		},
 	}
+	cmd.Flags().StringVar(&networkName, "network", constants.FlareName, "Name of the network: flare, songbird, costwo or coston")
+	cmd.Flags().StringVar(&uri, "uri", defaultURI, "URI of a node of the network with the index API enabled")
+	cmd.Flags().StringVar(&checkpointsPath, "checkpoints-file", defaultCheckpointsFile, "Path of the checkpoints file, such as genesis/checkpoint_heights.json")
+	cmd.Flags().Uint64Var(&interval, "interval", 0, "Number of blocks between checkpoints. If 0, the interval of the last checkpoints of the chain is kept, or chosen so that a new chain has at most --max-checkpoints")
+	cmd.Flags().Uint64Var(&maxNumCheckpoints, "max-checkpoints", defaultMaxNumCheckpoints, "Maximum number of checkpoints of a chain without checkpoints")
+	cmd.Flags().BoolVar(&verify, "verify", false, "Check the checkpoints of the file against the node instead of adding checkpoints")
+	cmd.Flags().DurationVar(&timeout, "timeout", defaultTimeout, "Timeout of the command")
 
-	var (
-		numAccepted = lastIndex + 1
-		// interval is rounded up to ensure that the number of checkpoints
-		// fetched is at most maxNumCheckpoints.
-		interval    = (numAccepted + maxNumCheckpoints - 1) / maxNumCheckpoints
-		checkpoints set.Set[ids.ID]
-	)
-	for index := interval - 1; index <= lastIndex; index += interval {
-		container, err := client.GetContainerByIndex(ctx, index)
-		if err != nil {
-			return nil, err
-		}
-
-		checkpoints.Add(container.ID)
+	if err := cmd.Execute(); err != nil {
+		os.Exit(1)
 	}
-	return checkpoints, nil
 }
@@ -324,6 +324,7 @@ type Node struct {
 	benchlistManager benchlist.Manager
 
 	uptimeCalculator uptime.LockedCalculator
+	uptimeHistory    uptime.LockedHistory
 
 	// dispatcher for events as they happen in consensus
 	BlockAcceptorGroup  snow.AcceptorGroup
@@ -568,6 +569,7 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 	n.benchlistManager = benchlist.NewManager(&n.Config.BenchlistConfig)
 
 	n.uptimeCalculator = uptime.NewLockedCalculator()
+	n.uptimeHistory = uptime.NewLockedHistory()
 
 	consensusRouter := n.chainRouter
 	if !n.Config.SybilProtectionEnabled {
@@ -1207,6 +1209,7 @@ func (n *Node) initVMs() error {
 				Chains:                    n.chainManager,
 				Validators:                vdrs,
 				UptimeLockedCalculator:    n.uptimeCalculator,
+				UptimeLockedHistory:       n.uptimeHistory,
 				SybilProtectionEnabled:    n.Config.SybilProtectionEnabled,
 				PartialSyncPrimaryNetwork: n.Config.PartialSyncPrimaryNetwork,
 				TrackedSubnets:            n.Config.TrackedSubnets,
@@ -1403,6 +1406,7 @@ func (n *Node) initInfoAPI() error {
 		n.Config.NetworkConfig.MyIPPort,
 		n.Net,
 		n.benchlistManager,
+		n.uptimeHistory,
 	)
 	if err != nil {
 		return err
@@ -0,0 +1,43 @@
+#!/usr/bin/env bash
+
+set -euo pipefail
+
+# e.g.,
+# ./scripts/tests.e2e.flare.sh
+# ./scripts/tests.e2e.flare.sh --ginkgo.focus-file=daemon.go                          # All arguments are supplied to ginkgo
+# E2E_RANDOM_SEED=1234882 ./scripts/tests.e2e.flare.sh                                 # Specify a specific seed to order test execution by
+# AVALANCHEGO_PATH=./build/avalanchego ./scripts/tests.e2e.flare.sh                    # Customization of avalanchego path
+if ! [[ "$0" =~ scripts/tests.e2e.flare.sh ]]; then
+  echo "must be run from repository root"
+  exit 255
+fi
+
+#################################
+# Sourcing constants.sh ensures that the necessary CGO flags are set to
+# build the portable version of BLST.
+source ./scripts/constants.sh
+
+# Ensure an absolute path to avoid dependency on the working directory
+# of script execution.
+AVALANCHEGO_PATH="$(realpath "${AVALANCHEGO_PATH:-./build/avalanchego}")"
+E2E_ARGS="--avalanchego-path=${AVALANCHEGO_PATH}"
+
+#################################
+# Determine ginkgo args
+#
+# The specs are always executed serially: they compare the balances and
+# supply deltas of whole blocks, and the localflare network only has
+# enough pre-funded keys for a single process.
+GINKGO_ARGS=""
+# Reference: https://onsi.github.io/ginkgo/#spec-randomization
+if [[ -n "${E2E_RANDOM_SEED:-}" ]]; then
+  # Supply a specific seed to simplify reproduction of test failures
+  GINKGO_ARGS+=" --seed=${E2E_RANDOM_SEED}"
+else
+  # Execute in random order to identify unwanted dependency
+  GINKGO_ARGS+=" --randomize-all"
+fi
+
+#################################
+# shellcheck disable=SC2086
+./bin/ginkgo ${GINKGO_ARGS} -v ./tests/e2e/flare -- "${E2E_ARGS[@]}" "${@}"
@@ -183,6 +183,7 @@ func (b *Bootstrapper) Start(ctx context.Context, startReqID uint32) error {
 		zap.Stringer("lastAcceptedID", lastAccepted.ID()),
 		zap.Uint64("lastAcceptedHeight", lastAcceptedHeight),
 	)
+	b.warnCheckpointConflicts(ctx, lastAcceptedHeight)
 
 	// Set the starting height
 	b.startingHeight = lastAcceptedHeight
@@ -0,0 +1,94 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package bootstrap
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/utils"
+)
+
+// checkpointConflict is a checkpoint whose height has a different block
+// accepted locally.
+type checkpointConflict struct {
+	height       uint64
+	checkpointID ids.ID
+	localID      ids.ID
+}
+
+func (c checkpointConflict) Compare(other checkpointConflict) int {
+	return cmp.Compare(c.height, other.height)
+}
+
+// findCheckpointConflicts returns the conflicts of the local blocks with the
+// [checkpoints] up to [lastAcceptedHeight], in order of height. Heights that
+// aren't indexed by [vm], such as those skipped by state sync, are ignored.
+func findCheckpointConflicts(
+	ctx context.Context,
+	vm block.ChainVM,
+	checkpoints genesis.Checkpoints,
+	lastAcceptedHeight uint64,
+) ([]checkpointConflict, error) {
+	var conflicts []checkpointConflict
+	for height, checkpointID := range checkpoints {
+		if height > lastAcceptedHeight {
+			continue
+		}
+		localID, err := vm.GetBlockIDAtHeight(ctx, height)
+		if errors.Is(err, database.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get block ID at height %d: %w", height, err)
+		}
+		if localID != checkpointID {
+			conflicts = append(conflicts, checkpointConflict{
+				height:       height,
+				checkpointID: checkpointID,
+				localID:      localID,
+			})
+		}
+	}
+	utils.Sort(conflicts)
+	return conflicts, nil
+}
+
+// warnCheckpointConflicts logs the accepted blocks conflicting with the
+// checkpoints of the chain. The node keeps running, as the checkpoints are
+// only used to speed up bootstrapping.
+func (b *Bootstrapper) warnCheckpointConflicts(ctx context.Context, lastAcceptedHeight uint64) {
+	checkpoints := genesis.GetCheckpointHeights(b.Ctx.NetworkID, b.Ctx.ChainID)
+	if len(checkpoints) == 0 {
+		return
+	}
+
+	conflicts, err := findCheckpointConflicts(ctx, b.VM, checkpoints, lastAcceptedHeight)
+	if err != nil {
+		b.Ctx.Log.Warn("failed to check accepted blocks against checkpoints",
+			zap.Error(err),
+		)
+		return
+	}
+	for _, conflict := range conflicts {
+		b.Ctx.Log.Warn("accepted block conflicts with checkpoint",
+			zap.Uint64("height", conflict.height),
+			zap.Stringer("checkpointID", conflict.checkpointID),
+			zap.Stringer("acceptedID", conflict.localID),
+		)
+	}
+	if len(conflicts) != 0 {
+		b.Ctx.Log.Warn("the local database may be on a fork of the network, consider deleting it and bootstrapping again",
+			zap.Int("numConflicts", len(conflicts)),
+		)
+	}
+}
@@ -0,0 +1,106 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blocktest"
+)
+
+func TestFindCheckpointConflicts(t *testing.T) {
+	blocks := snowmantest.BuildChain(6)
+	// Heights 1 and 2 were skipped by state sync
+	vm := &blocktest.VM{
+		GetBlockIDAtHeightF: func(_ context.Context, height uint64) (ids.ID, error) {
+			if height == 1 || height == 2 || height >= uint64(len(blocks)) {
+				return ids.Empty, database.ErrNotFound
+			}
+			return blocks[height].ID(), nil
+		},
+	}
+	forkID := ids.GenerateTestID()
+
+	tests := []struct {
+		name               string
+		checkpoints        genesis.Checkpoints
+		lastAcceptedHeight uint64
+		expected           []checkpointConflict
+	}{
+		{
+			name: "no conflicts",
+			checkpoints: genesis.Checkpoints{
+				3: blocks[3].ID(),
+				5: blocks[5].ID(),
+			},
+			lastAcceptedHeight: 5,
+		},
+		{
+			name: "conflicts",
+			checkpoints: genesis.Checkpoints{
+				3: blocks[3].ID(),
+				4: forkID,
+				5: forkID,
+			},
+			lastAcceptedHeight: 5,
+			expected: []checkpointConflict{
+				{
+					height:       4,
+					checkpointID: forkID,
+					localID:      blocks[4].ID(),
+				},
+				{
+					height:       5,
+					checkpointID: forkID,
+					localID:      blocks[5].ID(),
+				},
+			},
+		},
+		{
+			name: "heights not indexed",
+			checkpoints: genesis.Checkpoints{
+				1: forkID,
+				2: forkID,
+			},
+			lastAcceptedHeight: 5,
+		},
+		{
+			name: "heights not accepted",
+			checkpoints: genesis.Checkpoints{
+				5: forkID,
+				6: forkID,
+			},
+			lastAcceptedHeight: 4,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			conflicts, err := findCheckpointConflicts(context.Background(), vm, test.checkpoints, test.lastAcceptedHeight)
+			require.NoError(err)
+			require.Equal(test.expected, conflicts)
+		})
+	}
+}
+
+func TestFindCheckpointConflictsError(t *testing.T) {
+	errTest := errors.New("non-nil error")
+	vm := &blocktest.VM{
+		GetBlockIDAtHeightF: func(context.Context, uint64) (ids.ID, error) {
+			return ids.Empty, errTest
+		},
+	}
+
+	_, err := findCheckpointConflicts(context.Background(), vm, genesis.Checkpoints{1: ids.GenerateTestID()}, 1)
+	require.ErrorIs(t, err, errTest)
+}
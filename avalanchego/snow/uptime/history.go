@@ -0,0 +1,81 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package uptime
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/json"
+)
+
+var (
+	ErrHistoryDisabled = errors.New("uptime history is disabled")
+
+	_ LockedHistory = (*lockedHistory)(nil)
+)
+
+// EpochUptime is the uptime of a validator observed by this node during an
+// epoch. It is recorded once, when the epoch ends, so it doesn't change with
+// later queries.
+type EpochUptime struct {
+	Epoch json.Uint64 `json:"epoch"`
+	// StartTime and EndTime bound the observation. They are the times the node
+	// observed the start and the end of the epoch, or the start time of the
+	// validator if it started staking during the epoch.
+	StartTime json.Uint64 `json:"startTime"`
+	EndTime   json.Uint64 `json:"endTime"`
+	// Approximate is true if StartTime or EndTime differ from the bounds of
+	// the part of the epoch the validator was staking in, so the uptime was
+	// not measured over exactly that part.
+	Approximate bool `json:"approximate"`
+	// Uptime is the number of seconds the validator was connected to this node
+	// between StartTime and EndTime.
+	Uptime json.Uint64 `json:"uptime"`
+	// UptimePercentage is Uptime as a percentage of EndTime - StartTime.
+	UptimePercentage json.Float64 `json:"uptimePercentage"`
+}
+
+// History returns the uptimes of validators per epoch.
+type History interface {
+	// GetUptimeHistory returns the recorded uptimes of [nodeID] in the epochs
+	// between [fromEpoch] and [toEpoch] inclusive. Epochs without a record are
+	// skipped.
+	GetUptimeHistory(nodeID ids.NodeID, fromEpoch, toEpoch uint64) ([]EpochUptime, error)
+}
+
+// LockedHistory allows the history of a chain to be set after it is passed to
+// other components of the node.
+type LockedHistory interface {
+	History
+
+	SetHistory(h History)
+}
+
+type lockedHistory struct {
+	lock sync.RWMutex
+	h    History
+}
+
+func NewLockedHistory() LockedHistory {
+	return &lockedHistory{}
+}
+
+func (h *lockedHistory) GetUptimeHistory(nodeID ids.NodeID, fromEpoch, toEpoch uint64) ([]EpochUptime, error) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	if h.h == nil {
+		return nil, ErrHistoryDisabled
+	}
+	return h.h.GetUptimeHistory(nodeID, fromEpoch, toEpoch)
+}
+
+func (h *lockedHistory) SetHistory(newH History) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.h = newH
+}
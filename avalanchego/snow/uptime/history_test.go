@@ -0,0 +1,39 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package uptime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+type testHistory map[ids.NodeID][]EpochUptime
+
+func (h testHistory) GetUptimeHistory(nodeID ids.NodeID, _, _ uint64) ([]EpochUptime, error) {
+	return h[nodeID], nil
+}
+
+func TestLockedHistory(t *testing.T) {
+	require := require.New(t)
+
+	lh := NewLockedHistory()
+	nodeID := ids.GenerateTestNodeID()
+	_, err := lh.GetUptimeHistory(nodeID, 0, 1)
+	require.ErrorIs(err, ErrHistoryDisabled)
+
+	expected := []EpochUptime{{
+		Epoch:            1,
+		StartTime:        100,
+		EndTime:          200,
+		Uptime:           50,
+		UptimePercentage: 50,
+	}}
+	lh.SetHistory(testHistory{nodeID: expected})
+	records, err := lh.GetUptimeHistory(nodeID, 0, 1)
+	require.NoError(err)
+	require.Equal(expected, records)
+}
@@ -0,0 +1,50 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+// Package local embeds the staking keys of the validators in the genesis of
+// the local networks. These keys are public and must only be used for local
+// test networks.
+package local
+
+import (
+	"embed"
+	"fmt"
+)
+
+// NumStakers is the number of stakers whose keys are embedded.
+const NumStakers = 5
+
+//go:embed staker*.crt staker*.key signer*.key
+var files embed.FS
+
+// Staker holds the keys of a staker in the format of the files passed to the
+// --staking-tls-key-file, --staking-tls-cert-file and
+// --staking-signer-key-file flags.
+type Staker struct {
+	TLSKey    []byte
+	TLSCert   []byte
+	SignerKey []byte
+}
+
+// Stakers returns the keys of the embedded stakers, in the order of their
+// files (staker1, staker2, ...).
+func Stakers() ([]*Staker, error) {
+	stakers := make([]*Staker, NumStakers)
+	for i := range stakers {
+		var (
+			staker = &Staker{}
+			err    error
+		)
+		if staker.TLSKey, err = files.ReadFile(fmt.Sprintf("staker%d.key", i+1)); err != nil {
+			return nil, err
+		}
+		if staker.TLSCert, err = files.ReadFile(fmt.Sprintf("staker%d.crt", i+1)); err != nil {
+			return nil, err
+		}
+		if staker.SignerKey, err = files.ReadFile(fmt.Sprintf("signer%d.key", i+1)); err != nil {
+			return nil, err
+		}
+		stakers[i] = staker
+	}
+	return stakers, nil
+}
@@ -0,0 +1,170 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package flare
+
+import (
+	"math/big"
+
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/ethclient"
+	"github.com/ava-labs/coreth/plugin/evm/upgrade/ap4"
+	"github.com/ava-labs/coreth/rpc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/tests"
+	"github.com/ava-labs/avalanchego/tests/fixture/e2e"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+)
+
+// The replies of the Flare specific APIs of the C-Chain are decoded into the
+// types below, as the coreth version imported by avalanchego doesn't define
+// them.
+
+// daemonResult is the outcome of a daemon call made after a transaction.
+type daemonResult struct {
+	TxHash      common.Hash  `json:"transactionHash"`
+	MintRequest *hexutil.Big `json:"mintRequest"`
+	Minted      *hexutil.Big `json:"minted"`
+	ErrorClass  string       `json:"errorClass"`
+}
+
+// blockDaemonResults is the reply of flare_getDaemonResult.
+type blockDaemonResults struct {
+	BlockHash common.Hash     `json:"blockHash"`
+	Results   []*daemonResult `json:"results"`
+}
+
+// supplyDeltaRange is the reply of flare_getSupplyDelta.
+type supplyDeltaRange struct {
+	Minted            *hexutil.Big `json:"minted"`
+	Burned            *hexutil.Big `json:"burned"`
+	PrioritisedRefund *hexutil.Big `json:"prioritisedRefund"`
+}
+
+// callArgs are the arguments of eth_simulateFlareCall.
+type callArgs struct {
+	From     common.Address  `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      hexutil.Uint64  `json:"gas,omitempty"`
+	GasPrice *hexutil.Big    `json:"gasPrice,omitempty"`
+	Input    hexutil.Bytes   `json:"input,omitempty"`
+}
+
+// accountOverride replaces the code of an account in eth_simulateFlareCall.
+type accountOverride struct {
+	Code hexutil.Bytes `json:"code"`
+}
+
+// flareCallResult is the reply of eth_simulateFlareCall.
+type flareCallResult struct {
+	Err               string        `json:"err"`
+	Prioritised       bool          `json:"prioritised"`
+	PrioritisedReason string        `json:"prioritisedReason"`
+	ChargedFee        *hexutil.Big  `json:"chargedFee"`
+	FeeRefund         *hexutil.Big  `json:"feeRefund"`
+	Daemon            *daemonResult `json:"daemon"`
+}
+
+func getDaemonResults(tc tests.TestContext, ethClient ethclient.Client, blockHash common.Hash) *blockDaemonResults {
+	var results blockDaemonResults
+	require.NoError(tc, ethClient.Client().CallContext(tc.DefaultContext(), &results, "flare_getDaemonResult", blockHash))
+	return &results
+}
+
+func getSupplyDelta(tc tests.TestContext, ethClient ethclient.Client, blockNumber *big.Int) *supplyDeltaRange {
+	var delta supplyDeltaRange
+	number := hexutil.EncodeBig(blockNumber)
+	require.NoError(tc, ethClient.Client().CallContext(tc.DefaultContext(), &delta, "flare_getSupplyDelta", number, number))
+	return &delta
+}
+
+func simulateFlareCall(
+	tc tests.TestContext,
+	ethClient ethclient.Client,
+	args callArgs,
+	overrides map[common.Address]accountOverride,
+) *flareCallResult {
+	var result flareCallResult
+	require.NoError(tc, ethClient.Client().CallContext(tc.DefaultContext(), &result, "eth_simulateFlareCall", args, "latest", overrides))
+	return &result
+}
+
+// gasPrice returns a gas price above the base fee of the latest block and
+// above the nominal gas price of prioritised calls, so that a part of the fee
+// of every prioritised call is refunded.
+func gasPrice(tc tests.TestContext, ethClient ethclient.Client) *big.Int {
+	header, err := ethClient.HeaderByNumber(tc.DefaultContext(), nil)
+	require.NoError(tc, err)
+
+	price := new(big.Int).Mul(header.BaseFee, big.NewInt(2))
+	minPrice := big.NewInt(2 * ap4.MinBaseFee)
+	if price.Cmp(minPrice) < 0 {
+		return minPrice
+	}
+	return price
+}
+
+// sendTx signs a legacy transaction with [key] and waits for its receipt. The
+// transaction creates a contract if [to] is nil.
+func sendTx(
+	tc tests.TestContext,
+	ethClient ethclient.Client,
+	key *secp256k1.PrivateKey,
+	to *common.Address,
+	value *big.Int,
+	gas uint64,
+	data []byte,
+) (*types.Transaction, *types.Receipt) {
+	require := require.New(tc)
+
+	chainID, err := ethClient.ChainID(tc.DefaultContext())
+	require.NoError(err)
+	nonce, err := ethClient.AcceptedNonceAt(tc.DefaultContext(), key.EthAddress())
+	require.NoError(err)
+
+	tx := types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice(tc, ethClient),
+		Gas:      gas,
+		To:       to,
+		Value:    value,
+		Data:     data,
+	})
+	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), key.ToECDSA())
+	require.NoError(err)
+	return signedTx, e2e.SendEthTransaction(tc, ethClient, signedTx)
+}
+
+// balanceChange returns the change to the balance of [addr] made by the block
+// with [blockNumber].
+func balanceChange(tc tests.TestContext, ethClient ethclient.Client, addr common.Address, blockNumber *big.Int) *big.Int {
+	require := require.New(tc)
+
+	after, err := ethClient.BalanceAt(tc.DefaultContext(), addr, blockNumber)
+	require.NoError(err)
+	before, err := ethClient.BalanceAt(tc.DefaultContext(), addr, new(big.Int).Sub(blockNumber, common.Big1))
+	require.NoError(err)
+	return after.Sub(after, before)
+}
+
+// blockFees returns the sum of gasUsed * effectiveGasPrice of the transactions
+// of the block with [blockHash], which is the fee paid for the block if none of
+// its calls are prioritised.
+func blockFees(tc tests.TestContext, ethClient ethclient.Client, blockHash common.Hash) *big.Int {
+	receipts, err := ethClient.BlockReceipts(tc.DefaultContext(), rpc.BlockNumberOrHashWithHash(blockHash, false))
+	require.NoError(tc, err)
+
+	fees := new(big.Int)
+	for _, receipt := range receipts {
+		fees.Add(fees, receiptFee(receipt))
+	}
+	return fees
+}
+
+// receiptFee returns gasUsed * effectiveGasPrice of [receipt].
+func receiptFee(receipt *types.Receipt) *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
+}
@@ -0,0 +1,146 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package flare
+
+import (
+	"math/big"
+
+	"github.com/ava-labs/coreth/params"
+	"github.com/ava-labs/coreth/plugin/evm/upgrade/ap4"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ava-labs/avalanchego/tests/fixture/tmpnet"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+)
+
+// The stub contracts used by the suite are assembled from a handful of
+// opcodes instead of being compiled, since the node only inspects their return
+// values.
+var (
+	// echoCode returns the 32-byte word following the selector of the
+	// calldata, which lets the caller choose whether the call returns zero.
+	//
+	// PUSH1 0x04 CALLDATALOAD PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	echoCode = common.FromHex("0x60043560005260206000f3")
+	// stopCode returns nothing.
+	//
+	// STOP
+	stopCode = common.FromHex("0x00")
+	// revertCode reverts without a reason.
+	//
+	// PUSH1 0x00 DUP1 REVERT
+	revertCode = common.FromHex("0x600080fd")
+)
+
+// The stubs of the prioritised contracts are deployed by stubDeployerKey with
+// its first transactions, so that their addresses can be set in the upgrade
+// config of the C-Chain before the network is started.
+const stubDeployerKeyStr = "6a184dfec12a4e939dd41974678669e326309f82768b174b58ba0a2770137eab"
+
+var (
+	stubDeployerKey *secp256k1.PrivateKey
+
+	// submitterStubAddress is prioritised like the submitter contract: only
+	// calls of an allowlisted selector returning a non-zero value are charged
+	// the nominal fee.
+	submitterStubAddress common.Address
+	// ftsoStubAddress is prioritised like the FTSO contract: calls are charged
+	// the nominal fee if their gas limit is at most ftsoStubMaxGas.
+	ftsoStubAddress common.Address
+
+	// submitSelector is the only selector allowed for prioritised calls to the
+	// submitter stub.
+	submitSelector = common.FromHex("0x6c532fae")
+)
+
+const (
+	ftsoStubMaxGas         = 100_000
+	submitterStubMaxGas    = 3_000_000
+	submitterStubDataCap   = 4_500
+	prioritisedGasLimit    = 50_000
+	nonPrioritisedGasLimit = 2 * ftsoStubMaxGas
+	deployGasLimit         = 200_000
+)
+
+// nominalFee is the fee charged for a prioritised call on Flare networks.
+var nominalFee = new(big.Int).Mul(
+	new(big.Int).SetUint64(params.TxGas),
+	big.NewInt(ap4.MinBaseFee),
+)
+
+func init() {
+	keyBytes, err := hexutil.Decode("0x" + stubDeployerKeyStr)
+	if err != nil {
+		panic(err)
+	}
+	stubDeployerKey, err = secp256k1.ToPrivateKey(keyBytes)
+	if err != nil {
+		panic(err)
+	}
+
+	deployer := stubDeployerKey.EthAddress()
+	submitterStubAddress = crypto.CreateAddress(deployer, 0)
+	ftsoStubAddress = crypto.CreateAddress(deployer, 1)
+}
+
+// CChainUpgrade returns the upgrade config of the C-Chain of the network used
+// by the suite, which prioritises the calls to the stubs of the submitter and
+// FTSO contracts.
+func CChainUpgrade() tmpnet.FlagsMap {
+	return tmpnet.FlagsMap{
+		"prioritisedContracts": []map[string]any{
+			{
+				"blockTimestamp": 0,
+				"contracts": []map[string]any{
+					{
+						"address": ftsoStubAddress,
+						"maxGas":  ftsoStubMaxGas,
+					},
+					{
+						"address":            submitterStubAddress,
+						"selectors":          []hexutil.Bytes{submitSelector},
+						"maxGas":             submitterStubMaxGas,
+						"callDataCap":        submitterStubDataCap,
+						"requireReturnValue": true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// creationCode returns the init code of a contract whose code is [code].
+//
+// PUSH1 len(code) DUP1 PUSH1 0x0b PUSH1 0x00 CODECOPY PUSH1 0x00 RETURN
+func creationCode(code []byte) []byte {
+	initCode := []byte{0x60, byte(len(code)), 0x80, 0x60, 0x0b, 0x60, 0x00, 0x39, 0x60, 0x00, 0xf3}
+	return append(initCode, code...)
+}
+
+// returnWordCode returns the code of a contract returning [word] from every
+// call.
+//
+// PUSH32 word PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+func returnWordCode(word *big.Int) []byte {
+	code := []byte{0x7f}
+	code = append(code, common.BigToHash(word).Bytes()...)
+	return append(code, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3)
+}
+
+// returnBytesCode returns the code of a contract returning [size] zero bytes
+// from every call.
+//
+// PUSH1 size PUSH1 0x00 RETURN
+func returnBytesCode(size byte) []byte {
+	return []byte{0x60, size, 0x60, 0x00, 0xf3}
+}
+
+// submitCalldata returns the calldata of a call to [selector] of the
+// submitter stub, which returns [value].
+func submitCalldata(selector []byte, value int64) []byte {
+	data := append([]byte{}, selector...)
+	return append(data, common.BigToHash(big.NewInt(value)).Bytes()...)
+}
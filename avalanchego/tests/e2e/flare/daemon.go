@@ -0,0 +1,119 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package flare
+
+import (
+	"math/big"
+
+	"github.com/ava-labs/coreth/params"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/tests/fixture/e2e"
+)
+
+var (
+	daemonAddress = common.HexToAddress("0x1000000000000000000000000000000000000002")
+
+	// maxMintRequest is the largest amount the daemon may request on Flare
+	// networks (60M FLR).
+	maxMintRequest = new(big.Int).Mul(big.NewInt(60_000_000), big.NewInt(params.Ether))
+)
+
+var _ = DescribeFlare("[Daemon]", func() {
+	tc := e2e.NewTestContext()
+	require := require.New(tc)
+
+	ginkgo.It("should record the daemon call made after every successful transaction", func() {
+		env := e2e.GetEnv(tc)
+		ethClient := e2e.NewEthClient(tc, env.GetRandomNodeURI())
+		key := env.PreFundedKey
+		recipient := e2e.NewPrivateKey(tc).EthAddress()
+
+		tc.By("sending a transfer")
+		tx, receipt := sendTx(tc, ethClient, key, &recipient, big.NewInt(params.Ether), e2e.DefaultGasLimit, nil)
+		require.Equal(uint64(1), receipt.Status)
+
+		tc.By("checking that the daemon was called after the transfer", func() {
+			results := getDaemonResults(tc, ethClient, receipt.BlockHash)
+			require.Equal(receipt.BlockHash, results.BlockHash)
+
+			var (
+				found  bool
+				minted = new(big.Int)
+			)
+			for _, result := range results.Results {
+				if result.TxHash == tx.Hash() {
+					found = true
+				}
+				minted.Add(minted, result.Minted.ToInt())
+			}
+			require.True(found, "no daemon result for tx %s", tx.Hash())
+
+			tc.By("checking that the supply delta of the block includes the minted amount and the fees")
+			delta := getSupplyDelta(tc, ethClient, receipt.BlockNumber)
+			require.Zero(minted.Cmp(delta.Minted.ToInt()))
+			require.Zero(blockFees(tc, ethClient, receipt.BlockHash).Cmp(delta.Burned.ToInt()))
+		})
+
+		tc.By("sending a transaction that reverts")
+		tx, receipt = sendTx(tc, ethClient, key, nil, nil, deployGasLimit, revertCode)
+		require.Equal(uint64(0), receipt.Status)
+
+		tc.By("checking that the daemon was not called after the failed transaction", func() {
+			for _, result := range getDaemonResults(tc, ethClient, receipt.BlockHash).Results {
+				require.NotEqual(tx.Hash(), result.TxHash)
+			}
+		})
+	})
+
+	ginkgo.It("should mint the amount requested by the daemon", func() {
+		env := e2e.GetEnv(tc)
+		ethClient := e2e.NewEthClient(tc, env.GetRandomNodeURI())
+		recipient := e2e.NewPrivateKey(tc).EthAddress()
+		args := callArgs{
+			From: env.PreFundedKey.EthAddress(),
+			To:   &recipient,
+		}
+
+		// The daemon of the network is replaced by stubs in simulated calls
+		simulateWithDaemon := func(code []byte) *daemonResult {
+			result := simulateFlareCall(tc, ethClient, args, map[common.Address]accountOverride{
+				daemonAddress: {Code: code},
+			})
+			require.Empty(result.Err)
+			require.NotNil(result.Daemon)
+			return result.Daemon
+		}
+
+		tc.By("minting a valid mint request", func() {
+			mintRequest := big.NewInt(params.Ether) // 1 FLR
+			result := simulateWithDaemon(returnWordCode(mintRequest))
+			require.Empty(result.ErrorClass)
+			require.Zero(mintRequest.Cmp(result.MintRequest.ToInt()))
+			require.Zero(mintRequest.Cmp(result.Minted.ToInt()))
+		})
+
+		tc.By("rejecting a mint request above the maximum", func() {
+			mintRequest := new(big.Int).Add(maxMintRequest, common.Big1)
+			result := simulateWithDaemon(returnWordCode(mintRequest))
+			require.Equal("max-mint-exceeded", result.ErrorClass)
+			require.Zero(mintRequest.Cmp(result.MintRequest.ToInt()))
+			require.Zero(result.Minted.ToInt().Sign())
+		})
+
+		tc.By("rejecting invalid replies of the daemon", func() {
+			for errorClass, code := range map[string][]byte{
+				"data-empty":   stopCode,
+				"invalid-data": returnBytesCode(64),
+				"call-failed":  revertCode,
+			} {
+				result := simulateWithDaemon(code)
+				require.Equal(errorClass, result.ErrorClass)
+				require.Zero(result.Minted.ToInt().Sign())
+			}
+		})
+	})
+})
@@ -0,0 +1,82 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package flare
+
+import (
+	"math/big"
+
+	"github.com/ava-labs/coreth/constants"
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/ethclient"
+	"github.com/ava-labs/coreth/params"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/tests"
+	"github.com/ava-labs/avalanchego/tests/fixture/e2e"
+	"github.com/ava-labs/avalanchego/tests/fixture/tmpnet"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+)
+
+// burnAddress receives the fees paid on Flare networks.
+var burnAddress = common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+
+var _ = DescribeFlare("[Fee Recipient]", func() {
+	tc := e2e.NewTestContext()
+	require := require.New(tc)
+
+	ginkgo.It("should burn the fees on Flare networks", func() {
+		env := e2e.GetEnv(tc)
+		ethClient := e2e.NewEthClient(tc, env.GetRandomNodeURI())
+
+		receipt := sendTransfer(tc, ethClient, env.PreFundedKey)
+		fees := blockFees(tc, ethClient, receipt.BlockHash)
+		require.Positive(fees.Sign())
+		require.Zero(fees.Cmp(balanceChange(tc, ethClient, burnAddress, receipt.BlockNumber)))
+		require.Zero(balanceChange(tc, ethClient, constants.BlackholeAddr, receipt.BlockNumber).Sign())
+	})
+
+	ginkgo.It("should pay the fees to the system coinbase on Songbird networks", func() {
+		tc.By("creating a private local (Songbird) network")
+		env := e2e.GetEnv(tc)
+		network, err := tmpnet.LocalSongbirdFlavor.NewNetwork("avalanchego-flare-e2e-songbird", 1)
+		require.NoError(err)
+		env.StartPrivateNetwork(network)
+
+		// Avoid emitting a spec-scoped metrics link for the shared network
+		// since the link emitted by the start of the private network is more
+		// relevant.
+		e2e.EmitMetricsLink = false
+
+		node := network.Nodes[0]
+		ethClient := e2e.NewEthClient(tc, tmpnet.NodeURI{
+			NodeID: node.NodeID,
+			URI:    e2e.GetLocalURI(tc, node),
+		})
+
+		receipt := sendTransfer(tc, ethClient, network.PreFundedKeys[0])
+
+		tc.By("checking that the block was built with the system coinbase", func() {
+			header, err := ethClient.HeaderByHash(tc.DefaultContext(), receipt.BlockHash)
+			require.NoError(err)
+			require.Equal(constants.BlackholeAddr, header.Coinbase)
+		})
+
+		tc.By("checking that the fees were paid to the coinbase", func() {
+			fees := blockFees(tc, ethClient, receipt.BlockHash)
+			require.Positive(fees.Sign())
+			require.Zero(fees.Cmp(balanceChange(tc, ethClient, constants.BlackholeAddr, receipt.BlockNumber)))
+			require.Zero(balanceChange(tc, ethClient, burnAddress, receipt.BlockNumber).Sign())
+		})
+	})
+})
+
+// sendTransfer sends a transfer from [key] to a new address.
+func sendTransfer(tc tests.TestContext, ethClient ethclient.Client, key *secp256k1.PrivateKey) *types.Receipt {
+	recipient := e2e.NewPrivateKey(tc).EthAddress()
+	_, receipt := sendTx(tc, ethClient, key, &recipient, big.NewInt(params.Ether), e2e.DefaultGasLimit, nil)
+	require.Equal(tc, uint64(1), receipt.Status)
+	return receipt
+}
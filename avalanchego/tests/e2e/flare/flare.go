@@ -0,0 +1,21 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+// Package flare contains the e2e tests of the Flare specific behaviour of the
+// C-Chain. They require a localflare network whose C-Chain upgrade config is
+// CChainUpgrade (see flare_test.go).
+package flare
+
+import (
+	"github.com/onsi/ginkgo/v2"
+
+	"github.com/ava-labs/avalanchego/tests/fixture/e2e"
+)
+
+// DescribeFlare annotates the tests of the Flare specific behaviour of the
+// C-Chain. The tests are serial since they compare balances and supply deltas
+// of whole blocks.
+func DescribeFlare(text string, args ...interface{}) bool {
+	args = append(args, ginkgo.Label("flare"), ginkgo.Serial)
+	return e2e.DescribeCChain("[Flare] "+text, args...)
+}
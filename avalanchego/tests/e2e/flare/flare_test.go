@@ -0,0 +1,44 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package flare_test
+
+import (
+	"testing"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/tests/e2e/flare"
+	"github.com/ava-labs/avalanchego/tests/fixture/e2e"
+	"github.com/ava-labs/avalanchego/tests/fixture/tmpnet"
+)
+
+func TestFlare(t *testing.T) {
+	ginkgo.RunSpecs(t, "flare e2e test suites")
+}
+
+var flagVars *e2e.FlagVars
+
+func init() {
+	flagVars = e2e.RegisterFlags()
+}
+
+var _ = ginkgo.SynchronizedBeforeSuite(func() []byte {
+	// Run only once in the first ginkgo process
+
+	tc := e2e.NewEventHandlerTestContext()
+
+	network, err := tmpnet.LocalFlareFlavor.NewNetwork("avalanchego-flare-e2e", flagVars.NodeCount())
+	require.NoError(tc, err)
+	network.ChainUpgrades = map[string]tmpnet.FlagsMap{
+		"C": flare.CChainUpgrade(),
+	}
+
+	return e2e.NewTestEnvironment(tc, flagVars, network).Marshal()
+}, func(envBytes []byte) {
+	// Run in every ginkgo process
+
+	// Initialize the local test environment from the global state
+	e2e.InitSharedTestEnvironment(ginkgo.GinkgoT(), envBytes)
+})
@@ -0,0 +1,95 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package flare
+
+import (
+	"math/big"
+
+	"github.com/ava-labs/coreth/ethclient"
+	"github.com/ava-labs/coreth/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/tests"
+	"github.com/ava-labs/avalanchego/tests/fixture/e2e"
+)
+
+const governanceGasLimit = 100_000
+
+var (
+	governanceSettingsAddress = common.HexToAddress("0x1000000000000000000000000000000000000007")
+
+	// permittedGovernanceAddress and permittedTimelock are the only values the
+	// node allows to be set on localflare networks.
+	permittedGovernanceAddress = common.HexToAddress("0x100000000000000000000000000000000000000f")
+	permittedTimelock          = big.NewInt(3600)
+
+	getGovernanceAddressSelector = selector("getGovernanceAddress()")
+	getTimelockSelector          = selector("getTimelock()")
+	setGovernanceAddressSelector = selector("setGovernanceAddress(address)")
+	setTimelockSelector          = selector("setTimelock(uint256)")
+)
+
+var _ = DescribeFlare("[Governance Settings]", func() {
+	tc := e2e.NewTestContext()
+	require := require.New(tc)
+
+	ginkgo.It("should only apply the permitted governance updates", func() {
+		env := e2e.GetEnv(tc)
+		ethClient := e2e.NewEthClient(tc, env.GetRandomNodeURI())
+		key := env.PreFundedKey
+
+		setGovernanceSetting := func(selector []byte, value common.Hash) {
+			data := append(append([]byte{}, selector...), value.Bytes()...)
+			_, receipt := sendTx(tc, ethClient, key, &governanceSettingsAddress, nil, governanceGasLimit, data)
+			require.Equal(uint64(1), receipt.Status)
+		}
+
+		tc.By("setting a governance address that is not permitted", func() {
+			governanceAddress := getGovernanceSetting(tc, ethClient, getGovernanceAddressSelector)
+			setGovernanceSetting(setGovernanceAddressSelector, common.BytesToHash(e2e.NewPrivateKey(tc).EthAddress().Bytes()))
+			require.Equal(governanceAddress, getGovernanceSetting(tc, ethClient, getGovernanceAddressSelector))
+		})
+
+		tc.By("setting the permitted governance address", func() {
+			// The contract reverts if the address is unchanged, which is the
+			// case if the suite already ran on the network
+			permitted := common.BytesToHash(permittedGovernanceAddress.Bytes())
+			if getGovernanceSetting(tc, ethClient, getGovernanceAddressSelector) != permitted {
+				setGovernanceSetting(setGovernanceAddressSelector, permitted)
+			}
+			require.Equal(permitted, getGovernanceSetting(tc, ethClient, getGovernanceAddressSelector))
+		})
+
+		tc.By("setting the permitted timelock", func() {
+			permitted := common.BigToHash(permittedTimelock)
+			if getGovernanceSetting(tc, ethClient, getTimelockSelector) != permitted {
+				setGovernanceSetting(setTimelockSelector, permitted)
+			}
+			require.Equal(permitted, getGovernanceSetting(tc, ethClient, getTimelockSelector))
+		})
+
+		tc.By("setting a timelock that is not permitted", func() {
+			setGovernanceSetting(setTimelockSelector, common.BigToHash(big.NewInt(7)))
+			require.Equal(common.BigToHash(permittedTimelock), getGovernanceSetting(tc, ethClient, getTimelockSelector))
+		})
+	})
+})
+
+// getGovernanceSetting returns the word returned by the getter of the
+// governance settings contract with [selector].
+func getGovernanceSetting(tc tests.TestContext, ethClient ethclient.Client, selector []byte) common.Hash {
+	ret, err := ethClient.CallContract(tc.DefaultContext(), interfaces.CallMsg{
+		To:   &governanceSettingsAddress,
+		Data: selector,
+	}, nil)
+	require.NoError(tc, err)
+	return common.BytesToHash(ret)
+}
+
+func selector(signature string) []byte {
+	return crypto.Keccak256([]byte(signature))[:4]
+}
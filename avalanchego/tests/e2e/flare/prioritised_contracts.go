@@ -0,0 +1,175 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package flare
+
+import (
+	"math/big"
+
+	"github.com/ava-labs/coreth/ethclient"
+	"github.com/ava-labs/coreth/params"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/tests"
+	"github.com/ava-labs/avalanchego/tests/fixture/e2e"
+)
+
+var _ = DescribeFlare("[Prioritised Contracts]", func() {
+	tc := e2e.NewTestContext()
+	require := require.New(tc)
+
+	ginkgo.It("should refund the fees of prioritised calls to the submitter contract", func() {
+		env := e2e.GetEnv(tc)
+		ethClient := e2e.NewEthClient(tc, env.GetRandomNodeURI())
+		key := env.PreFundedKey
+		ensureStubsDeployed(tc, ethClient)
+
+		tc.By("calling the allowed selector with a non-zero return value", func() {
+			_, receipt := sendTx(tc, ethClient, key, &submitterStubAddress, nil, prioritisedGasLimit, submitCalldata(submitSelector, 1))
+			require.Equal(uint64(1), receipt.Status)
+			requireNominalFee(tc, ethClient, key.EthAddress(), receipt.BlockNumber, receiptFee(receipt))
+		})
+
+		args := callArgs{
+			From: key.EthAddress(),
+			To:   &submitterStubAddress,
+			Gas:  prioritisedGasLimit,
+		}
+
+		tc.By("checking that the simulated call is prioritised", func() {
+			args.Input = submitCalldata(submitSelector, 1)
+			result := simulateFlareCall(tc, ethClient, args, nil)
+			require.Empty(result.Err)
+			require.True(result.Prioritised)
+			require.Empty(result.PrioritisedReason)
+		})
+
+		tc.By("calling the allowed selector with a zero return value", func() {
+			_, receipt := sendTx(tc, ethClient, key, &submitterStubAddress, nil, prioritisedGasLimit, submitCalldata(submitSelector, 0))
+			require.Equal(uint64(1), receipt.Status)
+			requireFullFee(tc, ethClient, key.EthAddress(), receipt.BlockNumber, receiptFee(receipt))
+
+			args.Input = submitCalldata(submitSelector, 0)
+			result := simulateFlareCall(tc, ethClient, args, nil)
+			require.False(result.Prioritised)
+			require.Equal("zero-return-value", result.PrioritisedReason)
+		})
+
+		tc.By("calling a selector that is not allowed", func() {
+			otherSelector := common.FromHex("0xdeadbeef")
+			_, receipt := sendTx(tc, ethClient, key, &submitterStubAddress, nil, prioritisedGasLimit, submitCalldata(otherSelector, 1))
+			require.Equal(uint64(1), receipt.Status)
+			requireFullFee(tc, ethClient, key.EthAddress(), receipt.BlockNumber, receiptFee(receipt))
+
+			args.Input = submitCalldata(otherSelector, 1)
+			result := simulateFlareCall(tc, ethClient, args, nil)
+			require.False(result.Prioritised)
+			require.Equal("selector-not-allowed", result.PrioritisedReason)
+		})
+
+		tc.By("calling with calldata above the cap", func() {
+			args.Input = append(submitCalldata(submitSelector, 1), make([]byte, submitterStubDataCap)...)
+			args.Gas = submitterStubMaxGas
+			result := simulateFlareCall(tc, ethClient, args, nil)
+			require.False(result.Prioritised)
+			require.Equal("calldata-cap-exceeded", result.PrioritisedReason)
+		})
+	})
+
+	ginkgo.It("should refund the fees of prioritised calls to the FTSO contract", func() {
+		env := e2e.GetEnv(tc)
+		ethClient := e2e.NewEthClient(tc, env.GetRandomNodeURI())
+		key := env.PreFundedKey
+		ensureStubsDeployed(tc, ethClient)
+
+		tc.By("calling with a gas limit below the maximum", func() {
+			_, receipt := sendTx(tc, ethClient, key, &ftsoStubAddress, nil, prioritisedGasLimit, nil)
+			require.Equal(uint64(1), receipt.Status)
+			requireNominalFee(tc, ethClient, key.EthAddress(), receipt.BlockNumber, receiptFee(receipt))
+		})
+
+		tc.By("calling with a gas limit above the maximum", func() {
+			_, receipt := sendTx(tc, ethClient, key, &ftsoStubAddress, nil, nonPrioritisedGasLimit, nil)
+			require.Equal(uint64(1), receipt.Status)
+			requireFullFee(tc, ethClient, key.EthAddress(), receipt.BlockNumber, receiptFee(receipt))
+
+			result := simulateFlareCall(tc, ethClient, callArgs{
+				From: key.EthAddress(),
+				To:   &ftsoStubAddress,
+				Gas:  nonPrioritisedGasLimit,
+			}, nil)
+			require.False(result.Prioritised)
+			require.Equal("gas-cap-exceeded", result.PrioritisedReason)
+		})
+
+		tc.By("calling a contract that is not prioritised", func() {
+			recipient := e2e.NewPrivateKey(tc).EthAddress()
+			result := simulateFlareCall(tc, ethClient, callArgs{
+				From: key.EthAddress(),
+				To:   &recipient,
+				Gas:  prioritisedGasLimit,
+			}, nil)
+			require.False(result.Prioritised)
+			require.Equal("not-prioritised-contract", result.PrioritisedReason)
+		})
+	})
+})
+
+// ensureStubsDeployed deploys the stubs of the prioritised contracts with the
+// first transactions of stubDeployerKey, unless an earlier run of the suite on
+// the same network already did.
+func ensureStubsDeployed(tc tests.TestContext, ethClient ethclient.Client) {
+	require := require.New(tc)
+
+	code, err := ethClient.CodeAt(tc.DefaultContext(), ftsoStubAddress, nil)
+	require.NoError(err)
+	if len(code) > 0 {
+		return
+	}
+
+	tc.By("funding the deployer of the stubs")
+	deployer := stubDeployerKey.EthAddress()
+	_, receipt := sendTx(tc, ethClient, e2e.GetEnv(tc).PreFundedKey, &deployer, big.NewInt(5*params.Ether), e2e.DefaultGasLimit, nil)
+	require.Equal(uint64(1), receipt.Status)
+
+	tc.By("deploying the stubs of the submitter and FTSO contracts")
+	stubs := []struct {
+		address common.Address
+		code    []byte
+	}{
+		{address: submitterStubAddress, code: echoCode},
+		{address: ftsoStubAddress, code: stopCode},
+	}
+	for _, stub := range stubs {
+		_, receipt := sendTx(tc, ethClient, stubDeployerKey, nil, nil, deployGasLimit, creationCode(stub.code))
+		require.Equal(uint64(1), receipt.Status)
+		require.Equal(stub.address, receipt.ContractAddress)
+	}
+}
+
+// requireNominalFee checks that the sender of a prioritised call in the block
+// with [blockNumber] was charged the nominal fee, and that the rest of [fee] was
+// refunded.
+func requireNominalFee(tc tests.TestContext, ethClient ethclient.Client, sender common.Address, blockNumber *big.Int, fee *big.Int) {
+	require := require.New(tc)
+
+	change := balanceChange(tc, ethClient, sender, blockNumber)
+	require.Zero(new(big.Int).Neg(nominalFee).Cmp(change), "balance change %s", (*hexutil.Big)(change))
+
+	delta := getSupplyDelta(tc, ethClient, blockNumber)
+	refund := new(big.Int).Sub(fee, nominalFee)
+	require.Zero(refund.Cmp(delta.PrioritisedRefund.ToInt()))
+}
+
+// requireFullFee checks that the sender of a call in the block with
+// [blockNumber] was charged [fee].
+func requireFullFee(tc tests.TestContext, ethClient ethclient.Client, sender common.Address, blockNumber *big.Int, fee *big.Int) {
+	require := require.New(tc)
+
+	change := balanceChange(tc, ethClient, sender, blockNumber)
+	require.Zero(new(big.Int).Neg(fee).Cmp(change), "balance change %s", (*hexutil.Big)(change))
+	require.Zero(getSupplyDelta(tc, ethClient, blockNumber).PrioritisedRefund.ToInt().Sign())
+}
@@ -0,0 +1,221 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package tmpnet
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/staking/local"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+)
+
+// Flavor determines the genesis and the configuration of a new network.
+type Flavor string
+
+const (
+	// AvalancheFlavor networks use a genesis generated for their nodes and
+	// pre-funded keys.
+	AvalancheFlavor Flavor = "avalanche"
+	// LocalFlareFlavor networks use the localflare genesis embedded in the
+	// node, which deploys the Flare system contracts on the C-Chain.
+	LocalFlareFlavor Flavor = "localflare"
+	// LocalSongbirdFlavor networks use the local (Songbird) genesis embedded in
+	// the node.
+	LocalSongbirdFlavor Flavor = "local"
+
+	// eth address: 0xc783df8a850f42e7F7e57013759C285caa701eB6
+	FlareTestKeyStr = "c5e8f61d1ab959b397eecc0a37a6517b8e67a0e7cf1f4bce5591f3ed80199122"
+)
+
+var (
+	// Key funded on the C-Chain in the genesis of the localflare and local
+	// networks
+	FlareTestKey *secp256k1.PrivateKey
+
+	// Flavors are the supported flavors, the first being the default.
+	Flavors = []Flavor{AvalancheFlavor, LocalFlareFlavor, LocalSongbirdFlavor}
+
+	errUnknownFlavor    = errors.New("unknown network flavor")
+	errInvalidNodeCount = errors.New("invalid node count")
+)
+
+func init() {
+	flareTestKeyBytes, err := hex.DecodeString(FlareTestKeyStr)
+	if err != nil {
+		panic(err)
+	}
+	FlareTestKey, err = secp256k1.ToPrivateKey(flareTestKeyBytes)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ParseFlavor returns the flavor named [name].
+func ParseFlavor(name string) (Flavor, error) {
+	for _, flavor := range Flavors {
+		if string(flavor) == name {
+			return flavor, nil
+		}
+	}
+	return "", fmt.Errorf("%w %q, expected one of %s", errUnknownFlavor, name, FlavorNames())
+}
+
+// FlavorNames returns the names of the supported flavors separated by commas.
+func FlavorNames() string {
+	names := make([]string, len(Flavors))
+	for i, flavor := range Flavors {
+		names[i] = string(flavor)
+	}
+	return strings.Join(names, ", ")
+}
+
+// NetworkID returns the ID of the networks of this flavor, or zero if it is
+// set by a generated genesis.
+func (f Flavor) NetworkID() uint32 {
+	switch f {
+	case LocalFlareFlavor:
+		return constants.LocalFlareID
+	case LocalSongbirdFlavor:
+		return constants.LocalID
+	default:
+		return 0
+	}
+}
+
+// DefaultNodeCount returns the number of nodes of the networks of this flavor
+// when none is given.
+func (f Flavor) DefaultNodeCount() int {
+	if f == AvalancheFlavor {
+		return DefaultNodeCount
+	}
+	return local.NumStakers
+}
+
+// NewNetwork returns a network of this flavor with [nodeCount] nodes, or the
+// default number of nodes of the flavor if zero.
+//
+// The genesis of the localflare and local flavors is embedded in the node and
+// can't be replaced, so their nodes use the staking keys of the local
+// validators (see staking/local):
+//   - The first node of a localflare network is its genesis validator. The keys
+//     funded in the genesis are EWOQKey, on the P-Chain and the C-Chain, and
+//     FlareTestKey, on the C-Chain.
+//   - The local genesis has no validators, so sybil protection is disabled and
+//     every node validates. The key funded in the genesis is FlareTestKey, on
+//     the C-Chain.
+func (f Flavor) NewNetwork(owner string, nodeCount int) (*Network, error) {
+	if nodeCount == 0 {
+		nodeCount = f.DefaultNodeCount()
+	}
+	if f == AvalancheFlavor {
+		return &Network{
+			Owner: owner,
+			Nodes: NewNodesOrPanic(nodeCount),
+		}, nil
+	}
+
+	networkID := f.NetworkID()
+	if networkID == 0 {
+		return nil, fmt.Errorf("%w %q", errUnknownFlavor, f)
+	}
+	minNodeCount := max(len(genesis.GetConfig(networkID).InitialStakers), 1)
+	if nodeCount < minNodeCount || nodeCount > local.NumStakers {
+		return nil, fmt.Errorf("%w: %s networks need between %d and %d nodes, got %d",
+			errInvalidNodeCount,
+			f,
+			minNodeCount,
+			local.NumStakers,
+			nodeCount,
+		)
+	}
+
+	nodes, err := NewLocalNodes(nodeCount)
+	if err != nil {
+		return nil, err
+	}
+	network := &Network{
+		Owner:        owner,
+		NetworkID:    networkID,
+		ChainConfigs: FlareChainConfigs(),
+		Nodes:        nodes,
+	}
+	switch f {
+	case LocalFlareFlavor:
+		network.PreFundedKeys = []*secp256k1.PrivateKey{genesis.EWOQKey, FlareTestKey}
+	case LocalSongbirdFlavor:
+		network.PreFundedKeys = []*secp256k1.PrivateKey{FlareTestKey}
+		network.DefaultFlags = FlagsMap{
+			config.SybilProtectionEnabledKey: false,
+		}
+	}
+	return network, nil
+}
+
+// NewLocalNodes returns [count] nodes using the staking keys of the validators
+// in the genesis of the local networks.
+func NewLocalNodes(count int) ([]*Node, error) {
+	stakers, err := local.Stakers()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read local staking keys: %w", err)
+	}
+	if count > len(stakers) {
+		return nil, fmt.Errorf("%w: only %d local staking keys are available", errInvalidNodeCount, len(stakers))
+	}
+
+	nodes := make([]*Node, count)
+	for i := range nodes {
+		node := NewNode("")
+		node.Flags[config.StakingTLSKeyContentKey] = base64.StdEncoding.EncodeToString(stakers[i].TLSKey)
+		node.Flags[config.StakingCertContentKey] = base64.StdEncoding.EncodeToString(stakers[i].TLSCert)
+		node.Flags[config.StakingSignerKeyContentKey] = base64.StdEncoding.EncodeToString(stakers[i].SignerKey)
+		if err := node.EnsureKeys(); err != nil {
+			return nil, err
+		}
+		nodes[i] = node
+	}
+	return nodes, nil
+}
+
+// FlareChainConfigs returns the configuration of the primary network chains of
+// the localflare and local networks, in addition to DefaultChainConfigs. The
+// settings that were read from environment variables by earlier versions of the
+// node are set here.
+func FlareChainConfigs() map[string]FlagsMap {
+	return map[string]FlagsMap{
+		"C": {
+			// Enables the debug and tracing APIs in addition to the default
+			// ones, as WEB3_API=debug did
+			"eth-apis": []string{
+				"eth",
+				"eth-filter",
+				"net",
+				"web3",
+				"flare",
+				"admin",
+				"debug",
+				"debug-tracer",
+				"debug-handler",
+				"internal-eth",
+				"internal-blockchain",
+				"internal-transaction",
+				"internal-tx-pool",
+				"internal-debug",
+				"internal-account",
+			},
+			// Historical state is kept so that old blocks can be traced
+			"pruning-enabled": false,
+			"rpc-gas-cap":     50_000_000,
+		},
+		"P": {
+			"staker-event-index-enabled": true,
+		},
+	}
+}
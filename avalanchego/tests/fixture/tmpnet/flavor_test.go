@@ -0,0 +1,107 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package tmpnet
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/coreth/core"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/staking/local"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+// Address of the FlareDaemon contract on the C-Chain
+var daemonContractAddress = common.HexToAddress("0x1000000000000000000000000000000000000002")
+
+func TestParseFlavor(t *testing.T) {
+	require := require.New(t)
+
+	for _, flavor := range Flavors {
+		parsed, err := ParseFlavor(string(flavor))
+		require.NoError(err)
+		require.Equal(flavor, parsed)
+	}
+
+	_, err := ParseFlavor("mainnet")
+	require.ErrorIs(err, errUnknownFlavor)
+}
+
+func TestLocalFlareNetwork(t *testing.T) {
+	require := require.New(t)
+
+	network, err := LocalFlareFlavor.NewNetwork("testnet", 0)
+	require.NoError(err)
+	require.Equal(constants.LocalFlareID, network.GetNetworkID())
+	require.Len(network.Nodes, LocalFlareFlavor.DefaultNodeCount())
+
+	// The first node is the validator of the embedded genesis
+	genesisConfig := genesis.GetConfig(constants.LocalFlareID)
+	require.Len(genesisConfig.InitialStakers, 1)
+	require.Equal(genesisConfig.InitialStakers[0].NodeID, network.Nodes[0].NodeID)
+
+	// The C-Chain genesis has the Flare chain ID, deploys the daemon contract
+	// and funds the pre-funded key
+	var cChainGenesis core.Genesis
+	require.NoError(json.Unmarshal([]byte(genesisConfig.CChainGenesis), &cChainGenesis))
+	require.Equal(big.NewInt(int64(constants.LocalFlareID)), cChainGenesis.Config.ChainID)
+	require.NotEmpty(cChainGenesis.Alloc[daemonContractAddress].Code)
+	for _, key := range network.PreFundedKeys {
+		require.Positive(cChainGenesis.Alloc[key.EthAddress()].Balance.Sign())
+	}
+
+	// The network is written without a genesis file, which can't be given for
+	// the embedded genesis
+	require.NoError(network.EnsureDefaultConfig(logging.NoLog{}, "/path/to/avalanche/go", ""))
+	require.NoError(network.Create(t.TempDir()))
+	require.Nil(network.Genesis)
+	require.Equal([]string{"eth", "eth-filter", "net", "web3", "flare", "admin", "debug", "debug-tracer", "debug-handler", "internal-eth", "internal-blockchain", "internal-transaction", "internal-tx-pool", "internal-debug", "internal-account"}, network.ChainConfigs["C"]["eth-apis"])
+	for _, node := range network.Nodes {
+		_, ok := node.Flags["genesis-file"]
+		require.False(ok)
+	}
+
+	loadedNetwork, err := ReadNetwork(network.Dir)
+	require.NoError(err)
+	require.Equal(network.NetworkID, loadedNetwork.NetworkID)
+	require.ElementsMatch(nodeIDs(network.Nodes), nodeIDs(loadedNetwork.Nodes))
+}
+
+func nodeIDs(nodes []*Node) []ids.NodeID {
+	nodeIDs := make([]ids.NodeID, len(nodes))
+	for i, node := range nodes {
+		nodeIDs[i] = node.NodeID
+	}
+	return nodeIDs
+}
+
+func TestLocalSongbirdNetwork(t *testing.T) {
+	require := require.New(t)
+
+	// Only the embedded staking keys can be used
+	_, err := LocalSongbirdFlavor.NewNetwork("testnet", local.NumStakers+1)
+	require.ErrorIs(err, errInvalidNodeCount)
+
+	network, err := LocalSongbirdFlavor.NewNetwork("testnet", 1)
+	require.NoError(err)
+	require.Equal(constants.LocalID, network.GetNetworkID())
+	require.Len(network.Nodes, 1)
+
+	// The local genesis has no validators
+	require.Empty(genesis.GetConfig(constants.LocalID).InitialStakers)
+	require.Equal(false, network.DefaultFlags[config.SybilProtectionEnabledKey])
+
+	var cChainGenesis core.Genesis
+	require.NoError(json.Unmarshal([]byte(genesis.GetConfig(constants.LocalID).CChainGenesis), &cChainGenesis))
+	require.NotEmpty(cChainGenesis.Alloc[daemonContractAddress].Code)
+	require.Positive(cChainGenesis.Alloc[FlareTestKey.EthAddress()].Balance.Sign())
+}
@@ -105,6 +105,10 @@ type Network struct {
 	// TODO(marun) Rename to PrimaryChainConfigs
 	ChainConfigs map[string]FlagsMap
 
+	// Upgrade configuration for primary network chains (P, X, C), e.g. the
+	// scheduling of precompiles on the C-Chain
+	ChainUpgrades map[string]FlagsMap
+
 	// Default configuration to use when creating new nodes
 	DefaultFlags         FlagsMap
 	DefaultRuntimeConfig NodeRuntimeConfig
@@ -239,6 +243,9 @@ func (n *Network) EnsureDefaultConfig(log logging.Logger, avalancheGoPath string
 	if n.ChainConfigs == nil {
 		n.ChainConfigs = map[string]FlagsMap{}
 	}
+	if n.ChainUpgrades == nil {
+		n.ChainUpgrades = map[string]FlagsMap{}
+	}
 	defaultChainConfigs := DefaultChainConfigs()
 	for alias, chainConfig := range defaultChainConfigs {
 		if _, ok := n.ChainConfigs[alias]; !ok {
@@ -87,6 +87,11 @@ func (n *Network) getGenesisPath() string {
 
 func (n *Network) readGenesis() error {
 	bytes, err := os.ReadFile(n.getGenesisPath())
+	if errors.Is(err, os.ErrNotExist) {
+		// The network uses a genesis embedded in avalanchego
+		n.Genesis = nil
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("failed to read genesis: %w", err)
 	}
@@ -99,6 +104,11 @@ func (n *Network) readGenesis() error {
 }
 
 func (n *Network) writeGenesis() error {
+	if n.Genesis == nil {
+		// The network uses a genesis embedded in avalanchego, which can't be
+		// provided with --genesis-file
+		return nil
+	}
 	bytes, err := DefaultJSONMarshal(n.Genesis)
 	if err != nil {
 		return fmt.Errorf("failed to marshal genesis: %w", err)
@@ -120,9 +130,10 @@ func (n *Network) readChainConfigs() error {
 		return fmt.Errorf("failed to read chain config dir: %w", err)
 	}
 
-	// Clear the map of data that may end up stale (e.g. if a given
+	// Clear the maps of data that may end up stale (e.g. if a given
 	// chain is in the map but no longer exists on disk)
 	n.ChainConfigs = map[string]FlagsMap{}
+	n.ChainUpgrades = map[string]FlagsMap{}
 
 	for _, entry := range entries {
 		if !entry.IsDir() {
@@ -131,6 +142,15 @@ func (n *Network) readChainConfigs() error {
 			continue
 		}
 		chainAlias := entry.Name()
+		upgradePath := filepath.Join(baseChainConfigDir, chainAlias, defaultUpgradeFilename)
+		if _, err := os.Stat(upgradePath); err == nil {
+			chainUpgrade, err := ReadFlagsMap(upgradePath, chainAlias+" chain upgrade")
+			if err != nil {
+				return err
+			}
+			n.ChainUpgrades[chainAlias] = chainUpgrade
+		}
+
 		configPath := filepath.Join(baseChainConfigDir, chainAlias, defaultConfigFilename)
 		if _, err := os.Stat(configPath); os.IsNotExist(err) {
 			// No config file present
@@ -163,6 +183,18 @@ func (n *Network) writeChainConfigs() error {
 		}
 	}
 
+	for chainAlias, chainUpgrade := range n.ChainUpgrades {
+		chainConfigDir := filepath.Join(baseChainConfigDir, chainAlias)
+		if err := os.MkdirAll(chainConfigDir, perms.ReadWriteExecute); err != nil {
+			return fmt.Errorf("failed to create %s chain config dir: %w", chainAlias, err)
+		}
+
+		path := filepath.Join(chainConfigDir, defaultUpgradeFilename)
+		if err := chainUpgrade.Write(path, chainAlias+" chain upgrade"); err != nil {
+			return err
+		}
+	}
+
 	// TODO(marun) Ensure the removal of chain aliases that aren't present in the map
 
 	return nil
@@ -187,6 +219,7 @@ func (n *Network) readConfig() error {
 type serializedNetworkConfig struct {
 	UUID                 string
 	Owner                string
+	NetworkID            uint32 `json:",omitempty"`
 	DefaultFlags         FlagsMap
 	DefaultRuntimeConfig NodeRuntimeConfig
 	PreFundedKeys        []*secp256k1.PrivateKey
@@ -196,6 +229,7 @@ func (n *Network) writeNetworkConfig() error {
 	config := &serializedNetworkConfig{
 		UUID:                 n.UUID,
 		Owner:                n.Owner,
+		NetworkID:            n.NetworkID,
 		DefaultFlags:         n.DefaultFlags,
 		DefaultRuntimeConfig: n.DefaultRuntimeConfig,
 		PreFundedKeys:        n.PreFundedKeys,
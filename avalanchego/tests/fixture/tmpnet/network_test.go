@@ -18,6 +18,7 @@ func TestNetworkSerialization(t *testing.T) {
 
 	network := NewDefaultNetwork("testnet")
 	require.NoError(network.EnsureDefaultConfig(logging.NoLog{}, "/path/to/avalanche/go", ""))
+	network.ChainUpgrades["C"] = FlagsMap{"precompileUpgrades": []any{}}
 	require.NoError(network.Create(tmpDir))
 	// Ensure node runtime is initialized
 	require.NoError(network.readNodes())
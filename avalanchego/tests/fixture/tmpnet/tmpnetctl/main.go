@@ -60,6 +60,7 @@ func main() {
 		avalancheGoPath string
 		pluginDir       string
 		nodeCount       uint8
+		flavorName      string
 	)
 	startNetworkCmd := &cobra.Command{
 		Use:   "start-network",
@@ -69,6 +70,11 @@ func main() {
 				return errAvalancheGoRequired
 			}
 
+			flavor, err := tmpnet.ParseFlavor(flavorName)
+			if err != nil {
+				return err
+			}
+
 			log, err := tests.LoggerForFormat("", rawLogFormat)
 			if err != nil {
 				return err
@@ -76,9 +82,9 @@ func main() {
 
 			// Root dir will be defaulted on start if not provided
 
-			network := &tmpnet.Network{
-				Owner: networkOwner,
-				Nodes: tmpnet.NewNodesOrPanic(int(nodeCount)),
+			network, err := flavor.NewNetwork(networkOwner, int(nodeCount))
+			if err != nil {
+				return err
 			}
 
 			ctx, cancel := context.WithTimeout(context.Background(), tmpnet.DefaultNetworkTimeout)
@@ -123,7 +129,18 @@ func main() {
 		tmpnet.GetEnvWithDefault(tmpnet.AvalancheGoPluginDirEnvName, os.ExpandEnv("$HOME/.avalanchego/plugins")),
 		"[optional] the dir containing VM plugins",
 	)
-	startNetworkCmd.PersistentFlags().Uint8Var(&nodeCount, "node-count", tmpnet.DefaultNodeCount, "Number of nodes the network should initially consist of")
+	startNetworkCmd.PersistentFlags().Uint8Var(
+		&nodeCount,
+		"node-count",
+		0,
+		fmt.Sprintf("Number of nodes the network should initially consist of (default %d, or %d for the localflare and local flavors)", tmpnet.DefaultNodeCount, tmpnet.LocalFlareFlavor.DefaultNodeCount()),
+	)
+	startNetworkCmd.PersistentFlags().StringVar(
+		&flavorName,
+		"flavor",
+		string(tmpnet.AvalancheFlavor),
+		"The genesis and configuration of the network, one of "+tmpnet.FlavorNames(),
+	)
 	startNetworkCmd.PersistentFlags().StringVar(&networkOwner, "network-owner", "", "The string identifying the intended owner of the network")
 	rootCmd.AddCommand(startNetworkCmd)
 
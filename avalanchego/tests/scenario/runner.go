@@ -0,0 +1,393 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/coreth/ethclient"
+	"github.com/ava-labs/coreth/plugin/evm/atomic"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// Runner issues the txs of scenarios to the node at [URI] with the primary
+// network wallet.
+type Runner struct {
+	Log logging.Logger
+	URI string
+	// Signer of the txs. If its type is not set, the signer type of the
+	// scenario is used.
+	Signer SignerConfig
+}
+
+// scenarioRun is the state of the run of a scenario.
+type scenarioRun struct {
+	wallet   *primary.Wallet
+	balances *balanceReader
+	addr     ids.ShortID
+	ethAddr  ethcommon.Address
+	owner    *secp256k1fx.OutputOwners
+	assetID  ids.ID
+}
+
+// Run issues the txs of [s] in order, and checks the changes to the balances
+// of the key after each of them. The balances are logged before and after
+// the scenario.
+func (r *Runner) Run(ctx context.Context, s *Scenario) error {
+	signerConfig := r.Signer
+	if signerConfig.Type == "" {
+		signerConfig.Type = s.Signer
+	}
+	if signerConfig.Type == "" {
+		signerConfig.Type = HashSigner
+	}
+	kc, err := signerConfig.Keychain(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize keychain: %w", err)
+	}
+
+	wallet, err := primary.MakeWallet(ctx, r.URI, kc, kc, primary.WalletConfig{
+		TypedDataSignatures: signerConfig.Type == EIP712Signer,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to initialize wallet: %w", err)
+	}
+
+	pContext := wallet.P().Builder().Context()
+	addr := kc.Addresses().List()[0]
+	ethAddr := kc.EthAddresses().List()[0]
+	pAddr, err := address.Format("P", constants.GetHRP(pContext.NetworkID), addr.Bytes())
+	if err != nil {
+		return err
+	}
+	r.Log.Info("running scenario",
+		zap.String("name", s.Name),
+		zap.String("signer", string(signerConfig.Type)),
+		zap.String("address", pAddr),
+		zap.Stringer("ethAddress", ethAddr),
+	)
+
+	balances, err := newBalanceReader(r.URI, addr, ethAddr, pContext.AVAXAssetID)
+	if err != nil {
+		return err
+	}
+	run := &scenarioRun{
+		wallet:   wallet,
+		balances: balances,
+		addr:     addr,
+		ethAddr:  ethAddr,
+		owner: &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{addr},
+		},
+		assetID: pContext.AVAXAssetID,
+	}
+
+	initialBalances, err := balances.read(ctx)
+	if err != nil {
+		return err
+	}
+	r.logBalances("initial balances", initialBalances)
+
+	before := initialBalances
+	for i, step := range s.Steps {
+		txID, err := run.issue(step)
+		if err != nil {
+			return fmt.Errorf("step %d (%s) failed: %w", i, step.Action, err)
+		}
+		r.Log.Info("issued tx",
+			zap.Int("step", i),
+			zap.String("action", string(step.Action)),
+			zap.Stringer("txID", txID),
+		)
+
+		after, err := balances.read(ctx)
+		if err != nil {
+			return err
+		}
+		for chain, expected := range step.Expect {
+			if err := expected.Check(after[chain] - before[chain]); err != nil {
+				return fmt.Errorf("step %d (%s): unexpected %s-chain balance: %w", i, step.Action, chain, err)
+			}
+		}
+		before = after
+	}
+
+	r.logBalances("final balances", before)
+	r.Log.Info("scenario completed successfully",
+		zap.String("name", s.Name),
+	)
+	return nil
+}
+
+func (r *Runner) logBalances(msg string, balances map[string]Amount) {
+	r.Log.Info(msg,
+		zap.Stringer("P", balances[PChain]),
+		zap.Stringer("X", balances[XChain]),
+		zap.Stringer("C", balances[CChain]),
+	)
+}
+
+// issue issues the tx of [step] and returns its ID once it is accepted.
+func (r *scenarioRun) issue(step *Step) (ids.ID, error) {
+	switch step.Action {
+	case ExportAction:
+		return r.export(step.From, step.To, step.Amount)
+	case ImportAction:
+		return r.importFunds(step.From, step.To)
+	case TransferAction:
+		return r.transfer(step.Chain, step.Recipient, step.Amount)
+	case AddValidatorAction:
+		return r.addValidator(step)
+	case AddDelegatorAction:
+		return r.addDelegator(step)
+	default:
+		return ids.Empty, fmt.Errorf("%w %q", errUnknownAction, step.Action)
+	}
+}
+
+func (r *scenarioRun) chainID(alias string) ids.ID {
+	switch alias {
+	case XChain:
+		return r.wallet.X().Builder().Context().BlockchainID
+	case CChain:
+		return r.wallet.C().Builder().Context().BlockchainID
+	default:
+		return constants.PlatformChainID
+	}
+}
+
+func (r *scenarioRun) outputs(amount Amount) []*avax.TransferableOutput {
+	return []*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: r.assetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          uint64(amount),
+			OutputOwners: *r.owner,
+		},
+	}}
+}
+
+func (r *scenarioRun) export(from string, to string, amount Amount) (ids.ID, error) {
+	destinationChainID := r.chainID(to)
+	switch from {
+	case PChain:
+		tx, err := r.wallet.P().IssueExportTx(destinationChainID, r.outputs(amount))
+		if err != nil {
+			return ids.Empty, err
+		}
+		return tx.ID(), nil
+	case XChain:
+		tx, err := r.wallet.X().IssueExportTx(destinationChainID, r.outputs(amount))
+		if err != nil {
+			return ids.Empty, err
+		}
+		return tx.ID(), nil
+	default:
+		tx, err := r.wallet.C().IssueExportTx(destinationChainID, []*secp256k1fx.TransferOutput{{
+			Amt:          uint64(amount),
+			OutputOwners: *r.owner,
+		}})
+		if err != nil {
+			return ids.Empty, err
+		}
+		return tx.ID(), nil
+	}
+}
+
+func (r *scenarioRun) importFunds(from string, to string) (ids.ID, error) {
+	sourceChainID := r.chainID(from)
+	switch to {
+	case PChain:
+		tx, err := r.wallet.P().IssueImportTx(sourceChainID, r.owner)
+		if err != nil {
+			return ids.Empty, err
+		}
+		return tx.ID(), nil
+	case XChain:
+		tx, err := r.wallet.X().IssueImportTx(sourceChainID, r.owner)
+		if err != nil {
+			return ids.Empty, err
+		}
+		return tx.ID(), nil
+	default:
+		tx, err := r.wallet.C().IssueImportTx(sourceChainID, r.ethAddr)
+		if err != nil {
+			return ids.Empty, err
+		}
+		return tx.ID(), nil
+	}
+}
+
+func (r *scenarioRun) transfer(chain string, recipient string, amount Amount) (ids.ID, error) {
+	outputs := r.outputs(amount)
+	if recipient != "" {
+		addr, err := address.ParseToID(recipient)
+		if err != nil {
+			return ids.Empty, fmt.Errorf("invalid recipient %q: %w", recipient, err)
+		}
+		outputs[0].Out.(*secp256k1fx.TransferOutput).Addrs = []ids.ShortID{addr}
+	}
+
+	if chain == XChain {
+		tx, err := r.wallet.X().IssueBaseTx(outputs)
+		if err != nil {
+			return ids.Empty, err
+		}
+		return tx.ID(), nil
+	}
+	tx, err := r.wallet.P().IssueBaseTx(outputs)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return tx.ID(), nil
+}
+
+// stakingPeriod returns the validator of [step] on the primary network,
+// staking from now on for [step.Duration].
+func stakingPeriod(step *Step) (*txs.SubnetValidator, error) {
+	nodeID, err := ids.NodeIDFromString(step.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	startTime := time.Now()
+	return &txs.SubnetValidator{
+		Validator: txs.Validator{
+			NodeID: nodeID,
+			Start:  uint64(startTime.Unix()),
+			End:    uint64(startTime.Add(step.Duration).Unix()),
+			Wght:   uint64(step.Weight),
+		},
+		Subnet: constants.PrimaryNetworkID,
+	}, nil
+}
+
+func (r *scenarioRun) addValidator(step *Step) (ids.ID, error) {
+	vdr, err := stakingPeriod(step)
+	if err != nil {
+		return ids.Empty, err
+	}
+	pop, err := proofOfPossession(step.BLSPublicKey, step.BLSProofOfPossession)
+	if err != nil {
+		return ids.Empty, err
+	}
+	shares := uint32(step.DelegationFee * reward.PercentDenominator / 100)
+
+	tx, err := r.wallet.P().IssueAddPermissionlessValidatorTx(
+		vdr,
+		pop,
+		r.assetID,
+		r.owner,
+		r.owner,
+		shares,
+	)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return tx.ID(), nil
+}
+
+func (r *scenarioRun) addDelegator(step *Step) (ids.ID, error) {
+	vdr, err := stakingPeriod(step)
+	if err != nil {
+		return ids.Empty, err
+	}
+	tx, err := r.wallet.P().IssueAddPermissionlessDelegatorTx(
+		vdr,
+		r.assetID,
+		r.owner,
+	)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return tx.ID(), nil
+}
+
+// proofOfPossession parses and verifies the hex encoded BLS public key of a
+// validator and its proof of possession.
+func proofOfPossession(publicKey string, proof string) (*signer.ProofOfPossession, error) {
+	publicKeyBytes, err := formatting.Decode(formatting.HexNC, publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid BLS public key: %w", err)
+	}
+	proofBytes, err := formatting.Decode(formatting.HexNC, proof)
+	if err != nil {
+		return nil, fmt.Errorf("invalid BLS proof of possession: %w", err)
+	}
+
+	pop := &signer.ProofOfPossession{}
+	copy(pop.PublicKey[:], publicKeyBytes)
+	copy(pop.ProofOfPossession[:], proofBytes)
+	if err := pop.Verify(); err != nil {
+		return nil, fmt.Errorf("invalid BLS proof of possession: %w", err)
+	}
+	return pop, nil
+}
+
+// balanceReader reads the balances of a key on the primary network chains.
+type balanceReader struct {
+	pClient   platformvm.Client
+	xClient   avm.Client
+	ethClient ethclient.Client
+	addr      ids.ShortID
+	ethAddr   ethcommon.Address
+	assetID   ids.ID
+}
+
+func newBalanceReader(uri string, addr ids.ShortID, ethAddr ethcommon.Address, assetID ids.ID) (*balanceReader, error) {
+	ethClient, err := ethclient.Dial(fmt.Sprintf("%s/ext/%s/C/rpc", uri, constants.ChainAliasPrefix))
+	if err != nil {
+		return nil, err
+	}
+	return &balanceReader{
+		pClient:   platformvm.NewClient(uri),
+		xClient:   avm.NewClient(uri, "X"),
+		ethClient: ethClient,
+		addr:      addr,
+		ethAddr:   ethAddr,
+		assetID:   assetID,
+	}, nil
+}
+
+// read returns the balances by chain alias. The C-chain balance is converted
+// from wei to nFLR.
+func (b *balanceReader) read(ctx context.Context) (map[string]Amount, error) {
+	pBalance, err := b.pClient.GetBalance(ctx, []ids.ShortID{b.addr})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get P-chain balance: %w", err)
+	}
+	xBalance, err := b.xClient.GetBalance(ctx, b.addr, b.assetID.String(), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get X-chain balance: %w", err)
+	}
+	cBalance, err := b.ethClient.BalanceAt(ctx, b.ethAddr, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get C-chain balance: %w", err)
+	}
+	cBalance.Div(cBalance, new(big.Int).SetUint64(atomic.X2CRateUint64))
+
+	return map[string]Amount{
+		PChain: Amount(pBalance.Balance),
+		XChain: Amount(xBalance.Balance),
+		CChain: Amount(cBalance.Int64()),
+	}, nil
+}
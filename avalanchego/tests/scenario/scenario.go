@@ -0,0 +1,311 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package scenario
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+// Action is the operation performed by a step of a scenario.
+type Action string
+
+const (
+	// ExportAction exports [Amount] from chain [From] to chain [To].
+	ExportAction Action = "export"
+	// ImportAction imports to chain [To] all the funds exported to it from
+	// chain [From].
+	ImportAction Action = "import"
+	// TransferAction sends [Amount] to [Recipient] on chain [Chain] (P or X).
+	TransferAction Action = "transfer"
+	// AddValidatorAction adds [NodeID] as a primary network validator.
+	AddValidatorAction Action = "addValidator"
+	// AddDelegatorAction delegates [Weight] to the validator [NodeID].
+	AddDelegatorAction Action = "addDelegator"
+)
+
+// Aliases of the primary network chains used in scenarios.
+const (
+	PChain = "P"
+	XChain = "X"
+	CChain = "C"
+)
+
+// Decimals of the amounts in scenarios, which are in FLR and are stored in
+// nFLR.
+const amountDecimals = 9
+
+var (
+	//go:embed scenarios/*.yaml
+	builtinFiles embed.FS
+
+	errUnknownAction        = errors.New("unknown action")
+	errUnknownChain         = errors.New("unknown chain")
+	errUnknownScenario      = errors.New("unknown scenario")
+	errMissingField         = errors.New("missing field")
+	errSameChain            = errors.New("source and destination chains are the same")
+	errInvalidAmount        = errors.New("invalid amount")
+	errInvalidDelegationFee = errors.New("invalid delegation fee")
+	errNoSteps              = errors.New("scenario has no steps")
+)
+
+// Scenario is a sequence of txs issued with the same key, and the changes to
+// the balances of the key that each of them is expected to make.
+type Scenario struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+	// Signer used if none is given to the runner, defaults to HashSigner
+	Signer SignerType `yaml:"signer,omitempty"`
+	Steps  []*Step    `yaml:"steps"`
+}
+
+// Step is a tx issued by a scenario. The fields that are used depend on the
+// action.
+type Step struct {
+	Action Action `yaml:"action"`
+
+	// Chain of a transfer
+	Chain string `yaml:"chain,omitempty"`
+	// Source and destination chains of an export or import
+	From string `yaml:"from,omitempty"`
+	To   string `yaml:"to,omitempty"`
+
+	// Amount of an export or transfer
+	Amount Amount `yaml:"amount,omitempty"`
+	// Recipient of a transfer, defaults to the address of the key
+	Recipient string `yaml:"recipient,omitempty"`
+
+	// Node, stake and staking period of a validator or delegator
+	NodeID   string        `yaml:"nodeID,omitempty"`
+	Weight   Amount        `yaml:"weight,omitempty"`
+	Duration time.Duration `yaml:"duration,omitempty"`
+	// BLS key of a validator and its proof of possession, hex encoded
+	BLSPublicKey         string `yaml:"blsPublicKey,omitempty"`
+	BLSProofOfPossession string `yaml:"blsProofOfPossession,omitempty"`
+	// Delegation fee of a validator, in percent
+	DelegationFee float64 `yaml:"delegationFee,omitempty"`
+
+	// Expected changes to the balances of the key, by chain alias
+	Expect map[string]BalanceChange `yaml:"expect,omitempty"`
+}
+
+// BalanceChange is the expected change to a balance made by a step. As the
+// fees are not known in advance, the actual change may be lower than
+// [Change] by at most [MaxFee].
+type BalanceChange struct {
+	Change Amount `yaml:"change"`
+	MaxFee Amount `yaml:"maxFee,omitempty"`
+}
+
+// Check returns an error if [actual] is not the expected change.
+func (b BalanceChange) Check(actual Amount) error {
+	if actual > b.Change || actual < b.Change-b.MaxFee {
+		return fmt.Errorf("balance changed by %s, expected %s (max fee %s)", actual, b.Change, b.MaxFee)
+	}
+	return nil
+}
+
+// Amount of FLR, in nFLR. It is written in FLR in scenarios, e.g. 100 or
+// -0.5.
+type Amount int64
+
+// FLR is one FLR in nFLR.
+const FLR = Amount(units.Avax)
+
+// ParseAmount parses an amount in FLR with at most 9 decimals.
+func ParseAmount(s string) (Amount, error) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), "_", "")
+	negative := strings.HasPrefix(s, "-")
+	intPart, fracPart, _ := strings.Cut(strings.TrimPrefix(s, "-"), ".")
+	if intPart == "" || len(fracPart) > amountDecimals {
+		return 0, fmt.Errorf("%w: %q", errInvalidAmount, s)
+	}
+
+	whole, err := strconv.ParseUint(intPart, 10, 64)
+	if err != nil || whole > math.MaxInt64/units.Avax {
+		return 0, fmt.Errorf("%w: %q", errInvalidAmount, s)
+	}
+	var frac uint64
+	if fracPart != "" {
+		frac, err = strconv.ParseUint(fracPart+strings.Repeat("0", amountDecimals-len(fracPart)), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q", errInvalidAmount, s)
+		}
+	}
+
+	amount := Amount(whole*units.Avax + frac)
+	if negative {
+		return -amount, nil
+	}
+	return amount, nil
+}
+
+func (a *Amount) UnmarshalYAML(value *yaml.Node) error {
+	amount, err := ParseAmount(value.Value)
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
+
+// String returns the amount in FLR.
+func (a Amount) String() string {
+	sign := ""
+	abs := uint64(a)
+	if a < 0 {
+		sign = "-"
+		abs = uint64(-a)
+	}
+	s := fmt.Sprintf("%s%d", sign, abs/units.Avax)
+	if frac := abs % units.Avax; frac != 0 {
+		s += strings.TrimRight(fmt.Sprintf(".%09d", frac), "0")
+	}
+	return s + " FLR"
+}
+
+// Parse parses a scenario in YAML, or JSON as a subset of YAML.
+func Parse(b []byte) (*Scenario, error) {
+	var s Scenario
+	if err := yaml.Unmarshal(b, &s); err != nil {
+		return nil, err
+	}
+	if err := s.Verify(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Load returns the scenario in the file at [path], or the builtin scenario
+// named [path] if no such file exists.
+func Load(filePath string) (*Scenario, error) {
+	b, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		b, err = builtinFiles.ReadFile(path.Join("scenarios", filePath+".yaml"))
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: no file or builtin scenario named %q", errUnknownScenario, filePath)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := Parse(b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scenario %q: %w", filePath, err)
+	}
+	return s, nil
+}
+
+// BuiltinNames returns the names of the scenarios embedded in the runner.
+func BuiltinNames() ([]string, error) {
+	entries, err := builtinFiles.ReadDir("scenarios")
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = strings.TrimSuffix(entry.Name(), ".yaml")
+	}
+	return names, nil
+}
+
+// Verify returns an error if a step of the scenario is missing the fields
+// needed by its action.
+func (s *Scenario) Verify() error {
+	if len(s.Steps) == 0 {
+		return errNoSteps
+	}
+	if s.Signer != "" {
+		if _, err := ParseSignerType(string(s.Signer)); err != nil {
+			return err
+		}
+	}
+	for i, step := range s.Steps {
+		if err := step.Verify(); err != nil {
+			return fmt.Errorf("step %d (%s): %w", i, step.Action, err)
+		}
+	}
+	return nil
+}
+
+// Verify returns an error if the step is missing the fields needed by its
+// action.
+func (s *Step) Verify() error {
+	for chain := range s.Expect {
+		if err := verifyChain(chain); err != nil {
+			return err
+		}
+	}
+
+	switch s.Action {
+	case ExportAction, ImportAction:
+		if err := verifyChain(s.From); err != nil {
+			return err
+		}
+		if err := verifyChain(s.To); err != nil {
+			return err
+		}
+		if s.From == s.To {
+			return fmt.Errorf("%w: %s", errSameChain, s.From)
+		}
+		if s.Action == ExportAction && s.Amount <= 0 {
+			return fmt.Errorf("%w: amount", errMissingField)
+		}
+	case TransferAction:
+		if s.Chain != PChain && s.Chain != XChain {
+			return fmt.Errorf("%w %q, expected %s or %s", errUnknownChain, s.Chain, PChain, XChain)
+		}
+		if s.Amount <= 0 {
+			return fmt.Errorf("%w: amount", errMissingField)
+		}
+	case AddValidatorAction, AddDelegatorAction:
+		switch {
+		case s.NodeID == "":
+			return fmt.Errorf("%w: nodeID", errMissingField)
+		case s.Weight <= 0:
+			return fmt.Errorf("%w: weight", errMissingField)
+		case s.Duration <= 0:
+			return fmt.Errorf("%w: duration", errMissingField)
+		}
+		if _, err := ids.NodeIDFromString(s.NodeID); err != nil {
+			return fmt.Errorf("invalid nodeID: %w", err)
+		}
+		if s.Action == AddDelegatorAction {
+			return nil
+		}
+		switch {
+		case s.BLSPublicKey == "":
+			return fmt.Errorf("%w: blsPublicKey", errMissingField)
+		case s.BLSProofOfPossession == "":
+			return fmt.Errorf("%w: blsProofOfPossession", errMissingField)
+		case s.DelegationFee < 0 || s.DelegationFee > 100:
+			return fmt.Errorf("%w: %v%%", errInvalidDelegationFee, s.DelegationFee)
+		}
+	default:
+		return fmt.Errorf("%w %q", errUnknownAction, s.Action)
+	}
+	return nil
+}
+
+func verifyChain(chain string) error {
+	switch chain {
+	case PChain, XChain, CChain:
+		return nil
+	default:
+		return fmt.Errorf("%w %q, expected %s, %s or %s", errUnknownChain, chain, PChain, XChain, CChain)
+	}
+}
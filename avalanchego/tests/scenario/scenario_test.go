@@ -0,0 +1,262 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package scenario
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/staking/local"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		s           string
+		expected    Amount
+		expectedStr string
+		expectedErr error
+	}{
+		{s: "100", expected: 100 * FLR, expectedStr: "100 FLR"},
+		{s: "10_000", expected: 10_000 * FLR, expectedStr: "10000 FLR"},
+		{s: "0.5", expected: FLR / 2, expectedStr: "0.5 FLR"},
+		{s: "-1.000000001", expected: -(FLR + 1), expectedStr: "-1.000000001 FLR"},
+		{s: "0", expected: 0, expectedStr: "0 FLR"},
+		{s: "1.0000000001", expectedErr: errInvalidAmount},
+		{s: "", expectedErr: errInvalidAmount},
+		{s: ".5", expectedErr: errInvalidAmount},
+		{s: "FLR", expectedErr: errInvalidAmount},
+		{s: "100000000000", expectedErr: errInvalidAmount},
+	}
+	for _, test := range tests {
+		t.Run(test.s, func(t *testing.T) {
+			require := require.New(t)
+
+			amount, err := ParseAmount(test.s)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(test.expected, amount)
+			require.Equal(test.expectedStr, amount.String())
+		})
+	}
+}
+
+func TestBalanceChangeCheck(t *testing.T) {
+	require := require.New(t)
+
+	expected := BalanceChange{Change: -100 * FLR, MaxFee: FLR}
+	require.NoError(expected.Check(-100 * FLR))
+	require.NoError(expected.Check(-101 * FLR))
+	require.Error(expected.Check(-99 * FLR))  //nolint:forbidigo // the error is not exported
+	require.Error(expected.Check(-102 * FLR)) //nolint:forbidigo // the error is not exported
+}
+
+func TestParse(t *testing.T) {
+	require := require.New(t)
+
+	yamlScenario := `
+name: delegate
+signer: eip191
+steps:
+  - action: addDelegator
+    nodeID: NodeID-7Xhw2mDxuDS44j42TCB6U5579esbSt3Lg
+    weight: 10_000
+    duration: 1h
+    expect:
+      P: {change: -10_000, maxFee: 0.5}
+`
+	jsonScenario := `{
+  "name": "delegate",
+  "signer": "eip191",
+  "steps": [{
+    "action": "addDelegator",
+    "nodeID": "NodeID-7Xhw2mDxuDS44j42TCB6U5579esbSt3Lg",
+    "weight": "10000",
+    "duration": "1h",
+    "expect": {"P": {"change": -10000, "maxFee": 0.5}}
+  }]
+}`
+
+	expected := &Scenario{
+		Name:   "delegate",
+		Signer: EIP191Signer,
+		Steps: []*Step{{
+			Action:   AddDelegatorAction,
+			NodeID:   "NodeID-7Xhw2mDxuDS44j42TCB6U5579esbSt3Lg",
+			Weight:   10_000 * FLR,
+			Duration: time.Hour,
+			Expect: map[string]BalanceChange{
+				PChain: {Change: -10_000 * FLR, MaxFee: FLR / 2},
+			},
+		}},
+	}
+
+	for _, b := range []string{yamlScenario, jsonScenario} {
+		s, err := Parse([]byte(b))
+		require.NoError(err)
+		require.Equal(expected, s)
+	}
+}
+
+func TestStepVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		step        *Step
+		expectedErr error
+	}{
+		{
+			name:        "unknown action",
+			step:        &Step{Action: "stake"},
+			expectedErr: errUnknownAction,
+		},
+		{
+			name:        "export without amount",
+			step:        &Step{Action: ExportAction, From: CChain, To: PChain},
+			expectedErr: errMissingField,
+		},
+		{
+			name:        "import to the source chain",
+			step:        &Step{Action: ImportAction, From: PChain, To: PChain},
+			expectedErr: errSameChain,
+		},
+		{
+			name:        "transfer on the C-chain",
+			step:        &Step{Action: TransferAction, Chain: CChain, Amount: FLR},
+			expectedErr: errUnknownChain,
+		},
+		{
+			name: "unknown chain in expected balances",
+			step: &Step{
+				Action: ImportAction,
+				From:   CChain,
+				To:     PChain,
+				Expect: map[string]BalanceChange{"D": {}},
+			},
+			expectedErr: errUnknownChain,
+		},
+		{
+			name: "validator without proof of possession",
+			step: &Step{
+				Action:       AddValidatorAction,
+				NodeID:       ids.GenerateTestNodeID().String(),
+				Weight:       FLR,
+				Duration:     time.Hour,
+				BLSPublicKey: "0x00",
+			},
+			expectedErr: errMissingField,
+		},
+		{
+			name: "delegator",
+			step: &Step{
+				Action:   AddDelegatorAction,
+				NodeID:   ids.GenerateTestNodeID().String(),
+				Weight:   FLR,
+				Duration: time.Hour,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.ErrorIs(t, test.step.Verify(), test.expectedErr)
+		})
+	}
+}
+
+func TestBuiltinScenarios(t *testing.T) {
+	require := require.New(t)
+
+	names, err := BuiltinNames()
+	require.NoError(err)
+	require.Contains(names, "add-validator")
+
+	for _, name := range names {
+		s, err := Load(name)
+		require.NoError(err, name)
+		require.Equal(name, s.Name)
+	}
+
+	_, err = Load("missing-scenario")
+	require.ErrorIs(err, errUnknownScenario)
+}
+
+// The validator added by the add-validator scenario is the second node of a
+// localflare tmpnet network, which uses the keys of the second local staker.
+func TestAddValidatorScenario(t *testing.T) {
+	require := require.New(t)
+
+	s, err := Load("add-validator")
+	require.NoError(err)
+	step := s.Steps[0]
+
+	stakers, err := local.Stakers()
+	require.NoError(err)
+	tlsCert, err := staking.LoadTLSCertFromBytes(stakers[1].TLSKey, stakers[1].TLSCert)
+	require.NoError(err)
+	stakingCert, err := staking.ParseCertificate(tlsCert.Leaf.Raw)
+	require.NoError(err)
+	require.Equal(ids.NodeIDFromCert(stakingCert).String(), step.NodeID)
+
+	pop, err := proofOfPossession(step.BLSPublicKey, step.BLSProofOfPossession)
+	require.NoError(err)
+	blsSigner, err := localsigner.FromBytes(stakers[1].SignerKey)
+	require.NoError(err)
+	require.Equal(bls.PublicKeyToCompressedBytes(blsSigner.PublicKey()), pop.PublicKey[:])
+}
+
+func TestSignerConfigKeychain(t *testing.T) {
+	require := require.New(t)
+
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+	hash := hashing.ComputeHash256([]byte("unsigned tx"))
+
+	for _, signerType := range SignerTypes {
+		kc, err := SignerConfig{Type: signerType, Key: key}.Keychain(context.Background())
+		require.NoError(err)
+		addrs := kc.Addresses()
+		require.True(addrs.Contains(key.Address()))
+		ethAddrs := kc.EthAddresses()
+		require.True(ethAddrs.Contains(key.EthAddress()))
+
+		signer, ok := kc.Get(key.Address())
+		require.True(ok)
+		sig, err := signer.SignHash(hash)
+		require.NoError(err)
+
+		// The eip191 signer signs the hex encoded hash with the Ethereum
+		// prefix, the other signers sign the hash itself
+		if signerType == EIP191Signer {
+			pk, err := secp256k1fx.RecoverTextSigner([]byte(hex.EncodeToString(hash)), sig)
+			require.NoError(err)
+			require.Equal(key.Address(), pk.Address())
+		} else {
+			pk, err := secp256k1.RecoverPublicKeyFromHash(hash, sig)
+			require.NoError(err)
+			require.Equal(key.Address(), pk.Address())
+		}
+	}
+
+	_, err = SignerConfig{Type: HashSigner}.Keychain(context.Background())
+	require.ErrorIs(err, errKeyRequired)
+
+	_, err = SignerConfig{
+		Type:            EIP712Signer,
+		ExternalURI:     "http://localhost:8550",
+		ExternalAddress: common.HexToAddress("0xb3d82b1367d362de99ab59a658165aff520cbd4d"),
+	}.Keychain(context.Background())
+	require.ErrorIs(err, errExternalSignerType)
+}
@@ -0,0 +1,162 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/tests"
+	"github.com/ava-labs/avalanchego/tests/fixture/tmpnet"
+	"github.com/ava-labs/avalanchego/tests/scenario"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+)
+
+var errNoRunningNodes = errors.New("network has no running nodes")
+
+func main() {
+	rootCmd := &cobra.Command{
+		Use:   "scenariorunner",
+		Short: "Runs scenarios of primary network txs against a network",
+	}
+
+	var (
+		uri           string
+		networkDir    string
+		keyStr        string
+		signerName    string
+		signerURI     string
+		signerAddress string
+		rawLogFormat  string
+		timeout       time.Duration
+	)
+	runCmd := &cobra.Command{
+		Use:   "run [scenario file or builtin name]...",
+		Short: "Run scenarios in order",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			log, err := tests.LoggerForFormat("", rawLogFormat)
+			if err != nil {
+				return err
+			}
+
+			// Load all the scenarios before running any of them
+			scenarios := make([]*scenario.Scenario, len(args))
+			for i, arg := range args {
+				if scenarios[i], err = scenario.Load(arg); err != nil {
+					return err
+				}
+			}
+
+			key := genesis.EWOQKey
+			if len(networkDir) > 0 {
+				network, err := tmpnet.ReadNetwork(networkDir)
+				if err != nil {
+					return err
+				}
+				nodeURIs := network.GetNodeURIs()
+				if len(nodeURIs) == 0 {
+					return fmt.Errorf("%w: %s", errNoRunningNodes, networkDir)
+				}
+				uri = nodeURIs[0].URI
+				key = network.PreFundedKeys[0]
+			}
+			if len(keyStr) > 0 {
+				if key, err = parseKey(keyStr); err != nil {
+					return err
+				}
+			}
+
+			runner := &scenario.Runner{
+				Log: log,
+				URI: uri,
+				Signer: scenario.SignerConfig{
+					Key:             key,
+					ExternalURI:     signerURI,
+					ExternalAddress: common.HexToAddress(signerAddress),
+				},
+			}
+			if len(signerName) > 0 {
+				if runner.Signer.Type, err = scenario.ParseSignerType(signerName); err != nil {
+					return err
+				}
+			}
+
+			for _, s := range scenarios {
+				ctx, cancel := context.WithTimeout(context.Background(), timeout)
+				err := runner.Run(ctx, s)
+				cancel()
+				if err != nil {
+					return fmt.Errorf("scenario %q failed: %w", s.Name, err)
+				}
+			}
+			return nil
+		},
+	}
+	runCmd.PersistentFlags().StringVar(&uri, "uri", primary.LocalAPIURI, "The URI of the node the txs are issued to")
+	runCmd.PersistentFlags().StringVar(
+		&networkDir,
+		"network-dir",
+		os.Getenv(tmpnet.NetworkDirEnvName),
+		"[optional] The path to the configuration directory of a temporary network. If set, the txs are issued to its first node with its first pre-funded key.",
+	)
+	runCmd.PersistentFlags().StringVar(&keyStr, "key", "", "[optional] The hex encoded private key signing the txs (default the ewoq key, or the first pre-funded key of the temporary network)")
+	runCmd.PersistentFlags().StringVar(
+		&signerName,
+		"signer",
+		"",
+		"[optional] How the txs are signed, one of "+scenario.SignerTypeNames()+" (default the signer of the scenario, or hash)",
+	)
+	runCmd.PersistentFlags().StringVar(&signerURI, "signer-uri", "", "[optional] The URI of an external signer implementing the Clef API, which signs the txs instead of the key (requires --signer=eip191)")
+	runCmd.PersistentFlags().StringVar(&signerAddress, "signer-address", "", "[optional] The address of the account of the external signer (default its first account)")
+	runCmd.PersistentFlags().StringVar(&rawLogFormat, "log-format", logging.AutoString, logging.FormatDescription)
+	runCmd.PersistentFlags().DurationVar(&timeout, "timeout", 5*time.Minute, "The timeout of each scenario")
+	rootCmd.AddCommand(runCmd)
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the builtin scenarios",
+		RunE: func(*cobra.Command, []string) error {
+			names, err := scenario.BuiltinNames()
+			if err != nil {
+				return err
+			}
+			for _, name := range names {
+				s, err := scenario.Load(name)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(os.Stdout, "%-18s %s\n", name, s.Description)
+			}
+			return nil
+		},
+	}
+	rootCmd.AddCommand(listCmd)
+
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "scenariorunner failed: %v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+// parseKey parses a hex encoded private key, with or without a 0x prefix.
+func parseKey(keyStr string) (*secp256k1.PrivateKey, error) {
+	keyBytes, err := hex.DecodeString(strings.TrimPrefix(keyStr, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+	return secp256k1.ToPrivateKey(keyBytes)
+}
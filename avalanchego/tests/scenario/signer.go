@@ -0,0 +1,104 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package scenario
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ava-labs/avalanchego/utils/crypto/external"
+	"github.com/ava-labs/avalanchego/utils/crypto/external/externaltest"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/c"
+)
+
+// SignerType determines how the txs of a scenario are signed.
+type SignerType string
+
+const (
+	// HashSigner signs the hash of the txs with the key.
+	HashSigner SignerType = "hash"
+	// EIP191Signer signs the hex encoded hash of the txs with the standard
+	// Ethereum prefix (EIP-191), as done by personal_sign. The txs are signed
+	// with the key, or by an external signer if one is given.
+	EIP191Signer SignerType = "eip191"
+	// EIP712Signer signs the EIP-712 typed data of the P-chain and X-chain txs
+	// that support it, and the hash of the other txs, with the key.
+	EIP712Signer SignerType = "eip712"
+)
+
+var (
+	// SignerTypes are the supported signer types, the first being the default.
+	SignerTypes = []SignerType{HashSigner, EIP191Signer, EIP712Signer}
+
+	errUnknownSigner      = errors.New("unknown signer")
+	errKeyRequired        = errors.New("a key or an external signer is required")
+	errExternalSignerType = errors.New("external signers only support the eip191 signer")
+)
+
+// ParseSignerType returns the signer type named [name].
+func ParseSignerType(name string) (SignerType, error) {
+	for _, signerType := range SignerTypes {
+		if string(signerType) == name {
+			return signerType, nil
+		}
+	}
+	return "", fmt.Errorf("%w %q, expected one of %s", errUnknownSigner, name, SignerTypeNames())
+}
+
+// SignerTypeNames returns the names of the supported signer types separated by
+// commas.
+func SignerTypeNames() string {
+	names := make([]string, len(SignerTypes))
+	for i, signerType := range SignerTypes {
+		names[i] = string(signerType)
+	}
+	return strings.Join(names, ", ")
+}
+
+// Keychain is the keychain of the wallet of a scenario.
+type Keychain interface {
+	keychain.Keychain
+	c.EthKeychain
+}
+
+// SignerConfig determines the keys that sign the txs of a scenario and how.
+type SignerConfig struct {
+	Type SignerType
+	// Key signing the txs, if no external signer is given
+	Key *secp256k1.PrivateKey
+	// URI of an external signer that implements the Clef API, which signs the
+	// txs with the account [ExternalAddress]
+	ExternalURI     string
+	ExternalAddress common.Address
+}
+
+// Keychain returns the keychain of the wallet of a scenario.
+func (s SignerConfig) Keychain(ctx context.Context) (Keychain, error) {
+	if s.ExternalURI != "" {
+		if s.Type != EIP191Signer {
+			return nil, fmt.Errorf("%w, got %s", errExternalSignerType, s.Type)
+		}
+		var addrs []common.Address
+		if s.ExternalAddress != (common.Address{}) {
+			addrs = append(addrs, s.ExternalAddress)
+		}
+		return external.NewKeychain(ctx, external.NewClient(s.ExternalURI), addrs...)
+	}
+
+	if s.Key == nil {
+		return nil, errKeyRequired
+	}
+	if s.Type == EIP191Signer {
+		// The key signs the txs like an external signer would
+		return external.NewKeychain(ctx, externaltest.NewSigner(s.Key), s.Key.EthAddress())
+	}
+	return secp256k1fx.NewKeychain(s.Key), nil
+}
@@ -37,6 +37,7 @@ var (
 		DurangoTime:               time.Date(2024, time.March, 6, 16, 0, 0, 0, time.UTC),
 		EtnaTime:                  time.Date(2024, time.December, 16, 17, 0, 0, 0, time.UTC),
 		FortunaTime:               time.Date(2025, time.April, 8, 15, 0, 0, 0, time.UTC),
+		TypedDataSignaturesTime:   UnscheduledActivationTime,
 	}
 	// Fuji = Config{
 	// 	ApricotPhase1Time:            time.Date(2021, time.March, 26, 14, 0, 0, 0, time.UTC),
@@ -60,63 +61,67 @@ var (
 	//  FUpgradeTime:              UnscheduledActivationTime,
 	// }
 	Flare = Config{
-		ApricotPhase1Time:     InitiallyActiveTime,
-		ApricotPhase2Time:     InitiallyActiveTime,
-		ApricotPhase3Time:     InitiallyActiveTime,
-		ApricotPhase4Time:     InitiallyActiveTime,
-		ApricotPhase5Time:     InitiallyActiveTime,
-		ApricotPhasePre6Time:  time.Date(2024, time.December, 17, 12, 0, 0, 0, time.UTC),
-		ApricotPhase6Time:     time.Date(2024, time.December, 17, 13, 0, 0, 0, time.UTC),
-		ApricotPhasePost6Time: time.Date(2024, time.December, 17, 14, 0, 0, 0, time.UTC),
-		BanffTime:             time.Date(2024, time.December, 17, 15, 0, 0, 0, time.UTC),
-		CortinaTime:           time.Date(2025, time.May, 13, 12, 0, 0, 0, time.UTC),
-		DurangoTime:           time.Date(2025, time.August, 5, 12, 0, 0, 0, time.UTC),
-		EtnaTime:              time.Date(2025, time.December, 2, 12, 0, 0, 0, time.UTC),
-		FortunaTime:           time.Date(2026, time.April, 14, 12, 0, 0, 0, time.UTC),
+		ApricotPhase1Time:       InitiallyActiveTime,
+		ApricotPhase2Time:       InitiallyActiveTime,
+		ApricotPhase3Time:       InitiallyActiveTime,
+		ApricotPhase4Time:       InitiallyActiveTime,
+		ApricotPhase5Time:       InitiallyActiveTime,
+		ApricotPhasePre6Time:    time.Date(2024, time.December, 17, 12, 0, 0, 0, time.UTC),
+		ApricotPhase6Time:       time.Date(2024, time.December, 17, 13, 0, 0, 0, time.UTC),
+		ApricotPhasePost6Time:   time.Date(2024, time.December, 17, 14, 0, 0, 0, time.UTC),
+		BanffTime:               time.Date(2024, time.December, 17, 15, 0, 0, 0, time.UTC),
+		CortinaTime:             time.Date(2025, time.May, 13, 12, 0, 0, 0, time.UTC),
+		DurangoTime:             time.Date(2025, time.August, 5, 12, 0, 0, 0, time.UTC),
+		EtnaTime:                time.Date(2025, time.December, 2, 12, 0, 0, 0, time.UTC),
+		FortunaTime:             time.Date(2026, time.April, 14, 12, 0, 0, 0, time.UTC),
+		TypedDataSignaturesTime: UnscheduledActivationTime,
 	}
 	Songbird = Config{
-		ApricotPhase1Time:     InitiallyActiveTime,
-		ApricotPhase2Time:     InitiallyActiveTime,
-		ApricotPhase3Time:     time.Date(2022, time.March, 7, 14, 0, 0, 0, time.UTC),
-		ApricotPhase4Time:     time.Date(2022, time.March, 7, 15, 0, 0, 0, time.UTC),
-		ApricotPhase5Time:     time.Date(2022, time.March, 7, 16, 0, 0, 0, time.UTC),
-		ApricotPhasePre6Time:  time.Date(2025, time.January, 28, 12, 0, 0, 0, time.UTC),
-		ApricotPhase6Time:     time.Date(2025, time.January, 28, 13, 0, 0, 0, time.UTC),
-		ApricotPhasePost6Time: time.Date(2025, time.January, 28, 14, 0, 0, 0, time.UTC),
-		BanffTime:             time.Date(2025, time.January, 28, 15, 0, 0, 0, time.UTC),
-		CortinaTime:           time.Date(2025, time.May, 6, 12, 0, 0, 0, time.UTC),
-		DurangoTime:           time.Date(2025, time.July, 22, 12, 0, 0, 0, time.UTC),
-		EtnaTime:              time.Date(2025, time.November, 25, 12, 0, 0, 0, time.UTC),
-		FortunaTime:           time.Date(2026, time.March, 31, 12, 0, 0, 0, time.UTC),
+		ApricotPhase1Time:       InitiallyActiveTime,
+		ApricotPhase2Time:       InitiallyActiveTime,
+		ApricotPhase3Time:       time.Date(2022, time.March, 7, 14, 0, 0, 0, time.UTC),
+		ApricotPhase4Time:       time.Date(2022, time.March, 7, 15, 0, 0, 0, time.UTC),
+		ApricotPhase5Time:       time.Date(2022, time.March, 7, 16, 0, 0, 0, time.UTC),
+		ApricotPhasePre6Time:    time.Date(2025, time.January, 28, 12, 0, 0, 0, time.UTC),
+		ApricotPhase6Time:       time.Date(2025, time.January, 28, 13, 0, 0, 0, time.UTC),
+		ApricotPhasePost6Time:   time.Date(2025, time.January, 28, 14, 0, 0, 0, time.UTC),
+		BanffTime:               time.Date(2025, time.January, 28, 15, 0, 0, 0, time.UTC),
+		CortinaTime:             time.Date(2025, time.May, 6, 12, 0, 0, 0, time.UTC),
+		DurangoTime:             time.Date(2025, time.July, 22, 12, 0, 0, 0, time.UTC),
+		EtnaTime:                time.Date(2025, time.November, 25, 12, 0, 0, 0, time.UTC),
+		FortunaTime:             time.Date(2026, time.March, 31, 12, 0, 0, 0, time.UTC),
+		TypedDataSignaturesTime: UnscheduledActivationTime,
 	}
 	Costwo = Config{
-		ApricotPhase1Time:     InitiallyActiveTime,
-		ApricotPhase2Time:     InitiallyActiveTime,
-		ApricotPhase3Time:     InitiallyActiveTime,
-		ApricotPhase4Time:     InitiallyActiveTime,
-		ApricotPhase5Time:     InitiallyActiveTime,
-		ApricotPhasePre6Time:  time.Date(2024, time.November, 26, 12, 0, 0, 0, time.UTC),
-		ApricotPhase6Time:     time.Date(2024, time.November, 26, 13, 0, 0, 0, time.UTC),
-		ApricotPhasePost6Time: time.Date(2024, time.November, 26, 14, 0, 0, 0, time.UTC),
-		BanffTime:             time.Date(2024, time.November, 26, 15, 0, 0, 0, time.UTC),
-		CortinaTime:           time.Date(2025, time.April, 8, 12, 0, 0, 0, time.UTC),
-		DurangoTime:           time.Date(2025, time.June, 24, 12, 0, 0, 0, time.UTC),
-		EtnaTime:              time.Date(2025, time.November, 13, 14, 0, 0, 0, time.UTC),
-		FortunaTime:           time.Date(2026, time.March, 24, 12, 0, 0, 0, time.UTC),
+		ApricotPhase1Time:       InitiallyActiveTime,
+		ApricotPhase2Time:       InitiallyActiveTime,
+		ApricotPhase3Time:       InitiallyActiveTime,
+		ApricotPhase4Time:       InitiallyActiveTime,
+		ApricotPhase5Time:       InitiallyActiveTime,
+		ApricotPhasePre6Time:    time.Date(2024, time.November, 26, 12, 0, 0, 0, time.UTC),
+		ApricotPhase6Time:       time.Date(2024, time.November, 26, 13, 0, 0, 0, time.UTC),
+		ApricotPhasePost6Time:   time.Date(2024, time.November, 26, 14, 0, 0, 0, time.UTC),
+		BanffTime:               time.Date(2024, time.November, 26, 15, 0, 0, 0, time.UTC),
+		CortinaTime:             time.Date(2025, time.April, 8, 12, 0, 0, 0, time.UTC),
+		DurangoTime:             time.Date(2025, time.June, 24, 12, 0, 0, 0, time.UTC),
+		EtnaTime:                time.Date(2025, time.November, 13, 14, 0, 0, 0, time.UTC),
+		FortunaTime:             time.Date(2026, time.March, 24, 12, 0, 0, 0, time.UTC),
+		TypedDataSignaturesTime: UnscheduledActivationTime,
 	}
 	Coston = Config{
-		ApricotPhase1Time:     InitiallyActiveTime,
-		ApricotPhase2Time:     InitiallyActiveTime,
-		ApricotPhase3Time:     time.Date(2022, time.February, 25, 14, 0, 0, 0, time.UTC),
-		ApricotPhase4Time:     time.Date(2022, time.February, 25, 15, 0, 0, 0, time.UTC),
-		ApricotPhasePre6Time:  time.Date(2025, time.January, 7, 12, 0, 0, 0, time.UTC),
-		ApricotPhase6Time:     time.Date(2025, time.January, 7, 13, 0, 0, 0, time.UTC),
-		ApricotPhasePost6Time: time.Date(2025, time.January, 7, 14, 0, 0, 0, time.UTC),
-		BanffTime:             time.Date(2025, time.January, 7, 15, 0, 0, 0, time.UTC),
-		CortinaTime:           time.Date(2025, time.March, 27, 13, 0, 0, 0, time.UTC),
-		DurangoTime:           time.Date(2025, time.July, 1, 12, 0, 0, 0, time.UTC),
-		EtnaTime:              time.Date(2025, time.November, 13, 10, 0, 0, 0, time.UTC),
-		FortunaTime:           time.Date(2026, time.March, 17, 12, 0, 0, 0, time.UTC),
+		ApricotPhase1Time:       InitiallyActiveTime,
+		ApricotPhase2Time:       InitiallyActiveTime,
+		ApricotPhase3Time:       time.Date(2022, time.February, 25, 14, 0, 0, 0, time.UTC),
+		ApricotPhase4Time:       time.Date(2022, time.February, 25, 15, 0, 0, 0, time.UTC),
+		ApricotPhasePre6Time:    time.Date(2025, time.January, 7, 12, 0, 0, 0, time.UTC),
+		ApricotPhase6Time:       time.Date(2025, time.January, 7, 13, 0, 0, 0, time.UTC),
+		ApricotPhasePost6Time:   time.Date(2025, time.January, 7, 14, 0, 0, 0, time.UTC),
+		BanffTime:               time.Date(2025, time.January, 7, 15, 0, 0, 0, time.UTC),
+		CortinaTime:             time.Date(2025, time.March, 27, 13, 0, 0, 0, time.UTC),
+		DurangoTime:             time.Date(2025, time.July, 1, 12, 0, 0, 0, time.UTC),
+		EtnaTime:                time.Date(2025, time.November, 13, 10, 0, 0, 0, time.UTC),
+		FortunaTime:             time.Date(2026, time.March, 17, 12, 0, 0, 0, time.UTC),
+		TypedDataSignaturesTime: UnscheduledActivationTime,
 	}
 	LocalFlare = Config{
 		ApricotPhase1Time:            ZeroTime,
@@ -134,6 +139,7 @@ var (
 		DurangoTime:                  ZeroTime,
 		EtnaTime:                     ZeroTime,
 		FortunaTime:                  ZeroTime,
+		TypedDataSignaturesTime:      ZeroTime,
 	}
 	Local = Config{
 		ApricotPhase1Time:            ZeroTime,
@@ -151,6 +157,7 @@ var (
 		DurangoTime:                  ZeroTime,
 		EtnaTime:                     ZeroTime,
 		FortunaTime:                  ZeroTime,
+		TypedDataSignaturesTime:      ZeroTime,
 	}
 	Default = Config{
 		ApricotPhase1Time:            InitiallyActiveTime,
@@ -168,6 +175,7 @@ var (
 		DurangoTime:                  InitiallyActiveTime,
 		EtnaTime:                     InitiallyActiveTime,
 		FortunaTime:                  InitiallyActiveTime,
+		TypedDataSignaturesTime:      InitiallyActiveTime,
 	}
 
 	ErrInvalidUpgradeTimes = errors.New("invalid upgrade configuration")
@@ -189,6 +197,10 @@ type Config struct {
 	DurangoTime                  time.Time `json:"durangoTime"`
 	EtnaTime                     time.Time `json:"etnaTime"`
 	FortunaTime                  time.Time `json:"fortunaTime"`
+	// TypedDataSignaturesTime is the activation time of the credentials
+	// signing the EIP-712 typed data of P-chain and X-chain txs. It is not
+	// ordered with the other upgrades.
+	TypedDataSignaturesTime time.Time `json:"typedDataSignaturesTime"`
 }
 
 func (c *Config) Validate() error {
@@ -273,6 +285,10 @@ func (c *Config) IsFortunaActivated(t time.Time) bool {
 	return !t.Before(c.FortunaTime)
 }
 
+func (c *Config) IsTypedDataSignaturesActivated(t time.Time) bool {
+	return !t.Before(c.TypedDataSignaturesTime)
+}
+
 func GetConfig(networkID uint32) Config {
 	switch networkID {
 	case constants.FlareID:
@@ -0,0 +1,59 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+// Package external signs txs with keys held by an external signer, such as
+// Clef, that is reached over JSON-RPC.
+package external
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ava-labs/avalanchego/utils/rpc"
+)
+
+// TextContentType is the content type of the data signed with the standard
+// Ethereum prefix, see accounts.TextHash.
+const TextContentType = "text/plain"
+
+var _ Client = (*client)(nil)
+
+// Client for an external signer that implements the Clef API.
+type Client interface {
+	// Accounts returns the addresses of the accounts of the signer.
+	Accounts(ctx context.Context, options ...rpc.Option) ([]common.Address, error)
+	// SignText returns the signature of [text], with the standard Ethereum
+	// prefix, by the account with [addr]. The signature has the format
+	// [r || s || v], where v is 27 or 28.
+	SignText(ctx context.Context, addr common.Address, text []byte, options ...rpc.Option) ([]byte, error)
+}
+
+// client implementation for an external signer
+type client struct {
+	requester rpc.EndpointRequester
+}
+
+// NewClient returns a Client for the external signer at [uri].
+func NewClient(uri string) Client {
+	return &client{
+		requester: rpc.NewEndpointRequester(uri),
+	}
+}
+
+func (c *client) Accounts(ctx context.Context, options ...rpc.Option) ([]common.Address, error) {
+	var res []common.Address
+	err := c.requester.SendRequest(ctx, "account_list", []interface{}{}, &res, options...)
+	return res, err
+}
+
+func (c *client) SignText(ctx context.Context, addr common.Address, text []byte, options ...rpc.Option) ([]byte, error) {
+	var res hexutil.Bytes
+	err := c.requester.SendRequest(ctx, "account_signData", []interface{}{
+		TextContentType,
+		addr,
+		hexutil.Bytes(text),
+	}, &res, options...)
+	return res, err
+}
@@ -0,0 +1,156 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+// Package externaltest provides a stub external signer for tests.
+package externaltest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// textContentType is the content type of the data signed with the standard
+// Ethereum prefix, as external.TextContentType.
+const textContentType = "text/plain"
+
+var (
+	errUnknownMethod       = errors.New("unknown method")
+	errUnknownAccount      = errors.New("unknown account")
+	errInvalidParams       = errors.New("invalid params")
+	errUnsupportedDataType = errors.New("unsupported content type")
+)
+
+type request struct {
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+	ID     json.RawMessage   `json:"id"`
+}
+
+type response struct {
+	Version string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *responseError  `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Signer is a stub of an external signer that implements account_list and
+// account_signData of the Clef API, signing with in-memory keys and without
+// asking for approval.
+//
+// Signer is also an external.Client, for tests that run the signer in-process.
+type Signer struct {
+	keys  map[common.Address]*secp256k1.PrivateKey
+	addrs []common.Address
+}
+
+// NewSigner returns a stub signer with [keys].
+func NewSigner(keys ...*secp256k1.PrivateKey) *Signer {
+	s := &Signer{
+		keys:  make(map[common.Address]*secp256k1.PrivateKey),
+		addrs: []common.Address{},
+	}
+	for _, key := range keys {
+		addr := key.EthAddress()
+		s.keys[addr] = key
+		s.addrs = append(s.addrs, addr)
+	}
+	return s
+}
+
+// NewServer starts an HTTP server for a stub signer with [keys]. The server
+// must be closed by the caller.
+func NewServer(keys ...*secp256k1.PrivateKey) *httptest.Server {
+	return httptest.NewServer(NewSigner(keys...))
+}
+
+func (s *Signer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res := response{
+		Version: "2.0",
+		ID:      req.ID,
+	}
+	result, err := s.handle(&req)
+	if err != nil {
+		res.Error = &responseError{
+			Code:    -32000,
+			Message: err.Error(),
+		}
+	} else {
+		res.Result = result
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+func (s *Signer) handle(req *request) (interface{}, error) {
+	switch req.Method {
+	case "account_list":
+		return s.Accounts(context.Background())
+	case "account_signData":
+		return s.signData(req.Params)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownMethod, req.Method)
+	}
+}
+
+func (s *Signer) signData(params []json.RawMessage) (hexutil.Bytes, error) {
+	if len(params) != 3 {
+		return nil, fmt.Errorf("%w: expected 3 params, got %d", errInvalidParams, len(params))
+	}
+	var (
+		contentType string
+		addr        common.Address
+		data        hexutil.Bytes
+	)
+	if err := json.Unmarshal(params[0], &contentType); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidParams, err)
+	}
+	if err := json.Unmarshal(params[1], &addr); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidParams, err)
+	}
+	if err := json.Unmarshal(params[2], &data); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidParams, err)
+	}
+	if contentType != textContentType {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedDataType, contentType)
+	}
+
+	return s.SignText(context.Background(), addr, data)
+}
+
+// Accounts returns the addresses of the keys of the signer.
+func (s *Signer) Accounts(context.Context, ...rpc.Option) ([]common.Address, error) {
+	return s.addrs, nil
+}
+
+// SignText returns the signature of [text] by the key with [addr], with the
+// recovery id as 27 or 28 like Clef.
+func (s *Signer) SignText(_ context.Context, addr common.Address, text []byte, _ ...rpc.Option) ([]byte, error) {
+	key, ok := s.keys[addr]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errUnknownAccount, addr)
+	}
+	return secp256k1fx.SignText(key, text)
+}
@@ -0,0 +1,143 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package external
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	_ keychain.Keychain = (*Keychain)(nil)
+	_ keychain.Signer   = (*signer)(nil)
+
+	ErrNoAccounts  = errors.New("external signer has no accounts")
+	ErrWrongSigner = errors.New("signature is not from the requested account")
+)
+
+// Keychain is a collection of the accounts of an external signer.
+//
+// The signers of the keychain sign the hex encoded hash of a tx with the
+// standard Ethereum prefix, which is accepted by secp256k1fx.Fx. As the
+// hash passed to SignHash is signed in the same way, the keychain can not be
+// used to sign the typed data of a tx.
+type Keychain struct {
+	avaxAddrToSigner map[ids.ShortID]*signer
+	ethAddrToSigner  map[common.Address]*signer
+	avaxAddrs        set.Set[ids.ShortID]
+	ethAddrs         set.Set[common.Address]
+}
+
+// NewKeychain returns a keychain with the accounts of the signer with
+// [ethAddrs], or all its accounts if none are given.
+//
+// The signer must sign a message with each account, as the P-chain and
+// X-chain addresses are derived from the public key of the account, which is
+// recovered from the signature.
+func NewKeychain(ctx context.Context, client Client, ethAddrs ...common.Address) (*Keychain, error) {
+	if len(ethAddrs) == 0 {
+		var err error
+		ethAddrs, err = client.Accounts(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get accounts: %w", err)
+		}
+		if len(ethAddrs) == 0 {
+			return nil, ErrNoAccounts
+		}
+	}
+
+	kc := &Keychain{
+		avaxAddrToSigner: make(map[ids.ShortID]*signer),
+		ethAddrToSigner:  make(map[common.Address]*signer),
+	}
+	for _, ethAddr := range ethAddrs {
+		message := fmt.Sprintf("Link %s to its P-chain and X-chain address", ethAddr)
+		pk, _, err := signText(ctx, client, ethAddr, []byte(message))
+		if err != nil {
+			return nil, fmt.Errorf("couldn't recover public key of %s: %w", ethAddr, err)
+		}
+
+		s := &signer{
+			client:  client,
+			ethAddr: ethAddr,
+			addr:    pk.Address(),
+		}
+		kc.avaxAddrToSigner[s.addr] = s
+		kc.ethAddrToSigner[ethAddr] = s
+		kc.avaxAddrs.Add(s.addr)
+		kc.ethAddrs.Add(ethAddr)
+	}
+	return kc, nil
+}
+
+func (kc *Keychain) Get(addr ids.ShortID) (keychain.Signer, bool) {
+	s, ok := kc.avaxAddrToSigner[addr]
+	return s, ok
+}
+
+func (kc *Keychain) Addresses() set.Set[ids.ShortID] {
+	return kc.avaxAddrs
+}
+
+func (kc *Keychain) GetEth(addr common.Address) (keychain.Signer, bool) {
+	s, ok := kc.ethAddrToSigner[addr]
+	return s, ok
+}
+
+func (kc *Keychain) EthAddresses() set.Set[common.Address] {
+	return kc.ethAddrs
+}
+
+// signer signs with an account of an external signer
+type signer struct {
+	client  Client
+	ethAddr common.Address
+	addr    ids.ShortID
+}
+
+// expects to receive a hash of the unsigned tx bytes
+func (s *signer) SignHash(hash []byte) ([]byte, error) {
+	text := hex.EncodeToString(hash)
+	_, sig, err := signText(context.Background(), s.client, s.ethAddr, []byte(text))
+	return sig, err
+}
+
+// expects to receive the unsigned tx bytes
+func (s *signer) Sign(b []byte) ([]byte, error) {
+	return s.SignHash(hashing.ComputeHash256(b))
+}
+
+func (s *signer) Address() ids.ShortID {
+	return s.addr
+}
+
+// signText returns the signature of [text] by the account with [ethAddr] in
+// the format [r || s || v] expected by secp256k1fx.Fx, together with the
+// public key of the account.
+func signText(ctx context.Context, client Client, ethAddr common.Address, text []byte) (*secp256k1.PublicKey, []byte, error) {
+	sig, err := client.SignText(ctx, ethAddr, text)
+	if err != nil {
+		return nil, nil, err
+	}
+	pk, err := secp256k1fx.RecoverTextSigner(text, sig)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pk.EthAddress() != ethAddr {
+		return nil, nil, fmt.Errorf("%w: expected %s, got %s", ErrWrongSigner, ethAddr, pk.EthAddress())
+	}
+	rawSig, err := secp256k1fx.ParseEthSignature(sig)
+	return pk, rawSig, err
+}
@@ -0,0 +1,140 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package external
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/external/externaltest"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// otherKeyClient signs with a key that is not the key of the requested
+// account.
+type otherKeyClient struct {
+	Client
+	key *secp256k1.PrivateKey
+}
+
+func (c *otherKeyClient) SignText(ctx context.Context, _ common.Address, text []byte, _ ...rpc.Option) ([]byte, error) {
+	return c.Client.SignText(ctx, c.key.EthAddress(), text)
+}
+
+func TestKeychain(t *testing.T) {
+	require := require.New(t)
+
+	keys := make([]*secp256k1.PrivateKey, 2)
+	for i := range keys {
+		var err error
+		keys[i], err = secp256k1.NewPrivateKey()
+		require.NoError(err)
+	}
+	server := externaltest.NewServer(keys...)
+	defer server.Close()
+
+	kc, err := NewKeychain(context.Background(), NewClient(server.URL))
+	require.NoError(err)
+	require.Equal(set.Of(keys[0].Address(), keys[1].Address()), kc.Addresses())
+	require.Equal(set.Of(keys[0].EthAddress(), keys[1].EthAddress()), kc.EthAddresses())
+
+	kc, err = NewKeychain(context.Background(), NewClient(server.URL), keys[1].EthAddress())
+	require.NoError(err)
+	require.Equal(set.Of(keys[1].Address()), kc.Addresses())
+
+	_, ok := kc.Get(keys[0].Address())
+	require.False(ok)
+	ethSigner, ok := kc.GetEth(keys[1].EthAddress())
+	require.True(ok)
+	require.Equal(keys[1].Address(), ethSigner.Address())
+}
+
+func TestKeychainUnknownAccount(t *testing.T) {
+	require := require.New(t)
+
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+	server := externaltest.NewServer()
+	defer server.Close()
+
+	_, err = NewKeychain(context.Background(), NewClient(server.URL))
+	require.ErrorIs(err, ErrNoAccounts)
+
+	_, err = NewKeychain(context.Background(), NewClient(server.URL), key.EthAddress())
+	require.ErrorContains(err, "unknown account")
+}
+
+func TestKeychainWrongSigner(t *testing.T) {
+	require := require.New(t)
+
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+	otherKey, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+	server := externaltest.NewServer(key, otherKey)
+	defer server.Close()
+
+	client := &otherKeyClient{
+		Client: NewClient(server.URL),
+		key:    otherKey,
+	}
+	_, err = NewKeychain(context.Background(), client, key.EthAddress())
+	require.ErrorIs(err, ErrWrongSigner)
+}
+
+func TestSignerVerifyCredentials(t *testing.T) {
+	require := require.New(t)
+
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+	server := externaltest.NewServer(key)
+	defer server.Close()
+
+	kc, err := NewKeychain(context.Background(), NewClient(server.URL))
+	require.NoError(err)
+	s, ok := kc.Get(key.Address())
+	require.True(ok)
+
+	vm := secp256k1fx.TestVM{
+		Codec: linearcodec.NewDefault(),
+		Log:   logging.NoLog{},
+	}
+	fx := secp256k1fx.Fx{}
+	require.NoError(fx.Initialize(&vm))
+	require.NoError(fx.Bootstrapping())
+	require.NoError(fx.Bootstrapped())
+
+	tx := &secp256k1fx.TestTx{UnsignedBytes: []byte{1, 2, 3}}
+	in := &secp256k1fx.Input{
+		SigIndices: []uint32{0},
+	}
+	out := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{key.Address()},
+	}
+
+	// Both the tx and its hash are signed as the hex encoded hash with the
+	// standard Ethereum prefix.
+	sig, err := s.Sign(tx.Bytes())
+	require.NoError(err)
+	hashSig, err := s.SignHash(hashing.ComputeHash256(tx.Bytes()))
+	require.NoError(err)
+	require.Equal(sig, hashSig)
+
+	cred := &secp256k1fx.Credential{
+		Sigs: make([][secp256k1.SignatureLen]byte, 1),
+	}
+	copy(cred.Sigs[0][:], sig)
+	require.NoError(fx.VerifyCredentials(tx, in, cred, out))
+}
@@ -31,11 +31,15 @@ type SemanticVerifier struct {
 }
 
 func (v *SemanticVerifier) BaseTx(tx *txs.BaseTx) error {
+	spentTx := v.spentTx(v.Tx.Unsigned)
 	for i, in := range tx.Ins {
 		// Note: Verification of the length of [t.tx.Creds] happens during
 		// syntactic verification, which happens before semantic verification.
+		//
+		// The credentials are verified against [v.Tx.Unsigned] rather than
+		// [tx], as [tx] may be embedded in the tx that was signed.
 		cred := v.Tx.Creds[i].Credential
-		if err := v.verifyTransfer(tx, in, cred); err != nil {
+		if err := v.verifyTransfer(spentTx, in, cred); err != nil {
 			return err
 		}
 	}
@@ -104,7 +108,10 @@ func (v *SemanticVerifier) ImportTx(tx *txs.ImportTx) error {
 		return err
 	}
 
-	offset := len(tx.Ins)
+	var (
+		spentTx = v.spentTx(tx)
+		offset  = len(tx.Ins)
+	)
 	for i, in := range tx.ImportedIns {
 		utxo := avax.UTXO{}
 		if _, err := v.Codec.Unmarshal(allUTXOBytes[i], &utxo); err != nil {
@@ -114,7 +121,7 @@ func (v *SemanticVerifier) ImportTx(tx *txs.ImportTx) error {
 		// Note: Verification of the length of [t.tx.Creds] happens during
 		// syntactic verification, which happens before semantic verification.
 		cred := v.Tx.Creds[i+offset].Credential
-		if err := v.verifyTransferOfUTXO(tx, in, cred, &utxo); err != nil {
+		if err := v.verifyTransferOfUTXO(spentTx, in, cred, &utxo); err != nil {
 			return err
 		}
 	}
@@ -6,7 +6,9 @@ package executor
 import (
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -211,6 +213,7 @@ func TestSemanticVerifierBaseTx(t *testing.T) {
 
 				state.EXPECT().GetUTXO(utxoID.InputID()).Return(&utxo, nil)
 				state.EXPECT().GetTx(asset.ID).Return(&createAssetTx, nil)
+				state.EXPECT().GetTimestamp().Return(time.Time{})
 
 				return state
 			},
@@ -574,6 +577,7 @@ func TestSemanticVerifierExportTx(t *testing.T) {
 
 				state.EXPECT().GetUTXO(utxoID.InputID()).Return(&utxo, nil)
 				state.EXPECT().GetTx(asset.ID).Return(&createAssetTx, nil)
+				state.EXPECT().GetTimestamp().Return(time.Time{})
 
 				return state
 			},
@@ -1035,6 +1039,7 @@ func TestSemanticVerifierImportTx(t *testing.T) {
 				state := statemock.NewChain(ctrl)
 				state.EXPECT().GetUTXO(utxoID.InputID()).Return(&utxo, nil).AnyTimes()
 				state.EXPECT().GetTx(asset.ID).Return(&createAssetTx, nil).AnyTimes()
+				state.EXPECT().GetTimestamp().Return(time.Time{}).AnyTimes()
 				return state
 			},
 			txFunc: func(require *require.Assertions) *txs.Tx {
@@ -1127,3 +1132,147 @@ func TestSemanticVerifierImportTx(t *testing.T) {
 		})
 	}
 }
+
+func TestSemanticVerifierTypedDataSignatures(t *testing.T) {
+	ctx := snowtest.Context(t, snowtest.XChainID)
+
+	typeToFxIndex := make(map[reflect.Type]int)
+	secpFx := &secp256k1fx.Fx{}
+	parser, err := txs.NewCustomParser(
+		typeToFxIndex,
+		new(mockable.Clock),
+		logging.NoWarn{},
+		[]fxs.Fx{
+			secpFx,
+		},
+	)
+	require.NoError(t, err)
+	require.NoError(t, secpFx.Bootstrapped())
+
+	activationTime := time.Unix(1_700_000_000, 0)
+	config := feeConfig
+	config.Upgrades.TypedDataSignaturesTime = activationTime
+
+	codec := parser.Codec()
+	backend := &Backend{
+		Ctx:    ctx,
+		Config: &config,
+		Fxs: []*fxs.ParsedFx{
+			{
+				ID: secp256k1fx.ID,
+				Fx: secpFx,
+			},
+		},
+		TypeToFxIndex: typeToFxIndex,
+		Codec:         codec,
+		FeeAssetID:    ids.GenerateTestID(),
+		Bootstrapped:  true,
+	}
+
+	asset := avax.Asset{
+		ID: ids.GenerateTestID(),
+	}
+	utxos := make([]*avax.UTXO, 2)
+	ins := make([]*avax.TransferableInput, len(utxos))
+	for i := range utxos {
+		utxoID := avax.UTXOID{
+			TxID:        ids.GenerateTestID(),
+			OutputIndex: uint32(i),
+		}
+		utxos[i] = &avax.UTXO{
+			UTXOID: utxoID,
+			Asset:  asset,
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 12345,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs: []ids.ShortID{
+						keys[0].Address(),
+					},
+				},
+			},
+		}
+		ins[i] = &avax.TransferableInput{
+			UTXOID: utxoID,
+			Asset:  asset,
+			In: &secp256k1fx.TransferInput{
+				Amt: 12345,
+				Input: secp256k1fx.Input{
+					SigIndices: []uint32{0},
+				},
+			},
+		}
+	}
+	createAssetTx := txs.Tx{
+		Unsigned: &txs.CreateAssetTx{
+			States: []*txs.InitialState{{
+				FxIndex: 0,
+			}},
+		},
+	}
+
+	// Sign the typed data of the tx rather than its hash
+	tx := &txs.Tx{
+		Unsigned: &txs.BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    constants.UnitTestID,
+				BlockchainID: ctx.ChainID,
+				Ins:          ins,
+			},
+		},
+	}
+	require.NoError(t, tx.SignSECP256K1Fx(
+		codec,
+		[][]*secp256k1.PrivateKey{
+			{keys[0]},
+			{keys[0]},
+		},
+	))
+	typedData, err := txs.TypedData(tx.Unsigned)
+	require.NoError(t, err)
+	typedDataHash, _, err := apitypes.TypedDataAndHash(*typedData)
+	require.NoError(t, err)
+	sig, err := keys[0].SignHash(typedDataHash)
+	require.NoError(t, err)
+	for _, cred := range tx.Creds {
+		copy(cred.Credential.(*secp256k1fx.Credential).Sigs[0][:], sig)
+	}
+
+	tests := []struct {
+		name      string
+		chainTime time.Time
+		err       error
+	}{
+		{
+			name:      "before activation",
+			chainTime: activationTime.Add(-time.Second),
+			err:       secp256k1fx.ErrWrongSig,
+		},
+		{
+			name:      "at activation",
+			chainTime: activationTime,
+			err:       nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			state := statemock.NewChain(ctrl)
+			for _, utxo := range utxos {
+				state.EXPECT().GetUTXO(utxo.InputID()).Return(utxo, nil).AnyTimes()
+			}
+			state.EXPECT().GetTx(asset.ID).Return(&createAssetTx, nil).AnyTimes()
+			// The typed data is only hashed once for all the inputs
+			state.EXPECT().GetTimestamp().Return(test.chainTime).Times(1)
+
+			err := tx.Unsigned.Visit(&SemanticVerifier{
+				Backend: backend,
+				State:   state,
+				Tx:      tx,
+			})
+			require.ErrorIs(err, test.err)
+		})
+	}
+}
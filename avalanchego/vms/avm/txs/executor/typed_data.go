@@ -0,0 +1,63 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"errors"
+
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/eip712"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	_ secp256k1fx.TypedDataTx = (*typedDataTx)(nil)
+
+	errTypedDataNotActivated = errors.New("typed data signatures are not activated")
+)
+
+// typedDataTx is a tx whose credentials may also sign its EIP-712 typed data,
+// once typed data signatures are activated at the timestamp of the state
+// verified against.
+//
+// The timestamp is only read if a credential does not sign the tx hash, so
+// that txs signed as usual are verified without it.
+type typedDataTx struct {
+	txs.UnsignedTx
+	verifier *SemanticVerifier
+
+	hashed bool
+	hash   []byte
+	err    error
+}
+
+// TypedDataHash returns the hash of the typed data of the tx, which is only
+// computed for the first input that needs it.
+func (tx *typedDataTx) TypedDataHash() ([]byte, error) {
+	if !tx.hashed {
+		tx.hash, tx.err = tx.hashTypedData()
+		tx.hashed = true
+	}
+	return tx.hash, tx.err
+}
+
+func (tx *typedDataTx) hashTypedData() ([]byte, error) {
+	chainTime := tx.verifier.State.GetTimestamp()
+	if !tx.verifier.Config.Upgrades.IsTypedDataSignaturesActivated(chainTime) {
+		return nil, errTypedDataNotActivated
+	}
+	typedData, err := txs.TypedData(tx.UnsignedTx)
+	if err != nil {
+		return nil, err
+	}
+	return eip712.Hash(typedData)
+}
+
+// spentTx returns the tx passed to the fxs to verify the credentials of [tx].
+func (v *SemanticVerifier) spentTx(tx txs.UnsignedTx) txs.UnsignedTx {
+	return &typedDataTx{
+		UnsignedTx: tx,
+		verifier:   v,
+	}
+}
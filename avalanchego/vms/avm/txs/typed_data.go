@@ -0,0 +1,56 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/ava-labs/avalanchego/vms/components/eip712"
+)
+
+// TypedDataChainAlias is the chain alias used in the typed data of X-chain txs.
+const TypedDataChainAlias = "X"
+
+// TypedData returns the EIP-712 typed data of [tx], which can be signed
+// instead of the hash of the tx. Only BaseTx, ImportTx and ExportTx moving
+// outputs of the secp256k1fx are supported.
+//
+// The unsigned bytes of [tx] must have been set.
+func TypedData(tx UnsignedTx) (*apitypes.TypedData, error) {
+	var (
+		b      *eip712.Builder
+		baseTx *BaseTx
+	)
+	switch tx := tx.(type) {
+	case *BaseTx:
+		baseTx = tx
+		b = newTypedDataBuilder("BaseTx", baseTx)
+	case *ImportTx:
+		baseTx = &tx.BaseTx
+		b = newTypedDataBuilder("ImportTx", baseTx)
+		b.AddString("sourceChain", tx.SourceChain.String())
+	case *ExportTx:
+		baseTx = &tx.BaseTx
+		b = newTypedDataBuilder("ExportTx", baseTx)
+		b.AddString("destinationChain", tx.DestinationChain.String())
+		if err := b.AddOutputs("exportedOutputs", tx.ExportedOuts); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w: %T", eip712.ErrUnsupportedTx, tx)
+	}
+
+	if err := b.AddOutputs("outputs", baseTx.Outs); err != nil {
+		return nil, err
+	}
+	return b.TypedData(), nil
+}
+
+// newTypedDataBuilder returns a builder of the typed data of a tx of
+// [primaryType] with the metadata of [tx].
+func newTypedDataBuilder(primaryType string, tx *BaseTx) *eip712.Builder {
+	return eip712.New(TypedDataChainAlias, tx.NetworkID, tx.BlockchainID, primaryType, tx.Bytes())
+}
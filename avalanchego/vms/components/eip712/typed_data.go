@@ -0,0 +1,221 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+// Package eip712 builds the EIP-712 typed data of txs, so that wallets can
+// display the contents of a tx before it is signed.
+package eip712
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+const (
+	// Version is the version of the domain of the typed data.
+	Version = "1"
+
+	domainType          = "EIP712Domain"
+	outputType          = "Output"
+	stakeableOutputType = "StakeableOutput"
+	ownerType           = "Owner"
+)
+
+var (
+	ErrUnsupportedTx     = errors.New("tx can not be signed as typed data")
+	ErrUnsupportedOutput = errors.New("output can not be signed as typed data")
+
+	errLocktimesMismatch = errors.New("number of locktimes does not match number of outputs")
+
+	domainFields = []apitypes.Type{
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "salt", Type: "bytes32"},
+	}
+	ownerFields = []apitypes.Type{
+		{Name: "locktime", Type: "uint64"},
+		{Name: "threshold", Type: "uint32"},
+		{Name: "addresses", Type: "string[]"},
+	}
+	outputFields = []apitypes.Type{
+		{Name: "assetID", Type: "string"},
+		{Name: "amount", Type: "uint64"},
+		{Name: "owner", Type: ownerType},
+	}
+	stakeableOutputFields = []apitypes.Type{
+		{Name: "assetID", Type: "string"},
+		{Name: "amount", Type: "uint64"},
+		{Name: "locktime", Type: "uint64"},
+		{Name: "owner", Type: ownerType},
+	}
+)
+
+// Builder builds the typed data of a tx field by field.
+type Builder struct {
+	chainAlias string
+	hrp        string
+	typedData  apitypes.TypedData
+}
+
+// New returns a builder of the typed data of a tx of [primaryType] on the
+// chain with [blockchainID] and [chainAlias]. The domain of the typed data is
+// bound to the network and the chain, and the message starts with the hash of
+// [unsignedBytes], so that a signature of the typed data only authorizes that
+// exact tx.
+func New(chainAlias string, networkID uint32, blockchainID ids.ID, primaryType string, unsignedBytes []byte) *Builder {
+	txHash := hashing.ComputeHash256(unsignedBytes)
+	return &Builder{
+		chainAlias: chainAlias,
+		hrp:        constants.GetHRP(networkID),
+		typedData: apitypes.TypedData{
+			Types: apitypes.Types{
+				domainType: domainFields,
+				primaryType: {
+					{Name: "txHash", Type: "bytes32"},
+				},
+			},
+			PrimaryType: primaryType,
+			Domain: apitypes.TypedDataDomain{
+				Name:    chainAlias + "-Chain",
+				Version: Version,
+				ChainId: math.NewHexOrDecimal256(int64(networkID)),
+				Salt:    hexutil.Encode(blockchainID[:]),
+			},
+			Message: apitypes.TypedDataMessage{
+				"txHash": hexutil.Encode(txHash),
+			},
+		},
+	}
+}
+
+// AddString adds a string field to the message.
+func (b *Builder) AddString(name, value string) {
+	b.add(name, "string", value)
+}
+
+// AddUint32 adds a uint32 field to the message.
+func (b *Builder) AddUint32(name string, value uint32) {
+	b.add(name, "uint32", strconv.FormatUint(uint64(value), 10))
+}
+
+// AddUint64 adds a uint64 field to the message.
+func (b *Builder) AddUint64(name string, value uint64) {
+	b.add(name, "uint64", strconv.FormatUint(value, 10))
+}
+
+// AddOwner adds a field with the addresses, threshold and locktime of [owner]
+// to the message.
+func (b *Builder) AddOwner(name string, owner *secp256k1fx.OutputOwners) error {
+	value, err := b.owner(owner)
+	if err != nil {
+		return err
+	}
+	b.typedData.Types[ownerType] = ownerFields
+	b.add(name, ownerType, value)
+	return nil
+}
+
+// AddOutputs adds a field with the asset, amount and owner of each of [outs]
+// to the message. Only outputs of the secp256k1fx are supported.
+func (b *Builder) AddOutputs(name string, outs []*avax.TransferableOutput) error {
+	values := make([]interface{}, len(outs))
+	for i, out := range outs {
+		value, err := b.output(out)
+		if err != nil {
+			return err
+		}
+		values[i] = value
+	}
+	b.typedData.Types[ownerType] = ownerFields
+	b.typedData.Types[outputType] = outputFields
+	b.add(name, outputType+"[]", values)
+	return nil
+}
+
+// AddStakeableOutputs adds a field with the asset, amount, locktime and owner
+// of each of [outs] to the message, where the locktime of outs[i] is
+// locktimes[i]. The locktime is the time until which an output is locked for
+// staking, or 0 if the output is not locked. Only outputs of the secp256k1fx
+// are supported.
+func (b *Builder) AddStakeableOutputs(name string, outs []*avax.TransferableOutput, locktimes []uint64) error {
+	if len(outs) != len(locktimes) {
+		return fmt.Errorf("%w: %d outputs with %d locktimes", errLocktimesMismatch, len(outs), len(locktimes))
+	}
+	values := make([]interface{}, len(outs))
+	for i, out := range outs {
+		value, err := b.output(out)
+		if err != nil {
+			return err
+		}
+		value["locktime"] = strconv.FormatUint(locktimes[i], 10)
+		values[i] = value
+	}
+	b.typedData.Types[ownerType] = ownerFields
+	b.typedData.Types[stakeableOutputType] = stakeableOutputFields
+	b.add(name, stakeableOutputType+"[]", values)
+	return nil
+}
+
+// TypedData returns the typed data built so far.
+func (b *Builder) TypedData() *apitypes.TypedData {
+	return &b.typedData
+}
+
+func (b *Builder) add(name, typ string, value interface{}) {
+	primaryType := b.typedData.PrimaryType
+	b.typedData.Types[primaryType] = append(b.typedData.Types[primaryType], apitypes.Type{
+		Name: name,
+		Type: typ,
+	})
+	b.typedData.Message[name] = value
+}
+
+func (b *Builder) output(out *avax.TransferableOutput) (apitypes.TypedDataMessage, error) {
+	transferOut, ok := out.Out.(*secp256k1fx.TransferOutput)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedOutput, out.Out)
+	}
+	owner, err := b.owner(&transferOut.OutputOwners)
+	if err != nil {
+		return nil, err
+	}
+	return apitypes.TypedDataMessage{
+		"assetID": out.AssetID().String(),
+		"amount":  strconv.FormatUint(transferOut.Amt, 10),
+		"owner":   owner,
+	}, nil
+}
+
+func (b *Builder) owner(owner *secp256k1fx.OutputOwners) (apitypes.TypedDataMessage, error) {
+	addrs := make([]interface{}, len(owner.Addrs))
+	for i, addr := range owner.Addrs {
+		addrStr, err := address.Format(b.chainAlias, b.hrp, addr[:])
+		if err != nil {
+			return nil, err
+		}
+		addrs[i] = addrStr
+	}
+	return apitypes.TypedDataMessage{
+		"locktime":  strconv.FormatUint(owner.Locktime, 10),
+		"threshold": strconv.FormatUint(uint64(owner.Threshold), 10),
+		"addresses": addrs,
+	}, nil
+}
+
+// Hash returns the hash of [typedData] that is signed by the wallets.
+func Hash(typedData *apitypes.TypedData) ([]byte, error) {
+	hash, _, err := apitypes.TypedDataAndHash(*typedData)
+	return hash, err
+}
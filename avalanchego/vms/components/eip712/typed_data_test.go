@@ -0,0 +1,118 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package eip712
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func newTestBuilder(t *testing.T, networkID uint32, blockchainID ids.ID) *Builder {
+	b := New("P", networkID, blockchainID, "BaseTx", []byte{1, 2, 3})
+	b.AddString("memo", "hello")
+	b.AddUint32("threshold", 1)
+	b.AddUint64("weight", 1_000_000)
+	require.NoError(t, b.AddOwner("rewardsOwner", &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{{1}},
+	}))
+	require.NoError(t, b.AddOutputs("outputs", []*avax.TransferableOutput{
+		{
+			Asset: avax.Asset{ID: ids.ID{2}},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 5,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Locktime:  10,
+					Threshold: 1,
+					Addrs:     []ids.ShortID{{3}},
+				},
+			},
+		},
+	}))
+	return b
+}
+
+func TestHashJSON(t *testing.T) {
+	require := require.New(t)
+
+	typedData := newTestBuilder(t, constants.FlareID, ids.ID{1}).TypedData()
+	hash, err := Hash(typedData)
+	require.NoError(err)
+
+	// Wallets receive the typed data as JSON, so the hash must not change
+	// when the typed data is marshalled.
+	typedDataJSON, err := json.Marshal(typedData)
+	require.NoError(err)
+	var parsedTypedData apitypes.TypedData
+	require.NoError(json.Unmarshal(typedDataJSON, &parsedTypedData))
+
+	parsedHash, err := Hash(&parsedTypedData)
+	require.NoError(err)
+	require.Equal(hash, parsedHash)
+}
+
+func TestHashDomain(t *testing.T) {
+	require := require.New(t)
+
+	hash, err := Hash(newTestBuilder(t, constants.FlareID, ids.ID{1}).TypedData())
+	require.NoError(err)
+
+	otherNetworkHash, err := Hash(newTestBuilder(t, constants.CostwoID, ids.ID{1}).TypedData())
+	require.NoError(err)
+	require.NotEqual(hash, otherNetworkHash)
+
+	otherChainHash, err := Hash(newTestBuilder(t, constants.FlareID, ids.ID{2}).TypedData())
+	require.NoError(err)
+	require.NotEqual(hash, otherChainHash)
+}
+
+func TestAddOutputsUnsupported(t *testing.T) {
+	b := New("P", constants.FlareID, ids.ID{1}, "BaseTx", nil)
+	err := b.AddOutputs("outputs", []*avax.TransferableOutput{
+		{
+			Asset: avax.Asset{ID: ids.ID{2}},
+			Out:   &avax.TestTransferable{Val: 1},
+		},
+	})
+	require.ErrorIs(t, err, ErrUnsupportedOutput)
+}
+
+func TestAddStakeableOutputs(t *testing.T) {
+	require := require.New(t)
+
+	b := New("P", constants.FlareID, ids.ID{1}, "AddPermissionlessDelegatorTx", nil)
+	outs := []*avax.TransferableOutput{
+		{
+			Asset: avax.Asset{ID: ids.ID{2}},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 5,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{{3}},
+				},
+			},
+		},
+	}
+	err := b.AddStakeableOutputs("stake", outs, nil)
+	require.ErrorIs(err, errLocktimesMismatch)
+
+	require.NoError(b.AddStakeableOutputs("stake", outs, []uint64{12345}))
+	typedData := b.TypedData()
+	require.Equal(stakeableOutputFields, typedData.Types[stakeableOutputType])
+
+	stake := typedData.Message["stake"].([]interface{})
+	require.Len(stake, 1)
+	require.Equal("12345", stake[0].(apitypes.TypedDataMessage)["locktime"])
+
+	_, err = Hash(typedData)
+	require.NoError(err)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakerfeed"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators"
 )
@@ -30,6 +31,8 @@ type acceptor struct {
 	metrics      metrics.Metrics
 	validators   validators.Manager
 	bootstrapped *utils.Atomic[bool]
+	// Nil if the staker changes aren't published
+	stakerFeed *stakerfeed.Feed
 }
 
 func (a *acceptor) BanffAbortBlock(b *block.BanffAbortBlock) error {
@@ -104,6 +107,8 @@ func (a *acceptor) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 		)
 	}
 
+	a.publishStakerChanges(b.Height(), blkState.onAcceptState)
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", "apricot atomic"),
@@ -170,6 +175,15 @@ func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 		return fmt.Errorf("failed to apply vm's state to shared memory: %w", err)
 	}
 
+	if parentState.onDecisionState != nil {
+		a.publishStakerChanges(parentState.statelessBlock.Height(), parentState.onDecisionState)
+	} else if a.stakerFeed != nil {
+		// Apricot proposal blocks don't change the staker sets, but their
+		// height must still be indexed to keep the index contiguous.
+		a.stakerFeed.Accept(parentState.statelessBlock.Height(), parentState.timestamp, &state.StakerChanges{})
+	}
+	a.publishStakerChanges(b.Height(), blkState.onAcceptState)
+
 	if onAcceptFunc := parentState.onAcceptFunc; onAcceptFunc != nil {
 		onAcceptFunc()
 	}
@@ -249,6 +263,8 @@ func (a *acceptor) standardBlock(b block.Block, blockType string) error {
 		return fmt.Errorf("failed to apply vm's state to shared memory: %w", err)
 	}
 
+	a.publishStakerChanges(b.Height(), blkState.onAcceptState)
+
 	if onAcceptFunc := blkState.onAcceptFunc; onAcceptFunc != nil {
 		onAcceptFunc()
 	}
@@ -265,6 +281,15 @@ func (a *acceptor) standardBlock(b block.Block, blockType string) error {
 	return nil
 }
 
+// publishStakerChanges publishes the changes made to the staker sets by
+// [diff], which was accepted at [height].
+func (a *acceptor) publishStakerChanges(height uint64, diff state.Diff) {
+	if a.stakerFeed == nil {
+		return
+	}
+	a.stakerFeed.Accept(height, diff.GetTimestamp(), diff.GetStakerChanges())
+}
+
 func (a *acceptor) commonAccept(b *blockState) error {
 	blk := b.statelessBlock
 	blkID := blk.ID()
@@ -13,6 +13,7 @@ import (
 	"github.com/ava-labs/avalanchego/chains/atomic"
 	"github.com/ava-labs/avalanchego/chains/atomic/atomicmock"
 	"github.com/ava-labs/avalanchego/database/databasemock"
+	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/utils"
@@ -21,6 +22,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakerfeed"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators/validatorstest"
@@ -462,3 +464,81 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 	require.True(calledOnAcceptFunc)
 	require.Equal(blk.ID(), acceptor.backend.lastAccepted)
 }
+
+func TestAcceptorIndexesApricotProposalBlock(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	s := state.NewMockState(ctrl)
+	sharedMemory := atomicmock.NewSharedMemory(ctrl)
+
+	index := stakerfeed.NewIndex(memdb.New())
+	require.NoError(index.Accept(9, nil))
+
+	parentID := ids.GenerateTestID()
+	acceptor := &acceptor{
+		backend: &backend{
+			lastAccepted: parentID,
+			blkIDToState: make(map[ids.ID]*blockState),
+			state:        s,
+			ctx: &snow.Context{
+				Log:          logging.NoLog{},
+				SharedMemory: sharedMemory,
+			},
+		},
+		metrics:      metrics.Noop,
+		validators:   validatorstest.Manager,
+		bootstrapped: &utils.Atomic[bool]{},
+		stakerFeed:   stakerfeed.New(logging.NoLog{}, index),
+	}
+
+	blk, err := block.NewApricotCommitBlock(parentID, 11 /*height*/)
+	require.NoError(err)
+
+	// Apricot proposal blocks have no decision state
+	parentOnCommitState := state.NewMockDiff(ctrl)
+	parentStatelessBlk := block.NewMockBlock(ctrl)
+	atomicRequests := make(map[ids.ID]*atomic.Requests)
+	parentState := &blockState{
+		proposalBlockState: proposalBlockState{
+			onCommitState: parentOnCommitState,
+		},
+		statelessBlock: parentStatelessBlk,
+		timestamp:      time.Unix(1_000, 0),
+		atomicRequests: atomicRequests,
+	}
+	acceptor.backend.blkIDToState[parentID] = parentState
+	acceptor.backend.blkIDToState[blk.ID()] = &blockState{
+		statelessBlock: blk,
+		onAcceptState:  parentOnCommitState,
+		timestamp:      parentState.timestamp,
+		atomicRequests: atomicRequests,
+		metrics: metrics.Block{
+			Block: blk,
+		},
+	}
+
+	batch := databasemock.NewBatch(ctrl)
+	parentStatelessBlk.EXPECT().ID().Return(parentID).AnyTimes()
+	parentStatelessBlk.EXPECT().Height().Return(blk.Height() - 1).AnyTimes()
+	s.EXPECT().SetLastAccepted(gomock.Any()).Times(2)
+	s.EXPECT().SetHeight(gomock.Any()).Times(2)
+	s.EXPECT().AddStatelessBlock(gomock.Any()).Times(2)
+	parentOnCommitState.EXPECT().Apply(s).Times(1)
+	parentOnCommitState.EXPECT().GetTimestamp().Return(parentState.timestamp).Times(1)
+	parentOnCommitState.EXPECT().GetStakerChanges().Return(&state.StakerChanges{}).Times(1)
+	s.EXPECT().CommitBatch().Return(batch, nil).Times(1)
+	sharedMemory.EXPECT().Apply(atomicRequests, batch).Return(nil).Times(1)
+	s.EXPECT().Checksum().Return(ids.Empty).Times(1)
+	s.EXPECT().Abort().Times(1)
+
+	require.NoError(acceptor.ApricotCommitBlock(blk))
+
+	// The heights of both the proposal and the commit blocks were indexed
+	firstHeight, err := index.FirstHeight()
+	require.NoError(err)
+	require.Equal(uint64(9), firstHeight)
+
+	_, _, _, err = index.GetEvents(9, blk.Height(), stakerfeed.MaxEvents)
+	require.NoError(err)
+}
@@ -38,6 +38,10 @@ type Client interface {
 	//
 	// Deprecated: GetUTXOs should be used instead.
 	GetBalance(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (*GetBalanceResponse, error)
+	// GetLinkedAddresses returns the P-, X- and C-chain addresses of the key
+	// that signed [message] with the standard Ethereum prefix, and its balance
+	// on the P Chain
+	GetLinkedAddresses(ctx context.Context, message string, signature []byte, options ...rpc.Option) (*GetLinkedAddressesReply, error)
 	// GetUTXOs returns the byte representation of the UTXOs controlled by [addrs]
 	GetUTXOs(
 		ctx context.Context,
@@ -69,6 +73,10 @@ type Client interface {
 	GetStakingAssetID(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (ids.ID, error)
 	// GetCurrentValidators returns the list of current validators for subnet with ID [subnetID]
 	GetCurrentValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]ClientPermissionlessValidator, error)
+	// GetCurrentValidatorsPage returns the page of current validators that
+	// match [args], and the nodeID of the first validator of the next page,
+	// which is nil on the last page.
+	GetCurrentValidatorsPage(ctx context.Context, args *GetCurrentValidatorsArgs, options ...rpc.Option) ([]ClientPermissionlessValidator, *ids.NodeID, error)
 	// GetL1Validator returns the requested L1 validator with [validationID] and
 	// the height at which it was calculated.
 	GetL1Validator(ctx context.Context, validationID ids.ID, options ...rpc.Option) (L1Validator, uint64, error)
@@ -88,6 +96,9 @@ type Client interface {
 	GetBlockchains(ctx context.Context, options ...rpc.Option) ([]APIBlockchain, error)
 	// IssueTx issues the transaction and returns its txID
 	IssueTx(ctx context.Context, tx []byte, options ...rpc.Option) (ids.ID, error)
+	// VerifyTx verifies the transaction without issuing it and returns the
+	// staking rules it violates
+	VerifyTx(ctx context.Context, tx []byte, options ...rpc.Option) (*VerifyTxReply, error)
 	// GetTx returns the byte representation of the transaction corresponding to [txID]
 	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetTxStatus returns the status of the transaction corresponding to [txID]
@@ -105,6 +116,19 @@ type Client interface {
 	// GetMinStake returns the minimum staking amount in nAVAX for validators
 	// and delegators respectively
 	GetMinStake(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (uint64, uint64, error)
+	// GetStakingParameters returns the staking parameters of the primary
+	// network in effect at [timestamp], or at the current chain time if zero
+	GetStakingParameters(ctx context.Context, timestamp time.Time, options ...rpc.Option) (*GetStakingParametersReply, error)
+	// GetDelegationCapacity returns the maximum stake that can be delegated to
+	// the primary network validator [nodeID] from [startTime], or the current
+	// chain time if zero, to [endTime]
+	GetDelegationCapacity(
+		ctx context.Context,
+		nodeID ids.NodeID,
+		startTime time.Time,
+		endTime time.Time,
+		options ...rpc.Option,
+	) (*GetDelegationCapacityReply, error)
 	// GetTotalStake returns the total amount (in nAVAX) staked on the network
 	GetTotalStake(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (*big.Int, error)
 	// GetRewardUTXOs returns the reward UTXOs for a transaction
@@ -122,6 +146,31 @@ type Client interface {
 		height platformapi.Height,
 		options ...rpc.Option,
 	) (map[ids.NodeID]*validators.GetValidatorOutput, error)
+	// GetStakersAt returns the validators and delegators of the primary
+	// network at the specified height or at proposerVM height if set to
+	// [platformapi.ProposedHeight], with the root of their staker tree and
+	// their proofs
+	GetStakersAt(ctx context.Context, height platformapi.Height, options ...rpc.Option) (*GetStakersAtReply, error)
+	// GetValidatorUptimeHistory returns the uptimes of [nodeID] recorded by
+	// the node in the epochs between [fromEpoch] and [toEpoch] inclusive
+	GetValidatorUptimeHistory(
+		ctx context.Context,
+		nodeID ids.NodeID,
+		fromEpoch uint64,
+		toEpoch uint64,
+		options ...rpc.Option,
+	) (*GetValidatorUptimeHistoryReply, error)
+	// GetStakerEvents returns the staker events indexed by the node for the
+	// blocks from [fromHeight] to [toHeight] inclusive, or to the last indexed
+	// block if [toHeight] is zero. At most [limit] events are returned, if not
+	// zero.
+	GetStakerEvents(
+		ctx context.Context,
+		fromHeight uint64,
+		toHeight uint64,
+		limit uint32,
+		options ...rpc.Option,
+	) (*GetStakerEventsReply, error)
 	// GetBlock returns the block with the given id.
 	GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetBlockByHeight returns the block at the given [height].
@@ -179,6 +228,19 @@ func (c *client) GetBalance(ctx context.Context, addrs []ids.ShortID, options ..
 	return res, err
 }
 
+func (c *client) GetLinkedAddresses(ctx context.Context, message string, signature []byte, options ...rpc.Option) (*GetLinkedAddressesReply, error) {
+	signatureStr, err := formatting.Encode(formatting.HexNC, signature)
+	if err != nil {
+		return nil, err
+	}
+	res := &GetLinkedAddressesReply{}
+	err = c.requester.SendRequest(ctx, "platform.getLinkedAddresses", &GetLinkedAddressesArgs{
+		Message:   message,
+		Signature: signatureStr,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetUTXOs(
 	ctx context.Context,
 	addrs []ids.ShortID,
@@ -331,6 +393,20 @@ func (c *client) GetCurrentValidators(
 	return getClientPermissionlessValidators(res.Validators)
 }
 
+func (c *client) GetCurrentValidatorsPage(
+	ctx context.Context,
+	args *GetCurrentValidatorsArgs,
+	options ...rpc.Option,
+) ([]ClientPermissionlessValidator, *ids.NodeID, error) {
+	res := &GetCurrentValidatorsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getCurrentValidators", args, res, options...)
+	if err != nil {
+		return nil, nil, err
+	}
+	validators, err := getClientPermissionlessValidators(res.Validators)
+	return validators, res.NextNodeID, err
+}
+
 // L1Validator is the response from calling GetL1Validator on the API client.
 type L1Validator struct {
 	SubnetID              ids.ID
@@ -457,6 +533,20 @@ func (c *client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Opt
 	return res.TxID, err
 }
 
+func (c *client) VerifyTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (*VerifyTxReply, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &VerifyTxReply{}
+	err = c.requester.SendRequest(ctx, "platform.verifyTx", &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedTx{}
 	err := c.requester.SendRequest(ctx, "platform.getTx", &api.GetTxArgs{
@@ -525,6 +615,35 @@ func (c *client) GetMinStake(ctx context.Context, subnetID ids.ID, options ...rp
 	return uint64(res.MinValidatorStake), uint64(res.MinDelegatorStake), err
 }
 
+func (c *client) GetStakingParameters(ctx context.Context, timestamp time.Time, options ...rpc.Option) (*GetStakingParametersReply, error) {
+	args := &GetStakingParametersArgs{}
+	if !timestamp.IsZero() {
+		args.Timestamp = json.Uint64(timestamp.Unix())
+	}
+	res := &GetStakingParametersReply{}
+	err := c.requester.SendRequest(ctx, "platform.getStakingParameters", args, res, options...)
+	return res, err
+}
+
+func (c *client) GetDelegationCapacity(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	startTime time.Time,
+	endTime time.Time,
+	options ...rpc.Option,
+) (*GetDelegationCapacityReply, error) {
+	args := &GetDelegationCapacityArgs{
+		NodeID:  nodeID,
+		EndTime: json.Uint64(endTime.Unix()),
+	}
+	if !startTime.IsZero() {
+		args.StartTime = json.Uint64(startTime.Unix())
+	}
+	res := &GetDelegationCapacityReply{}
+	err := c.requester.SendRequest(ctx, "platform.getDelegationCapacity", args, res, options...)
+	return res, err
+}
+
 func (c *client) GetTotalStake(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (*big.Int, error) {
 	res := &GetTotalStakeReply{}
 	err := c.requester.SendRequest(ctx, "platform.getTotalStake", &GetTotalStakeArgs{
@@ -576,6 +695,50 @@ func (c *client) GetValidatorsAt(
 	return res.Validators, err
 }
 
+func (c *client) GetStakersAt(
+	ctx context.Context,
+	height platformapi.Height,
+	options ...rpc.Option,
+) (*GetStakersAtReply, error) {
+	res := &GetStakersAtReply{}
+	err := c.requester.SendRequest(ctx, "platform.getStakersAt", &GetStakersAtArgs{
+		Height: height,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetValidatorUptimeHistory(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	fromEpoch uint64,
+	toEpoch uint64,
+	options ...rpc.Option,
+) (*GetValidatorUptimeHistoryReply, error) {
+	res := &GetValidatorUptimeHistoryReply{}
+	err := c.requester.SendRequest(ctx, "platform.getValidatorUptimeHistory", &GetValidatorUptimeHistoryArgs{
+		NodeID:    nodeID,
+		FromEpoch: json.Uint64(fromEpoch),
+		ToEpoch:   json.Uint64(toEpoch),
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetStakerEvents(
+	ctx context.Context,
+	fromHeight uint64,
+	toHeight uint64,
+	limit uint32,
+	options ...rpc.Option,
+) (*GetStakerEventsReply, error) {
+	res := &GetStakerEventsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getStakerEvents", &GetStakerEventsArgs{
+		FromHeight: json.Uint64(fromHeight),
+		ToHeight:   json.Uint64(toHeight),
+		Limit:      json.Uint32(limit),
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedBlock{}
 	if err := c.requester.SendRequest(ctx, "platform.getBlock", &api.GetBlockArgs{
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/platformvm/inflation"
 )
 
 var Default = Config{
@@ -45,6 +46,17 @@ type Config struct {
 	L1SubnetIDNodeIDCacheSize     int           `json:"l1-subnet-id-node-id-cache-size"`
 	ChecksumsEnabled              bool          `json:"checksums-enabled"`
 	MempoolPruneFrequency         time.Duration `json:"mempool-prune-frequency"`
+	// InflationSchedule replaces the staking phases of the embedded inflation
+	// schedule. It is only allowed on local networks.
+	InflationSchedule []inflation.Phase `json:"inflation-schedule"`
+	// UptimeHistoryEpochStart and UptimeHistoryEpochDuration define the epochs
+	// in which the uptime of the primary network validators is recorded. The
+	// history is disabled if the duration is zero.
+	UptimeHistoryEpochStart    time.Time     `json:"uptime-history-epoch-start"`
+	UptimeHistoryEpochDuration time.Duration `json:"uptime-history-epoch-duration"`
+	// StakerEventIndexEnabled stores the staker events of the blocks accepted
+	// from now on, so that they can be queried with getStakerEvents.
+	StakerEventIndexEnabled bool `json:"staker-event-index-enabled"`
 }
 
 // GetConfig returns a Config from the provided json encoded bytes. If a
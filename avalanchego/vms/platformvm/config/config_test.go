@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/vms/platformvm/inflation"
 )
 
 // Requires all values in a struct to be initialized
@@ -93,6 +95,24 @@ func TestConfigUnmarshal(t *testing.T) {
 			L1SubnetIDNodeIDCacheSize:     13,
 			ChecksumsEnabled:              true,
 			MempoolPruneFrequency:         time.Minute,
+			InflationSchedule: []inflation.Phase{{
+				Start: time.Date(2023, time.August, 1, 0, 0, 0, 0, time.UTC),
+				Settings: inflation.Settings{
+					MinValidatorStake:        1,
+					MaxValidatorStake:        2,
+					MinDelegatorStake:        3,
+					MinDelegationFee:         4,
+					MinStakeDuration:         time.Hour,
+					MinDelegateDuration:      time.Minute,
+					MaxStakeDuration:         2 * time.Hour,
+					MinFutureStartTimeOffset: time.Second,
+					MaxValidatorWeightFactor: 5,
+					MinStakeStartTime:        time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC),
+				},
+			}},
+			UptimeHistoryEpochStart:    time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			UptimeHistoryEpochDuration: 84 * time.Hour,
+			StakerEventIndexEnabled:    true,
 		}
 		verifyInitializedStruct(t, *expected)
 		verifyInitializedStruct(t, expected.Network)
@@ -14,6 +14,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm/inflation"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators/fee"
@@ -42,6 +43,10 @@ type Internal struct {
 	// Provides access to the uptime manager as a thread safe data structure
 	UptimeLockedCalculator uptime.LockedCalculator
 
+	// Provides access to the uptime history of the validators, if it is
+	// enabled in the execution config
+	UptimeLockedHistory uptime.LockedHistory
+
 	// True if the node is being run with staking enabled
 	SybilProtectionEnabled bool
 
@@ -72,6 +77,10 @@ type Internal struct {
 	// Maximum amount of time to allow a staker to stake
 	MaxStakeDuration time.Duration
 
+	// Staking phases of the primary network. If empty, the phases of the
+	// embedded inflation schedule are used.
+	InflationPhases []inflation.Phase
+
 	// Config for the minting function
 	RewardConfig reward.Config
 
@@ -0,0 +1,209 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package inflation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	_ "embed"
+
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+)
+
+// ScheduleVersion is the version of the schedule format understood by this
+// node.
+const ScheduleVersion = 1
+
+var (
+	//go:embed schedule.json
+	defaultScheduleJSON []byte
+
+	// DefaultSchedule is the schedule of the known networks, embedded in the
+	// node.
+	DefaultSchedule *Schedule
+
+	errUnsupportedVersion           = errors.New("unsupported schedule version")
+	errNoPhases                     = errors.New("no phases")
+	errUnsortedPhases               = errors.New("phases are not sorted by start time")
+	errInvalidValidatorStake        = errors.New("min validator stake is greater than max validator stake")
+	errInvalidStakeDuration         = errors.New("min stake duration is greater than max stake duration")
+	errInvalidDelegateDuration      = errors.New("min delegate duration is greater than max stake duration")
+	errInvalidValidatorWeightFactor = errors.New("max validator weight factor must be between 1 and 255")
+	errInvalidFutureStartOffset     = errors.New("min future start time offset must not be negative")
+	errInvalidMinDelegationFee      = errors.New("min delegation fee is greater than 100%")
+)
+
+func init() {
+	var err error
+	DefaultSchedule, err = ParseSchedule(defaultScheduleJSON)
+	if err != nil {
+		panic(fmt.Sprintf("failed to decode schedule.json: %v", err))
+	}
+}
+
+// Settings are the staking parameters of the primary network in effect during
+// a phase.
+type Settings struct {
+	MinValidatorStake        uint64
+	MaxValidatorStake        uint64
+	MinDelegatorStake        uint64
+	MinDelegationFee         uint32
+	MinStakeDuration         time.Duration
+	MinDelegateDuration      time.Duration
+	MaxStakeDuration         time.Duration
+	MinFutureStartTimeOffset time.Duration // Will not be checked when addPermissionlessValidator tx is used
+	MaxValidatorWeightFactor uint64
+	MinStakeStartTime        time.Time
+}
+
+// Phase is a period of a network with the same staking parameters, lasting
+// from [Start] until the start of the next phase.
+type Phase struct {
+	Start time.Time
+	Settings
+}
+
+type phaseJSON struct {
+	Start                    time.Time `json:"start"`
+	MinValidatorStake        uint64    `json:"minValidatorStake"`
+	MaxValidatorStake        uint64    `json:"maxValidatorStake"`
+	MinDelegatorStake        uint64    `json:"minDelegatorStake"`
+	MinDelegationFee         uint32    `json:"minDelegationFee"`
+	MinStakeDuration         string    `json:"minStakeDuration"`
+	MinDelegateDuration      string    `json:"minDelegateDuration"`
+	MaxStakeDuration         string    `json:"maxStakeDuration"`
+	MinFutureStartTimeOffset string    `json:"minFutureStartTimeOffset"`
+	MaxValidatorWeightFactor uint64    `json:"maxValidatorWeightFactor"`
+	MinStakeStartTime        time.Time `json:"minStakeStartTime"`
+}
+
+// MarshalJSON encodes the phase with the durations as strings, such as "336h".
+func (p Phase) MarshalJSON() ([]byte, error) {
+	return json.Marshal(phaseJSON{
+		Start:                    p.Start,
+		MinValidatorStake:        p.MinValidatorStake,
+		MaxValidatorStake:        p.MaxValidatorStake,
+		MinDelegatorStake:        p.MinDelegatorStake,
+		MinDelegationFee:         p.MinDelegationFee,
+		MinStakeDuration:         p.MinStakeDuration.String(),
+		MinDelegateDuration:      p.MinDelegateDuration.String(),
+		MaxStakeDuration:         p.MaxStakeDuration.String(),
+		MinFutureStartTimeOffset: p.MinFutureStartTimeOffset.String(),
+		MaxValidatorWeightFactor: p.MaxValidatorWeightFactor,
+		MinStakeStartTime:        p.MinStakeStartTime,
+	})
+}
+
+// UnmarshalJSON decodes a phase encoded by MarshalJSON.
+func (p *Phase) UnmarshalJSON(b []byte) error {
+	var j phaseJSON
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	durations := []struct {
+		name  string
+		value string
+		dst   *time.Duration
+	}{
+		{"minStakeDuration", j.MinStakeDuration, &p.MinStakeDuration},
+		{"minDelegateDuration", j.MinDelegateDuration, &p.MinDelegateDuration},
+		{"maxStakeDuration", j.MaxStakeDuration, &p.MaxStakeDuration},
+		{"minFutureStartTimeOffset", j.MinFutureStartTimeOffset, &p.MinFutureStartTimeOffset},
+	}
+	for _, d := range durations {
+		var err error
+		*d.dst, err = time.ParseDuration(d.value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", d.name, err)
+		}
+	}
+	p.Start = j.Start
+	p.MinValidatorStake = j.MinValidatorStake
+	p.MaxValidatorStake = j.MaxValidatorStake
+	p.MinDelegatorStake = j.MinDelegatorStake
+	p.MinDelegationFee = j.MinDelegationFee
+	p.MaxValidatorWeightFactor = j.MaxValidatorWeightFactor
+	p.MinStakeStartTime = j.MinStakeStartTime
+	return nil
+}
+
+// Schedule is a versioned set of staking phases per network name.
+type Schedule struct {
+	Version  uint32             `json:"version"`
+	Networks map[string][]Phase `json:"networks"`
+}
+
+// ParseSchedule decodes and verifies a schedule.
+func ParseSchedule(b []byte) (*Schedule, error) {
+	s := &Schedule{}
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, err
+	}
+	if s.Version != ScheduleVersion {
+		return nil, fmt.Errorf("%w: %d", errUnsupportedVersion, s.Version)
+	}
+	for network, phases := range s.Networks {
+		if err := VerifyPhases(phases); err != nil {
+			return nil, fmt.Errorf("network %s: %w", network, err)
+		}
+	}
+	return s, nil
+}
+
+// Phases returns the phases of the network with [networkID], or nil if the
+// schedule does not include the network.
+func (s *Schedule) Phases(networkID uint32) []Phase {
+	return s.Networks[constants.NetworkIDToNetworkName[networkID]]
+}
+
+// VerifyPhases checks [phases] are sorted by start time and that the settings
+// of every phase are consistent.
+func VerifyPhases(phases []Phase) error {
+	if len(phases) == 0 {
+		return errNoPhases
+	}
+	for i, p := range phases {
+		if i > 0 && !p.Start.After(phases[i-1].Start) {
+			return fmt.Errorf("phase %d: %w", i, errUnsortedPhases)
+		}
+		if err := p.Settings.verify(); err != nil {
+			return fmt.Errorf("phase %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (s *Settings) verify() error {
+	switch {
+	case s.MinValidatorStake > s.MaxValidatorStake:
+		return errInvalidValidatorStake
+	case s.MinStakeDuration > s.MaxStakeDuration:
+		return errInvalidStakeDuration
+	case s.MinDelegateDuration > s.MaxStakeDuration:
+		return errInvalidDelegateDuration
+	case s.MaxValidatorWeightFactor == 0 || s.MaxValidatorWeightFactor > 255:
+		return errInvalidValidatorWeightFactor
+	case s.MinFutureStartTimeOffset < 0:
+		return errInvalidFutureStartOffset
+	case s.MinDelegationFee > reward.PercentDenominator:
+		return errInvalidMinDelegationFee
+	default:
+		return nil
+	}
+}
+
+// SettingsAt returns the settings of the phase in effect at [timestamp], or
+// false if [timestamp] is before the first phase.
+func SettingsAt(phases []Phase, timestamp time.Time) (Settings, bool) {
+	for i := len(phases) - 1; i >= 0; i-- {
+		if !timestamp.Before(phases[i].Start) {
+			return phases[i].Settings, true
+		}
+	}
+	return Settings{}, false
+}
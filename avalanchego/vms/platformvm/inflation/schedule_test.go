@@ -0,0 +1,189 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package inflation
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+func TestDefaultSchedule(t *testing.T) {
+	flarePhase1 := Settings{
+		MinValidatorStake:        10 * units.MegaAvax,
+		MaxValidatorStake:        50 * units.MegaAvax,
+		MinDelegatorStake:        1 * units.KiloAvax,
+		MinStakeDuration:         2 * 7 * 24 * time.Hour,
+		MinDelegateDuration:      2 * 7 * 24 * time.Hour,
+		MaxStakeDuration:         365 * 24 * time.Hour,
+		MinFutureStartTimeOffset: 3 * 24 * time.Hour,
+		MaxValidatorWeightFactor: 5,
+		MinStakeStartTime:        time.Date(2023, time.July, 5, 15, 0, 0, 0, time.UTC),
+	}
+	flarePhase2 := Settings{
+		MinValidatorStake:        1 * units.MegaAvax,
+		MaxValidatorStake:        200 * units.MegaAvax,
+		MinDelegatorStake:        50 * units.KiloAvax,
+		MinStakeDuration:         60 * 24 * time.Hour,
+		MinDelegateDuration:      2 * 7 * 24 * time.Hour,
+		MaxStakeDuration:         365 * 24 * time.Hour,
+		MinFutureStartTimeOffset: 2 * 7 * 24 * time.Hour,
+		MaxValidatorWeightFactor: 15,
+		MinStakeStartTime:        time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC),
+	}
+	localFlarePhase2 := Settings{
+		MinValidatorStake:        10 * units.KiloAvax,
+		MaxValidatorStake:        9_000 * units.MegaAvax,
+		MinDelegatorStake:        10 * units.KiloAvax,
+		MinStakeDuration:         1 * time.Hour,
+		MinDelegateDuration:      30 * time.Minute,
+		MaxStakeDuration:         365 * 24 * time.Hour,
+		MinFutureStartTimeOffset: 2 * 7 * 24 * time.Hour,
+		MaxValidatorWeightFactor: 5,
+		MinStakeStartTime:        time.Date(2023, time.April, 10, 15, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name      string
+		networkID uint32
+		timestamp time.Time
+		expected  Settings
+		found     bool
+	}{
+		{
+			name:      "flare genesis",
+			networkID: constants.FlareID,
+			timestamp: time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC),
+			expected:  flarePhase1,
+			found:     true,
+		},
+		{
+			name:      "flare before phase 2",
+			networkID: constants.FlareID,
+			timestamp: time.Date(2023, time.September, 30, 23, 59, 59, 0, time.UTC),
+			expected:  flarePhase1,
+			found:     true,
+		},
+		{
+			name:      "flare at phase 2",
+			networkID: constants.FlareID,
+			timestamp: time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC),
+			expected:  flarePhase2,
+			found:     true,
+		},
+		{
+			name:      "localflare phase 2",
+			networkID: constants.LocalFlareID,
+			timestamp: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected:  localFlarePhase2,
+			found:     true,
+		},
+		{
+			name:      "songbird before first phase",
+			networkID: constants.SongbirdID,
+			timestamp: time.Date(2000, time.February, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "unknown network",
+			networkID: constants.UnitTestID,
+			timestamp: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			settings, found := SettingsAt(DefaultSchedule.Phases(test.networkID), test.timestamp)
+			require.Equal(test.found, found)
+			require.Equal(test.expected, settings)
+		})
+	}
+}
+
+func TestPhaseJSON(t *testing.T) {
+	require := require.New(t)
+
+	phases := DefaultSchedule.Phases(constants.CostwoID)
+	require.Len(phases, 2)
+
+	b, err := json.Marshal(phases)
+	require.NoError(err)
+
+	var parsed []Phase
+	require.NoError(json.Unmarshal(b, &parsed))
+	require.Equal(phases, parsed)
+}
+
+func TestParseSchedule(t *testing.T) {
+	validPhase := `{
+		"start": "2023-01-01T00:00:00Z",
+		"minValidatorStake": 1,
+		"maxValidatorStake": 2,
+		"minDelegatorStake": 1,
+		"minDelegationFee": 0,
+		"minStakeDuration": "1h",
+		"minDelegateDuration": "30m",
+		"maxStakeDuration": "2h",
+		"minFutureStartTimeOffset": "1h",
+		"maxValidatorWeightFactor": 5,
+		"minStakeStartTime": "2023-01-01T00:00:00Z"
+	}`
+	laterPhase := `{
+		"start": "2024-01-01T00:00:00Z",
+		"minValidatorStake": 3,
+		"maxValidatorStake": 2,
+		"minStakeDuration": "1h",
+		"minDelegateDuration": "1h",
+		"maxStakeDuration": "2h",
+		"minFutureStartTimeOffset": "1h",
+		"maxValidatorWeightFactor": 5
+	}`
+
+	tests := []struct {
+		name        string
+		json        string
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			json: `{"version": 1, "networks": {"local": [` + validPhase + `]}}`,
+		},
+		{
+			name:        "unsupported version",
+			json:        `{"version": 2, "networks": {"local": [` + validPhase + `]}}`,
+			expectedErr: errUnsupportedVersion,
+		},
+		{
+			name:        "no phases",
+			json:        `{"version": 1, "networks": {"local": []}}`,
+			expectedErr: errNoPhases,
+		},
+		{
+			name:        "unsorted phases",
+			json:        `{"version": 1, "networks": {"local": [` + validPhase + `,` + validPhase + `]}}`,
+			expectedErr: errUnsortedPhases,
+		},
+		{
+			name:        "invalid validator stake",
+			json:        `{"version": 1, "networks": {"local": [` + validPhase + `,` + laterPhase + `]}}`,
+			expectedErr: errInvalidValidatorStake,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseSchedule([]byte(test.json))
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestParseScheduleInvalidDuration(t *testing.T) {
+	_, err := ParseSchedule([]byte(`{"version": 1, "networks": {"local": [{"minStakeDuration": "1 day"}]}}`))
+	require.ErrorContains(t, err, "invalid minStakeDuration")
+}
@@ -11,16 +11,17 @@ import (
 	"maps"
 	"math"
 	"net/http"
-	"os"
-	"strings"
+	"slices"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"go.uber.org/zap"
 
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
@@ -30,10 +31,13 @@ import (
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakerfeed"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakertree"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -68,16 +72,13 @@ var (
 	errPrimaryNetworkIsNotASubnet = errors.New("the primary network isn't a subnet")
 	errNoAddresses                = errors.New("no addresses provided")
 	errMissingBlockchainID        = errors.New("argument 'blockchainID' not given")
-
-	completeGetValidators = false
+	errL1ValidatorsFilters        = errors.New("L1 validators can not be paginated or filtered")
+	errHeightNotAccepted          = errors.New("height is not accepted")
+	errHeightBeforeDurango        = errors.New("stakers before Durango are not supported")
+	errStartTimeInThePast         = errors.New("start time is before the current chain time")
+	errStartAfterEndTime          = errors.New("start time is not before the end time")
 )
 
-func init() {
-	// if COMPLETE_GET_VALIDATORS is set to true, the getCurrentValidators API will return delegators
-	// for every node. Otherwise, it will only return delegators if a single nodeID is provided.
-	completeGetValidators = strings.ToUpper(os.Getenv("COMPLETE_GET_VALIDATORS")) == "TRUE"
-}
-
 // Service defines the API calls that can be made to the platform chain
 type Service struct {
 	vm                    *VM
@@ -160,9 +161,19 @@ func (s *Service) GetBalance(_ *http.Request, args *GetBalanceRequest, response
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
+	if err := s.getBalance(addrs, response); err != nil {
+		return fmt.Errorf("couldn't get UTXO set of %v: %w", args.Addresses, err)
+	}
+	return nil
+}
+
+// getBalance sets the balances of [addrs] in [response].
+//
+// Assumes the ctx lock is held.
+func (s *Service) getBalance(addrs set.Set[ids.ShortID], response *GetBalanceResponse) error {
 	utxos, err := avax.GetAllUTXOs(s.vm.state, addrs)
 	if err != nil {
-		return fmt.Errorf("couldn't get UTXO set of %v: %w", args.Addresses, err)
+		return err
 	}
 
 	currentTime := s.vm.clock.Unix()
@@ -265,6 +276,62 @@ func newJSONBalanceMap(balanceMap map[ids.ID]uint64) map[ids.ID]avajson.Uint64 {
 	return jsonBalanceMap
 }
 
+// GetLinkedAddressesArgs are the arguments for calling GetLinkedAddresses
+type GetLinkedAddressesArgs struct {
+	// Message is the challenge signed by the account
+	Message string `json:"message"`
+	// Signature is the hex encoded EIP-191 signature of Message, as returned
+	// by personal_sign
+	Signature string `json:"signature"`
+}
+
+// GetLinkedAddressesReply is the response from calling GetLinkedAddresses
+type GetLinkedAddressesReply struct {
+	PChainAddress string         `json:"pChainAddress"`
+	XChainAddress string         `json:"xChainAddress"`
+	CChainAddress common.Address `json:"cChainAddress"`
+	// PChainBalance is the balance of PChainAddress. The balances on the other
+	// chains are served by their own APIs.
+	PChainBalance GetBalanceResponse `json:"pChainBalance"`
+}
+
+// GetLinkedAddresses returns the P-, X- and C-chain addresses of the key that
+// signed a challenge, and its balance on the P-chain.
+func (s *Service) GetLinkedAddresses(_ *http.Request, args *GetLinkedAddressesArgs, reply *GetLinkedAddressesReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getLinkedAddresses"),
+	)
+
+	sig, err := formatting.Decode(formatting.HexNC, args.Signature)
+	if err != nil {
+		return fmt.Errorf("couldn't decode signature: %w", err)
+	}
+	pk, err := secp256k1fx.RecoverTextSigner([]byte(args.Message), sig)
+	if err != nil {
+		return fmt.Errorf("couldn't recover signer: %w", err)
+	}
+
+	addr := pk.Address()
+	reply.PChainAddress, err = s.addrManager.FormatLocalAddress(addr)
+	if err != nil {
+		return fmt.Errorf("couldn't format P-chain address: %w", err)
+	}
+	reply.XChainAddress, err = s.addrManager.FormatAddress(s.vm.ctx.XChainID, addr)
+	if err != nil {
+		return fmt.Errorf("couldn't format X-chain address: %w", err)
+	}
+	reply.CChainAddress = pk.EthAddress()
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	if err := s.getBalance(set.Of(addr), &reply.PChainBalance); err != nil {
+		return fmt.Errorf("couldn't get UTXO set of %s: %w", reply.PChainAddress, err)
+	}
+	return nil
+}
+
 // Index is an address and an associated UTXO.
 // Marks a starting or stopping point when fetching UTXOs. Used for pagination.
 type Index struct {
@@ -662,12 +729,49 @@ type GetCurrentValidatorsArgs struct {
 	// some nodeIDs are not currently validators, they
 	// will be omitted from the response.
 	NodeIDs []ids.NodeID `json:"nodeIDs"`
+	// IncludeDelegators returns the delegators of each validator. If omitted,
+	// the delegators are only returned if a single nodeID is requested.
+	IncludeDelegators *bool `json:"includeDelegators"`
+	// StartNodeID is the nodeID of the first validator to return, as
+	// validators are returned in order of their nodeIDs. To fetch the next
+	// page, set it to the [NextNodeID] of the previous reply.
+	StartNodeID ids.NodeID `json:"startNodeID"`
+	// PageSize is the max number of validators to return. If omitted, all
+	// validators are returned.
+	PageSize avajson.Uint32 `json:"pageSize"`
+	// MinWeight is the min weight of the validators to return, not including
+	// the weight of their delegators.
+	MinWeight avajson.Uint64 `json:"minWeight"`
+	// MinDelegationFee and MaxDelegationFee are the bounds, in percent, of the
+	// delegation fee of the validators to return. If either is set, validators
+	// without a delegation fee are omitted.
+	MinDelegationFee *avajson.Float32 `json:"minDelegationFee"`
+	MaxDelegationFee *avajson.Float32 `json:"maxDelegationFee"`
+	// MinEndTime and MaxEndTime are the bounds of the end time of the
+	// validators to return. If omitted, the end time is not bounded.
+	MinEndTime avajson.Uint64 `json:"minEndTime"`
+	MaxEndTime avajson.Uint64 `json:"maxEndTime"`
+}
+
+// hasL1Filters returns true if [args] paginates or filters the validators,
+// which is not supported for L1 validators.
+func (args *GetCurrentValidatorsArgs) hasL1Filters() bool {
+	return args.StartNodeID != ids.EmptyNodeID ||
+		args.PageSize != 0 ||
+		args.MinWeight != 0 ||
+		args.MinDelegationFee != nil ||
+		args.MaxDelegationFee != nil ||
+		args.MinEndTime != 0 ||
+		args.MaxEndTime != 0
 }
 
 // GetCurrentValidatorsReply are the results from calling GetCurrentValidators.
 // Each validator contains a list of delegators to itself.
 type GetCurrentValidatorsReply struct {
 	Validators []any `json:"validators"`
+	// NextNodeID is the nodeID of the first validator of the next page. It is
+	// omitted on the last page.
+	NextNodeID *ids.NodeID `json:"nextNodeID,omitempty"`
 }
 
 func (s *Service) loadStakerTxAttributes(txID ids.ID) (*stakerAttributes, error) {
@@ -713,8 +817,9 @@ func (s *Service) loadStakerTxAttributes(txID ids.ID) (*stakerAttributes, error)
 }
 
 // GetCurrentValidators returns the current validators. If a single nodeID
-// is provided, full delegators information is also returned. Otherwise only
-// delegators' number and total weight is returned.
+// is provided or delegators are requested, full delegators information is
+// also returned. Otherwise only delegators' number and total weight is
+// returned.
 func (s *Service) GetCurrentValidators(request *http.Request, args *GetCurrentValidatorsArgs, reply *GetCurrentValidatorsReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
@@ -731,8 +836,8 @@ func (s *Service) GetCurrentValidators(request *http.Request, args *GetCurrentVa
 	_, err := s.vm.state.GetSubnetToL1Conversion(args.SubnetID)
 	if errors.Is(err, database.ErrNotFound) {
 		// Subnet is not L1, get validators for the subnet
-		reply.Validators, err = s.getPrimaryOrSubnetValidators(
-			args.SubnetID,
+		reply.Validators, reply.NextNodeID, err = s.getPrimaryOrSubnetValidators(
+			args,
 			nodeIDs,
 		)
 		if err != nil {
@@ -743,6 +848,9 @@ func (s *Service) GetCurrentValidators(request *http.Request, args *GetCurrentVa
 	if err != nil {
 		return fmt.Errorf("failed to get subnet to L1 conversion: %w", err)
 	}
+	if args.hasL1Filters() {
+		return errL1ValidatorsFilters
+	}
 
 	// Subnet is L1, get validators for L1
 	reply.Validators, err = s.getL1Validators(
@@ -794,28 +902,33 @@ func (s *Service) getL1Validators(
 	return validators, nil
 }
 
-func (s *Service) getPrimaryOrSubnetValidators(subnetID ids.ID, nodeIDs set.Set[ids.NodeID]) ([]any, error) {
+func (s *Service) getPrimaryOrSubnetValidators(
+	args *GetCurrentValidatorsArgs,
+	nodeIDs set.Set[ids.NodeID],
+) ([]any, *ids.NodeID, error) {
+	subnetID := args.SubnetID
 	numNodeIDs := nodeIDs.Len()
 
-	targetStakers := make([]*state.Staker, 0, numNodeIDs)
+	targetValidators := make([]*state.Staker, 0, numNodeIDs)
 
-	// Validator's node ID as string --> Delegators to them
-	vdrToDelegators := map[ids.NodeID][]platformapi.PrimaryDelegator{}
-
-	validators := []any{}
+	// Validator's node ID --> Delegators to them
+	vdrToDelegators := map[ids.NodeID][]*state.Staker{}
 
 	if numNodeIDs == 0 { // Include all nodes
 		currentStakerIterator, err := s.vm.state.GetCurrentStakerIterator()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		// TODO: avoid iterating over delegators here.
 		for currentStakerIterator.Next() {
 			staker := currentStakerIterator.Value()
 			if subnetID != staker.SubnetID {
 				continue
 			}
-			targetStakers = append(targetStakers, staker)
+			if staker.Priority.IsCurrentDelegator() {
+				vdrToDelegators[staker.NodeID] = append(vdrToDelegators[staker.NodeID], staker)
+				continue
+			}
+			targetValidators = append(targetValidators, staker)
 		}
 		currentStakerIterator.Release()
 	} else {
@@ -827,42 +940,52 @@ func (s *Service) getPrimaryOrSubnetValidators(subnetID ids.ID, nodeIDs set.Set[
 				// nothing to do, continue
 				continue
 			default:
-				return nil, err
+				return nil, nil, err
 			}
-			targetStakers = append(targetStakers, staker)
+			targetValidators = append(targetValidators, staker)
 
-			// TODO: avoid iterating over delegators when numNodeIDs > 1.
 			delegatorsIt, err := s.vm.state.GetCurrentDelegatorIterator(subnetID, nodeID)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			for delegatorsIt.Next() {
 				staker := delegatorsIt.Value()
-				targetStakers = append(targetStakers, staker)
+				vdrToDelegators[nodeID] = append(vdrToDelegators[nodeID], staker)
 			}
 			delegatorsIt.Release()
 		}
 	}
 
-	for _, currentStaker := range targetStakers {
+	targetValidators, err := s.filterValidators(args, targetValidators)
+	if err != nil {
+		return nil, nil, err
+	}
+	targetValidators, nextNodeID := paginateValidators(args, targetValidators)
+
+	includeDelegators := numNodeIDs == 1
+	if args.IncludeDelegators != nil {
+		includeDelegators = *args.IncludeDelegators
+	}
+
+	validators := make([]any, 0, len(targetValidators))
+	for _, currentStaker := range targetValidators {
 		apiStaker := toPlatformStaker(currentStaker)
 		potentialReward := avajson.Uint64(currentStaker.PotentialReward)
 
-		delegateeReward, err := s.vm.state.GetDelegateeReward(currentStaker.SubnetID, currentStaker.NodeID)
-		if err != nil {
-			return nil, err
-		}
-		jsonDelegateeReward := avajson.Uint64(delegateeReward)
-
 		switch currentStaker.Priority {
 		case txs.PrimaryNetworkValidatorCurrentPriority, txs.SubnetPermissionlessValidatorCurrentPriority:
+			delegateeReward, err := s.vm.state.GetDelegateeReward(currentStaker.SubnetID, currentStaker.NodeID)
+			if err != nil {
+				return nil, nil, err
+			}
+			jsonDelegateeReward := avajson.Uint64(delegateeReward)
+
 			attr, err := s.loadStakerTxAttributes(currentStaker.TxID)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 
-			shares := attr.shares
-			delegationFee := avajson.Float32(100 * float32(shares) / float32(reward.PercentDenominator))
+			delegationFee := toDelegationFee(attr.shares)
 			var (
 				uptime    *avajson.Float32
 				connected *bool
@@ -870,14 +993,11 @@ func (s *Service) getPrimaryOrSubnetValidators(subnetID ids.ID, nodeIDs set.Set[
 			if subnetID == constants.PrimaryNetworkID {
 				rawUptime, err := s.vm.uptimeManager.CalculateUptimePercentFrom(currentStaker.NodeID, currentStaker.StartTime)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				// Transform this to a percentage (0-100) to make it consistent
 				// with observedUptime in info.peers API
 				currentUptime := avajson.Float32(rawUptime * 100)
-				if err != nil {
-					return nil, err
-				}
 				isConnected := s.vm.uptimeManager.IsConnected(currentStaker.NodeID)
 				connected = &isConnected
 				uptime = &currentUptime
@@ -891,17 +1011,27 @@ func (s *Service) getPrimaryOrSubnetValidators(subnetID ids.ID, nodeIDs set.Set[
 			if ok {
 				validationRewardOwner, err = s.getAPIOwner(validationOwner)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 			}
 			delegationOwner, ok := attr.delegationRewardsOwner.(*secp256k1fx.OutputOwners)
 			if ok {
 				delegationRewardOwner, err = s.getAPIOwner(delegationOwner)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 			}
 
+			delegators, err := s.getDelegators(vdrToDelegators[currentStaker.NodeID], includeDelegators)
+			if err != nil {
+				return nil, nil, err
+			}
+			delegatorCount := avajson.Uint64(len(delegators))
+			delegatorWeight := avajson.Uint64(0)
+			for _, d := range delegators {
+				delegatorWeight += d.Weight
+			}
+
 			vdr := platformapi.PermissionlessValidator{
 				Staker:                 apiStaker,
 				Uptime:                 uptime,
@@ -913,71 +1043,126 @@ func (s *Service) getPrimaryOrSubnetValidators(subnetID ids.ID, nodeIDs set.Set[
 				DelegationRewardOwner:  delegationRewardOwner,
 				DelegationFee:          delegationFee,
 				Signer:                 attr.proofOfPossession,
+				DelegatorCount:         &delegatorCount,
+				DelegatorWeight:        &delegatorWeight,
+			}
+			if includeDelegators {
+				// queried a specific validator or requested the delegators,
+				// load all of its delegators
+				vdr.Delegators = &delegators
 			}
 			validators = append(validators, vdr)
 
-		case txs.PrimaryNetworkDelegatorCurrentPriority, txs.SubnetPermissionlessDelegatorCurrentPriority:
-			var rewardOwner *platformapi.Owner
-			// If we are handling multiple nodeIDs, we don't return the
-			// delegator information unless completeGetValidators is true.
-			if numNodeIDs == 1 || completeGetValidators {
-				attr, err := s.loadStakerTxAttributes(currentStaker.TxID)
+		case txs.SubnetPermissionedValidatorCurrentPriority:
+			validators = append(validators, apiStaker)
+
+		default:
+			return nil, nil, fmt.Errorf("unexpected staker priority %d", currentStaker.Priority)
+		}
+	}
+
+	return validators, nextNodeID, nil
+}
+
+// getDelegators returns the API format of [delegators]. The reward owners are
+// only loaded if [withRewardOwners] is true.
+func (s *Service) getDelegators(delegators []*state.Staker, withRewardOwners bool) ([]platformapi.PrimaryDelegator, error) {
+	// If we are expected to populate the delegators field, we should always
+	// return a non-nil value.
+	apiDelegators := make([]platformapi.PrimaryDelegator, 0, len(delegators))
+	for _, delegator := range delegators {
+		potentialReward := avajson.Uint64(delegator.PotentialReward)
+
+		var rewardOwner *platformapi.Owner
+		if withRewardOwners {
+			attr, err := s.loadStakerTxAttributes(delegator.TxID)
+			if err != nil {
+				return nil, err
+			}
+			owner, ok := attr.rewardsOwner.(*secp256k1fx.OutputOwners)
+			if ok {
+				rewardOwner, err = s.getAPIOwner(owner)
 				if err != nil {
 					return nil, err
 				}
-				owner, ok := attr.rewardsOwner.(*secp256k1fx.OutputOwners)
-				if ok {
-					rewardOwner, err = s.getAPIOwner(owner)
-					if err != nil {
-						return nil, err
-					}
-				}
 			}
+		}
 
-			delegator := platformapi.PrimaryDelegator{
-				Staker:          apiStaker,
-				RewardOwner:     rewardOwner,
-				PotentialReward: &potentialReward,
-			}
-			vdrToDelegators[delegator.NodeID] = append(vdrToDelegators[delegator.NodeID], delegator)
+		apiDelegators = append(apiDelegators, platformapi.PrimaryDelegator{
+			Staker:          toPlatformStaker(delegator),
+			RewardOwner:     rewardOwner,
+			PotentialReward: &potentialReward,
+		})
+	}
+	return apiDelegators, nil
+}
 
-		case txs.SubnetPermissionedValidatorCurrentPriority:
-			validators = append(validators, apiStaker)
+// filterValidators returns the validators of [vdrs] that match the weight,
+// delegation fee and end time bounds of [args].
+func (s *Service) filterValidators(args *GetCurrentValidatorsArgs, vdrs []*state.Staker) ([]*state.Staker, error) {
+	filterFee := args.MinDelegationFee != nil || args.MaxDelegationFee != nil
 
-		default:
-			return nil, fmt.Errorf("unexpected staker priority %d", currentStaker.Priority)
+	filteredVdrs := vdrs[:0]
+	for _, vdr := range vdrs {
+		if vdr.Weight < uint64(args.MinWeight) {
+			continue
 		}
-	}
 
-	// handle delegators' information
-	for i, vdrIntf := range validators {
-		vdr, ok := vdrIntf.(platformapi.PermissionlessValidator)
-		if !ok {
+		endTime := uint64(vdr.EndTime.Unix())
+		if endTime < uint64(args.MinEndTime) || (args.MaxEndTime != 0 && endTime > uint64(args.MaxEndTime)) {
 			continue
 		}
-		delegators, ok := vdrToDelegators[vdr.NodeID]
-		if !ok {
-			// If we are expected to populate the delegators field, we should
-			// always return a non-nil value.
-			delegators = []platformapi.PrimaryDelegator{}
-		}
-		delegatorCount := avajson.Uint64(len(delegators))
-		delegatorWeight := avajson.Uint64(0)
-		for _, d := range delegators {
-			delegatorWeight += d.Weight
+
+		if filterFee {
+			if vdr.Priority == txs.SubnetPermissionedValidatorCurrentPriority {
+				continue
+			}
+			attr, err := s.loadStakerTxAttributes(vdr.TxID)
+			if err != nil {
+				return nil, err
+			}
+			delegationFee := toDelegationFee(attr.shares)
+			if args.MinDelegationFee != nil && delegationFee < *args.MinDelegationFee {
+				continue
+			}
+			if args.MaxDelegationFee != nil && delegationFee > *args.MaxDelegationFee {
+				continue
+			}
 		}
 
-		vdr.DelegatorCount = &delegatorCount
-		vdr.DelegatorWeight = &delegatorWeight
+		filteredVdrs = append(filteredVdrs, vdr)
+	}
+	return filteredVdrs, nil
+}
 
-		if numNodeIDs == 1 || completeGetValidators {
-			// queried a specific validator or completeGetValidators is true, load all of its delegators
-			vdr.Delegators = &delegators
-		}
-		validators[i] = vdr
+// paginateValidators sorts [vdrs] by nodeID and returns the page of [args],
+// together with the nodeID of the first validator of the next page, if any.
+func paginateValidators(args *GetCurrentValidatorsArgs, vdrs []*state.Staker) ([]*state.Staker, *ids.NodeID) {
+	slices.SortFunc(vdrs, func(a, b *state.Staker) int {
+		return a.NodeID.Compare(b.NodeID)
+	})
+
+	start, _ := slices.BinarySearchFunc(vdrs, args.StartNodeID, func(vdr *state.Staker, nodeID ids.NodeID) int {
+		return vdr.NodeID.Compare(nodeID)
+	})
+	vdrs = vdrs[start:]
+
+	pageSize := int(args.PageSize)
+	if pageSize == 0 {
+		return vdrs, nil
 	}
+	pageSize = min(pageSize, maxPageSize)
+	if len(vdrs) <= pageSize {
+		return vdrs, nil
+	}
+	nextNodeID := vdrs[pageSize].NodeID
+	return vdrs[:pageSize], &nextNodeID
+}
 
-	return validators, nil
+// toDelegationFee returns the delegation fee, in percent, of a validator with
+// [shares].
+func toDelegationFee(shares uint32) avajson.Float32 {
+	return avajson.Float32(100 * float32(shares) / float32(reward.PercentDenominator))
 }
 
 type GetL1ValidatorArgs struct {
@@ -1448,6 +1633,64 @@ func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, response *api.
 	return nil
 }
 
+// RuleViolation is a staking rule violated by a tx
+type RuleViolation struct {
+	Rule     string `json:"rule"`
+	Value    string `json:"value"`
+	Required string `json:"required"`
+	Error    string `json:"error"`
+}
+
+// VerifyTxReply are the results from calling VerifyTx
+type VerifyTxReply struct {
+	TxID  ids.ID `json:"txID"`
+	Valid bool   `json:"valid"`
+	// Error is the reason the tx would be rejected
+	Error      string          `json:"error,omitempty"`
+	Violations []RuleViolation `json:"violations"`
+}
+
+// VerifyTx verifies a tx against the preferred state as it would be verified
+// when issued, without issuing it. If the tx is invalid, the staking rules it
+// violates are returned.
+func (s *Service) VerifyTx(_ *http.Request, args *api.FormattedTx, reply *VerifyTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "verifyTx"),
+	)
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't parse tx: %w", err)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	err = s.vm.manager.VerifyTx(tx)
+	reply.TxID = tx.ID()
+	reply.Valid = err == nil
+	if err != nil {
+		reply.Error = err.Error()
+	}
+
+	violations := executor.RuleViolations(err)
+	reply.Violations = make([]RuleViolation, len(violations))
+	for i, violation := range violations {
+		reply.Violations[i] = RuleViolation{
+			Rule:     violation.Rule,
+			Value:    violation.Value,
+			Required: violation.Required,
+			Error:    violation.Err.Error(),
+		}
+	}
+	return nil
+}
+
 func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, response *api.GetTxReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
@@ -1676,9 +1919,9 @@ func (s *Service) GetMinStake(_ *http.Request, args *GetMinStakeArgs, reply *Get
 
 	if args.SubnetID == constants.PrimaryNetworkID {
 		timestamp := s.vm.state.GetTimestamp()
-		minValidatorStake, _, minDelegatorStake, _, _, _, _, _, _, _ := executor.GetCurrentInflationSettings(timestamp, s.vm.ctx.NetworkID, &s.vm.Internal)
-		reply.MinValidatorStake = avajson.Uint64(minValidatorStake)
-		reply.MinDelegatorStake = avajson.Uint64(minDelegatorStake)
+		inflationSettings := executor.GetCurrentInflationSettings(timestamp, s.vm.ctx.NetworkID, &s.vm.Internal)
+		reply.MinValidatorStake = avajson.Uint64(inflationSettings.MinValidatorStake)
+		reply.MinDelegatorStake = avajson.Uint64(inflationSettings.MinDelegatorStake)
 		return nil
 	}
 
@@ -1707,6 +1950,140 @@ func (s *Service) GetMinStake(_ *http.Request, args *GetMinStakeArgs, reply *Get
 	return nil
 }
 
+// GetStakingParametersArgs are the arguments for calling GetStakingParameters.
+type GetStakingParametersArgs struct {
+	// Unix time, in seconds, to return the parameters at. If zero, the current
+	// chain time is used.
+	Timestamp avajson.Uint64 `json:"timestamp"`
+}
+
+// GetStakingParametersReply is the response from calling GetStakingParameters.
+type GetStakingParametersReply struct {
+	// Unix time, in seconds, the parameters are in effect at
+	Timestamp avajson.Uint64 `json:"timestamp"`
+	// Stake, in nAVAX, a validator of the primary network must bond
+	MinValidatorStake avajson.Uint64 `json:"minValidatorStake"`
+	MaxValidatorStake avajson.Uint64 `json:"maxValidatorStake"`
+	// Minimum stake, in nAVAX, that can be delegated on the primary network
+	MinDelegatorStake avajson.Uint64 `json:"minDelegatorStake"`
+	// Minimum delegation fee, in units of [reward.PercentDenominator]
+	MinDelegationFee avajson.Uint32 `json:"minDelegationFee"`
+	// Durations, in seconds
+	MinStakeDuration         avajson.Uint64 `json:"minStakeDuration"`
+	MinDelegateDuration      avajson.Uint64 `json:"minDelegateDuration"`
+	MaxStakeDuration         avajson.Uint64 `json:"maxStakeDuration"`
+	MinFutureStartTimeOffset avajson.Uint64 `json:"minFutureStartTimeOffset"`
+	// Maximum ratio of the delegated stake to the stake of a validator
+	MaxValidatorWeightFactor avajson.Uint64 `json:"maxValidatorWeightFactor"`
+	// Unix time, in seconds, validators must start staking after
+	MinStakeStartTime avajson.Uint64 `json:"minStakeStartTime"`
+}
+
+// GetStakingParameters returns the staking parameters of the primary network
+// in effect at the given time.
+func (s *Service) GetStakingParameters(_ *http.Request, args *GetStakingParametersArgs, reply *GetStakingParametersReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getStakingParameters"),
+	)
+
+	timestamp := time.Unix(int64(args.Timestamp), 0)
+	if args.Timestamp == 0 {
+		s.vm.ctx.Lock.Lock()
+		timestamp = s.vm.state.GetTimestamp()
+		s.vm.ctx.Lock.Unlock()
+	}
+
+	inflationSettings := executor.GetCurrentInflationSettings(timestamp, s.vm.ctx.NetworkID, &s.vm.Internal)
+	reply.Timestamp = avajson.Uint64(timestamp.Unix())
+	reply.MinValidatorStake = avajson.Uint64(inflationSettings.MinValidatorStake)
+	reply.MaxValidatorStake = avajson.Uint64(inflationSettings.MaxValidatorStake)
+	reply.MinDelegatorStake = avajson.Uint64(inflationSettings.MinDelegatorStake)
+	reply.MinDelegationFee = avajson.Uint32(inflationSettings.MinDelegationFee)
+	reply.MinStakeDuration = avajson.Uint64(inflationSettings.MinStakeDuration / time.Second)
+	reply.MinDelegateDuration = avajson.Uint64(inflationSettings.MinDelegateDuration / time.Second)
+	reply.MaxStakeDuration = avajson.Uint64(inflationSettings.MaxStakeDuration / time.Second)
+	reply.MinFutureStartTimeOffset = avajson.Uint64(inflationSettings.MinFutureStartTimeOffset / time.Second)
+	reply.MaxValidatorWeightFactor = avajson.Uint64(inflationSettings.MaxValidatorWeightFactor)
+	reply.MinStakeStartTime = avajson.Uint64(inflationSettings.MinStakeStartTime.Unix())
+	return nil
+}
+
+// GetDelegationCapacityArgs are the arguments for calling
+// GetDelegationCapacity.
+type GetDelegationCapacityArgs struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// Unix times, in seconds, of the staking period of the delegation. If
+	// StartTime is zero, the current chain time is used.
+	StartTime avajson.Uint64 `json:"startTime"`
+	EndTime   avajson.Uint64 `json:"endTime"`
+}
+
+// GetDelegationCapacityReply is the response from calling
+// GetDelegationCapacity.
+type GetDelegationCapacityReply struct {
+	// Stake, in nAVAX, of the validator
+	ValidatorWeight avajson.Uint64 `json:"validatorWeight"`
+	// Maximum total stake of the validator and its delegators, set by the
+	// staking parameter LimitingRule
+	MaxWeight    avajson.Uint64 `json:"maxWeight"`
+	LimitingRule string         `json:"limitingRule"`
+	// Maximum total stake of the validator and its delegators during the
+	// staking period
+	CurrentMaxWeight avajson.Uint64 `json:"currentMaxWeight"`
+	// Maximum stake of an additional delegator during the staking period
+	Capacity avajson.Uint64 `json:"capacity"`
+	// Minimum stake of a delegator
+	MinDelegatorStake avajson.Uint64 `json:"minDelegatorStake"`
+}
+
+// GetDelegationCapacity returns the maximum stake that can be delegated to a
+// primary network validator during a staking period.
+func (s *Service) GetDelegationCapacity(_ *http.Request, args *GetDelegationCapacityArgs, reply *GetDelegationCapacityReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getDelegationCapacity"),
+		zap.Stringer("nodeID", args.NodeID),
+		zap.Uint64("startTime", uint64(args.StartTime)),
+		zap.Uint64("endTime", uint64(args.EndTime)),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	chainTime := s.vm.state.GetTimestamp()
+	startTime := time.Unix(int64(args.StartTime), 0)
+	if args.StartTime == 0 {
+		startTime = chainTime
+	}
+	endTime := time.Unix(int64(args.EndTime), 0)
+	switch {
+	case startTime.Before(chainTime):
+		return fmt.Errorf("%w: %s < %s", errStartTimeInThePast, startTime, chainTime)
+	case !startTime.Before(endTime):
+		return fmt.Errorf("%w: %s >= %s", errStartAfterEndTime, startTime, endTime)
+	}
+
+	validator, err := executor.GetValidator(s.vm.state, constants.PrimaryNetworkID, args.NodeID)
+	if err != nil {
+		return fmt.Errorf("failed to get validator %s: %w", args.NodeID, err)
+	}
+
+	inflationSettings := executor.GetCurrentInflationSettings(chainTime, s.vm.ctx.NetworkID, &s.vm.Internal)
+	capacity, err := executor.GetDelegationCapacity(s.vm.state, inflationSettings, validator, startTime, endTime)
+	if err != nil {
+		return fmt.Errorf("failed to get delegation capacity: %w", err)
+	}
+
+	reply.ValidatorWeight = avajson.Uint64(validator.Weight)
+	reply.MaxWeight = avajson.Uint64(capacity.MaxWeight)
+	reply.LimitingRule = capacity.LimitingRule
+	reply.CurrentMaxWeight = avajson.Uint64(capacity.CurrentMaxWeight)
+	reply.Capacity = avajson.Uint64(capacity.Capacity)
+	reply.MinDelegatorStake = avajson.Uint64(inflationSettings.MinDelegatorStake)
+	return nil
+}
+
 // GetTotalStakeArgs are the arguments for calling GetTotalStake
 type GetTotalStakeArgs struct {
 	// Subnet we're getting the total stake
@@ -1901,6 +2278,326 @@ func (s *Service) GetValidatorsAt(r *http.Request, args *GetValidatorsAtArgs, re
 	return nil
 }
 
+// GetStakersAtArgs are the arguments for calling GetStakersAt
+type GetStakersAtArgs struct {
+	Height platformapi.Height `json:"height"`
+}
+
+// StakerAt is a staker of the primary network and the proof of its inclusion
+// in the staker tree.
+type StakerAt struct {
+	TxID    ids.ID         `json:"txID"`
+	NodeID  ids.NodeID     `json:"nodeID"`
+	Type    avajson.Uint8  `json:"type"`
+	Weight  avajson.Uint64 `json:"weight"`
+	EndTime avajson.Uint64 `json:"endTime"`
+	// Leaf is the hash of the staker in the staker tree
+	Leaf  common.Hash   `json:"leaf"`
+	Proof []common.Hash `json:"proof"`
+}
+
+// GetStakersAtReply are the results from calling GetStakersAt
+type GetStakersAtReply struct {
+	Height avajson.Uint64 `json:"height"`
+	// Root is the root of the staker tree, see stakertree.Tree
+	Root    common.Hash `json:"root"`
+	Stakers []StakerAt  `json:"stakers"`
+}
+
+// GetStakersAt returns the validators and delegators of the primary network
+// after the block at the requested height was accepted, in order of their
+// txIDs, together with the root of their staker tree and the proof of each of
+// them.
+func (s *Service) GetStakersAt(r *http.Request, args *GetStakersAtArgs, reply *GetStakersAtReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getStakersAt"),
+		zap.Uint64("height", uint64(args.Height)),
+		zap.Bool("isProposed", args.Height.IsProposed()),
+	)
+
+	ctx := r.Context()
+	height := uint64(args.Height)
+	if args.Height.IsProposed() {
+		s.vm.ctx.Lock.Lock()
+		proposedHeight, err := s.vm.GetMinimumHeight(ctx)
+		s.vm.ctx.Lock.Unlock()
+		if err != nil {
+			return fmt.Errorf("failed to get proposed height: %w", err)
+		}
+		height = proposedHeight
+	}
+
+	stakers, err := s.getPrimaryNetworkStakersAt(ctx, height)
+	if err != nil {
+		return fmt.Errorf("failed to get stakers at height %d: %w", height, err)
+	}
+
+	tree := stakertree.New(stakers)
+	reply.Height = avajson.Uint64(height)
+	reply.Root = tree.Root()
+	reply.Stakers = make([]StakerAt, len(stakers))
+	for i, staker := range stakers {
+		reply.Stakers[i] = StakerAt{
+			TxID:    staker.TxID,
+			NodeID:  staker.NodeID,
+			Type:    avajson.Uint8(staker.Type),
+			Weight:  avajson.Uint64(staker.Weight),
+			EndTime: avajson.Uint64(staker.EndTime),
+			Leaf:    staker.Leaf(),
+			Proof:   tree.Proof(i),
+		}
+	}
+	return nil
+}
+
+// getPrimaryNetworkStakersAt returns the stakers of the primary network after
+// the block at [height] was accepted. The stakers are computed by undoing the
+// staker changes of the blocks accepted after [height] on the current stakers,
+// as the validator weight diffs are summed by node and do not identify the
+// stakers.
+//
+// Only the addition of stakers and their removal by RewardValidatorTxs are
+// undone, so [height] must be late enough that no staker was added to the
+// pending stakers before Durango and started after [height].
+//
+// The context lock is only held while the current stakers and each of the
+// undone blocks are read, so that blocks can be accepted in between. The
+// accepted blocks never change, so the blocks after [height] can be undone
+// even if more blocks are accepted meanwhile.
+func (s *Service) getPrimaryNetworkStakersAt(ctx context.Context, height uint64) ([]*stakertree.Staker, error) {
+	blk, stakers, err := s.getCurrentPrimaryNetworkStakers()
+	if err != nil {
+		return nil, err
+	}
+	if height > blk.Height() {
+		return nil, fmt.Errorf("%w: last accepted height is %d", errHeightNotAccepted, blk.Height())
+	}
+
+	for blk.Height() > height {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		blk, err = s.undoPrimaryNetworkStakerChanges(blk, stakers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	treeStakers := make([]*stakertree.Staker, 0, len(stakers))
+	for _, staker := range stakers {
+		stakerType := stakertree.ValidatorType
+		if staker.Priority.IsDelegator() {
+			stakerType = stakertree.DelegatorType
+		}
+		treeStakers = append(treeStakers, &stakertree.Staker{
+			TxID:    staker.TxID,
+			NodeID:  staker.NodeID,
+			Type:    stakerType,
+			Weight:  staker.Weight,
+			EndTime: uint64(staker.EndTime.Unix()),
+		})
+	}
+	return treeStakers, nil
+}
+
+// getCurrentPrimaryNetworkStakers returns the last accepted block and the
+// current stakers of the primary network by txID.
+func (s *Service) getCurrentPrimaryNetworkStakers() (block.Block, map[ids.ID]*state.Staker, error) {
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	blk, err := s.vm.state.GetStatelessBlock(s.vm.state.GetLastAccepted())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stakers := make(map[ids.ID]*state.Staker)
+	currentStakerIterator, err := s.vm.state.GetCurrentStakerIterator()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer currentStakerIterator.Release()
+
+	for currentStakerIterator.Next() {
+		staker := currentStakerIterator.Value()
+		if staker.SubnetID == constants.PrimaryNetworkID {
+			stakers[staker.TxID] = staker
+		}
+	}
+	return blk, stakers, nil
+}
+
+// undoPrimaryNetworkStakerChanges undoes the changes made by the accepted
+// [blk] to the primary network [stakers], and returns the parent of [blk].
+func (s *Service) undoPrimaryNetworkStakerChanges(blk block.Block, stakers map[ids.ID]*state.Staker) (block.Block, error) {
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	parent, err := s.vm.state.GetStatelessBlock(blk.Parent())
+	if err != nil {
+		return nil, err
+	}
+	// Pending stakers added before Durango may have been started by the
+	// accepted block until MaxFutureStartTime after Durango.
+	parentBlk, ok := parent.(block.BanffBlock)
+	if !ok || !s.vm.Internal.UpgradeConfig.IsDurangoActivated(parentBlk.Timestamp().Add(-executor.MaxFutureStartTime)) {
+		return nil, errHeightBeforeDurango
+	}
+
+	// The txs of a proposal block are accepted with its commit or abort
+	// block.
+	var acceptedTxs []*txs.Tx
+	switch blk.(type) {
+	case *block.BanffProposalBlock:
+	case *block.BanffCommitBlock, *block.BanffAbortBlock:
+		acceptedTxs = parent.Txs()
+	default:
+		acceptedTxs = blk.Txs()
+	}
+	for _, tx := range acceptedTxs {
+		switch utx := tx.Unsigned.(type) {
+		case txs.Staker:
+			delete(stakers, tx.ID())
+		case *txs.RewardValidatorTx:
+			stakerTx, _, err := s.vm.state.GetTx(utx.TxID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get staker tx %s: %w", utx.TxID, err)
+			}
+			stakerUtx, ok := stakerTx.Unsigned.(txs.Staker)
+			if !ok {
+				return nil, fmt.Errorf("unexpected staker tx type %T", stakerTx.Unsigned)
+			}
+			if stakerUtx.SubnetID() != constants.PrimaryNetworkID {
+				continue
+			}
+			staker, err := state.NewCurrentStaker(utx.TxID, stakerUtx, time.Time{}, 0)
+			if err != nil {
+				return nil, err
+			}
+			stakers[utx.TxID] = staker
+		}
+	}
+	return parent, nil
+}
+
+// GetValidatorUptimeHistoryArgs are the arguments for calling
+// GetValidatorUptimeHistory
+type GetValidatorUptimeHistoryArgs struct {
+	NodeID    ids.NodeID     `json:"nodeID"`
+	FromEpoch avajson.Uint64 `json:"fromEpoch"`
+	ToEpoch   avajson.Uint64 `json:"toEpoch"`
+}
+
+// GetValidatorUptimeHistoryReply are the results from calling
+// GetValidatorUptimeHistory
+type GetValidatorUptimeHistoryReply struct {
+	// EpochStart is the start time of epoch 0
+	EpochStart avajson.Uint64 `json:"epochStart"`
+	// EpochDuration is the duration of the epochs in seconds
+	EpochDuration avajson.Uint64       `json:"epochDuration"`
+	Epochs        []uptime.EpochUptime `json:"epochs"`
+}
+
+// GetValidatorUptimeHistory returns the uptimes of a primary network validator
+// recorded by this node at the end of each epoch.
+func (s *Service) GetValidatorUptimeHistory(_ *http.Request, args *GetValidatorUptimeHistoryArgs, reply *GetValidatorUptimeHistoryReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getValidatorUptimeHistory"),
+		zap.Stringer("nodeID", args.NodeID),
+		zap.Uint64("fromEpoch", uint64(args.FromEpoch)),
+		zap.Uint64("toEpoch", uint64(args.ToEpoch)),
+	)
+
+	if s.vm.uptimeHistory == nil {
+		return uptime.ErrHistoryDisabled
+	}
+
+	epochs, err := s.vm.uptimeHistory.GetUptimeHistory(args.NodeID, uint64(args.FromEpoch), uint64(args.ToEpoch))
+	if err != nil {
+		return fmt.Errorf("failed to get uptime history: %w", err)
+	}
+
+	epochParams := s.vm.uptimeHistory.Epochs()
+	reply.EpochStart = avajson.Uint64(epochParams.Start.Unix())
+	reply.EpochDuration = avajson.Uint64(epochParams.Duration / time.Second)
+	reply.Epochs = epochs
+	return nil
+}
+
+// GetStakerEventsArgs are the arguments for calling GetStakerEvents
+type GetStakerEventsArgs struct {
+	// If zero, the events from the first indexed block are returned
+	FromHeight avajson.Uint64 `json:"fromHeight"`
+	// If zero, the events up to the last indexed block are returned
+	ToHeight avajson.Uint64 `json:"toHeight"`
+	// If zero, or above [stakerfeed.MaxEvents], at most MaxEvents events are
+	// returned
+	Limit avajson.Uint32 `json:"limit"`
+}
+
+// GetStakerEventsReply are the results from calling GetStakerEvents
+type GetStakerEventsReply struct {
+	// IndexedFromHeight is the height of the first indexed block. The events
+	// of the earlier blocks aren't available.
+	IndexedFromHeight avajson.Uint64     `json:"indexedFromHeight"`
+	Events            []stakerfeed.Event `json:"events"`
+	// NextHeight is the height to continue from if not all the events were
+	// returned
+	NextHeight *avajson.Uint64 `json:"nextHeight,omitempty"`
+}
+
+// GetStakerEvents returns the changes of the staker sets made by the accepted
+// blocks, from the index of staker events.
+func (s *Service) GetStakerEvents(_ *http.Request, args *GetStakerEventsArgs, reply *GetStakerEventsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getStakerEvents"),
+		zap.Uint64("fromHeight", uint64(args.FromHeight)),
+		zap.Uint64("toHeight", uint64(args.ToHeight)),
+	)
+
+	index := s.vm.stakerFeed.Index()
+	if index == nil {
+		return stakerfeed.ErrIndexDisabled
+	}
+
+	firstHeight, err := index.FirstHeight()
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+		reply.Events = []stakerfeed.Event{}
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get first indexed height: %w", err)
+	}
+
+	fromHeight := uint64(args.FromHeight)
+	if fromHeight == 0 {
+		fromHeight = firstHeight
+	}
+	toHeight := uint64(args.ToHeight)
+	if toHeight == 0 {
+		toHeight = math.MaxUint64
+	}
+	limit := stakerfeed.MaxEvents
+	if args.Limit != 0 && args.Limit < stakerfeed.MaxEvents {
+		limit = int(args.Limit)
+	}
+	events, nextHeight, more, err := index.GetEvents(fromHeight, toHeight, limit)
+	if err != nil {
+		return fmt.Errorf("failed to get staker events: %w", err)
+	}
+
+	reply.IndexedFromHeight = avajson.Uint64(firstHeight)
+	reply.Events = events
+	if more {
+		next := avajson.Uint64(nextHeight)
+		reply.NextHeight = &next
+	}
+	return nil
+}
+
 func (s *Service) GetBlock(_ *http.Request, args *api.GetBlockArgs, response *api.GetBlockResponse) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
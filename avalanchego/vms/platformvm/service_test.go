@@ -14,6 +14,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ava-labs/coreth/accounts"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"golang.org/x/exp/maps"
@@ -22,12 +23,15 @@ import (
 	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/chains/atomic"
 	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
@@ -42,10 +46,14 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block/executor/executormock"
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis/genesistest"
+	"github.com/ava-labs/avalanchego/vms/platformvm/inflation"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakerfeed"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakertree"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/uptimehistory"
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators/fee"
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp/message"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
@@ -427,6 +435,49 @@ func TestGetBalance(t *testing.T) {
 	}
 }
 
+func TestGetLinkedAddresses(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	key := genesistest.DefaultFundedKeys[1]
+	message := "link my addresses"
+	sig, err := key.SignHash(accounts.TextHash([]byte(message)))
+	require.NoError(err)
+	// Ethereum signers offset the recovery id by 27
+	sig[len(sig)-1] += 27
+	sigStr, err := formatting.Encode(formatting.HexNC, sig)
+	require.NoError(err)
+
+	args := GetLinkedAddressesArgs{
+		Message:   message,
+		Signature: sigStr,
+	}
+	reply := GetLinkedAddressesReply{}
+	require.NoError(service.GetLinkedAddresses(nil, &args, &reply))
+
+	addr := key.Address()
+	pAddrStr, err := address.Format("P", constants.UnitTestHRP, addr.Bytes())
+	require.NoError(err)
+	xAddrStr, err := address.Format("X", constants.UnitTestHRP, addr.Bytes())
+	require.NoError(err)
+	require.Equal(pAddrStr, reply.PChainAddress)
+	require.Equal(xAddrStr, reply.XChainAddress)
+	require.Equal(key.EthAddress(), reply.CChainAddress)
+	require.Equal(avajson.Uint64(genesistest.DefaultInitialBalance), reply.PChainBalance.Balance)
+	require.Equal(avajson.Uint64(genesistest.DefaultInitialBalance), reply.PChainBalance.Unlocked)
+
+	// A signature of another message belongs to another key
+	args.Message = "another message"
+	reply = GetLinkedAddressesReply{}
+	if err := service.GetLinkedAddresses(nil, &args, &reply); err == nil {
+		require.NotEqual(pAddrStr, reply.PChainAddress)
+	}
+
+	args.Signature = "0x1234"
+	err = service.GetLinkedAddresses(nil, &args, &reply)
+	require.ErrorIs(err, secp256k1.ErrInvalidSig)
+}
+
 func TestGetStake(t *testing.T) {
 	require := require.New(t)
 	service, _ := defaultService(t)
@@ -773,6 +824,85 @@ func TestGetCurrentValidators(t *testing.T) {
 	}
 }
 
+func TestGetCurrentValidatorsPage(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	genesis := genesistest.New(t, genesistest.Config{})
+	expectedNodeIDs := make([]ids.NodeID, 0, len(genesis.Validators))
+	for _, validatorTx := range genesis.Validators {
+		validator := validatorTx.Unsigned.(*txs.AddValidatorTx)
+		expectedNodeIDs = append(expectedNodeIDs, validator.NodeID())
+	}
+	utils.Sort(expectedNodeIDs)
+
+	// Fetch all the validators, two at a time, in order of their nodeIDs
+	includeDelegators := true
+	args := GetCurrentValidatorsArgs{
+		SubnetID:          constants.PrimaryNetworkID,
+		IncludeDelegators: &includeDelegators,
+		PageSize:          2,
+	}
+	nodeIDs := []ids.NodeID{}
+	for {
+		response := GetCurrentValidatorsReply{}
+		require.NoError(service.GetCurrentValidators(nil, &args, &response))
+		require.LessOrEqual(len(response.Validators), 2)
+		for _, vdrIntf := range response.Validators {
+			vdr := vdrIntf.(pchainapi.PermissionlessValidator)
+			require.NotNil(vdr.Delegators)
+			nodeIDs = append(nodeIDs, vdr.NodeID)
+		}
+		if response.NextNodeID == nil {
+			break
+		}
+		args.StartNodeID = *response.NextNodeID
+	}
+	require.Equal(expectedNodeIDs, nodeIDs)
+
+	tests := []struct {
+		name          string
+		args          GetCurrentValidatorsArgs
+		expectedCount int
+	}{
+		{
+			name: "min weight",
+			args: GetCurrentValidatorsArgs{
+				MinWeight: avajson.Uint64(genesistest.DefaultValidatorWeight + 1),
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "end time range",
+			args: GetCurrentValidatorsArgs{
+				MinEndTime: avajson.Uint64(genesistest.DefaultValidatorEndTimeUnix),
+				MaxEndTime: avajson.Uint64(genesistest.DefaultValidatorEndTimeUnix),
+			},
+			expectedCount: len(genesis.Validators),
+		},
+		{
+			name: "end time before validators",
+			args: GetCurrentValidatorsArgs{
+				MaxEndTime: avajson.Uint64(genesistest.DefaultValidatorEndTimeUnix - 1),
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "start node ID after validators",
+			args: GetCurrentValidatorsArgs{
+				StartNodeID: ids.NodeID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			},
+			expectedCount: 0,
+		},
+	}
+	for _, test := range tests {
+		response := GetCurrentValidatorsReply{}
+		require.NoError(service.GetCurrentValidators(nil, &test.args, &response), test.name)
+		require.Len(response.Validators, test.expectedCount, test.name)
+		require.Nil(response.NextNodeID, test.name)
+	}
+}
+
 func TestGetValidatorsAt(t *testing.T) {
 	require := require.New(t)
 	service, _ := defaultService(t)
@@ -868,6 +998,101 @@ func TestGetValidatorsAt(t *testing.T) {
 	require.Len(response.Validators, len(genesis.Validators)+1)
 }
 
+func TestGetStakersAt(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	genesis := genesistest.New(t, genesistest.Config{})
+
+	service.vm.ctx.Lock.Lock()
+	lastAccepted := service.vm.manager.LastAccepted()
+	lastAcceptedBlk, err := service.vm.manager.GetBlock(lastAccepted)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	// Confirm that it returns the genesis validators given the latest height
+	args := GetStakersAtArgs{
+		Height: pchainapi.Height(lastAcceptedBlk.Height()),
+	}
+	response := GetStakersAtReply{}
+	require.NoError(service.GetStakersAt(&http.Request{}, &args, &response))
+	require.Len(response.Stakers, len(genesis.Validators))
+	for _, staker := range response.Stakers {
+		require.Equal(avajson.Uint8(stakertree.ValidatorType), staker.Type)
+		require.True(stakertree.Verify(response.Root, staker.Leaf, staker.Proof))
+	}
+	genesisRoot := response.Root
+
+	service.vm.ctx.Lock.Lock()
+
+	wallet := newWallet(t, service.vm, walletConfig{})
+	rewardsOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	sk, err := localsigner.New()
+	require.NoError(err)
+	pop, err := signer.NewProofOfPossession(sk)
+	require.NoError(err)
+
+	tx, err := wallet.IssueAddPermissionlessValidatorTx(
+		&txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: ids.GenerateTestNodeID(),
+				Start:  uint64(service.vm.clock.Time().Add(txexecutor.SyncBound).Unix()),
+				End:    uint64(service.vm.clock.Time().Add(txexecutor.SyncBound).Add(defaultMinStakingDuration).Unix()),
+				Wght:   service.vm.MinValidatorStake,
+			},
+			Subnet: constants.PrimaryNetworkID,
+		},
+		pop,
+		service.vm.ctx.AVAXAssetID,
+		rewardsOwner,
+		rewardsOwner,
+		0,
+	)
+	require.NoError(err)
+
+	service.vm.ctx.Lock.Unlock()
+	require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+	service.vm.ctx.Lock.Lock()
+
+	blk, err := service.vm.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+	service.vm.ctx.Lock.Unlock()
+
+	// Confirm that it returns the new validator given the new height
+	args.Height = pchainapi.Height(blk.Height())
+	require.NoError(service.GetStakersAt(&http.Request{}, &args, &response))
+	require.Len(response.Stakers, len(genesis.Validators)+1)
+	require.NotEqual(genesisRoot, response.Root)
+	found := false
+	for _, staker := range response.Stakers {
+		require.True(stakertree.Verify(response.Root, staker.Leaf, staker.Proof))
+		found = found || staker.TxID == tx.ID()
+	}
+	require.True(found)
+
+	// The genesis block was accepted before Durango, so the stakers at its
+	// height can not be computed from the current stakers
+	args.Height = pchainapi.Height(lastAcceptedBlk.Height())
+	err = service.GetStakersAt(&http.Request{}, &args, &response)
+	require.ErrorIs(err, errHeightBeforeDurango)
+
+	args.Height = pchainapi.Height(blk.Height() + 1)
+	err = service.GetStakersAt(&http.Request{}, &args, &response)
+	require.ErrorIs(err, errHeightNotAccepted)
+
+	// Undoing the blocks stops once the request is cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	args.Height = pchainapi.Height(lastAcceptedBlk.Height())
+	err = service.GetStakersAt((&http.Request{}).WithContext(ctx), &args, &response)
+	require.ErrorIs(err, context.Canceled)
+}
+
 func TestGetValidatorsAtArgsMarshalling(t *testing.T) {
 	subnetID, err := ids.FromString("u3Jjpzzj95827jdENvR1uc76f4zvvVQjGshbVWaSr2Ce5WV1H")
 	require.NoError(t, err)
@@ -931,6 +1156,292 @@ func TestGetTimestamp(t *testing.T) {
 	require.Equal(newTimestamp, reply.Timestamp)
 }
 
+func TestVerifyTx(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+	wallet := newWallet(t, service.vm, walletConfig{})
+	tx, err := wallet.IssueAddPermissionlessDelegatorTx(
+		&txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: genesistest.DefaultNodeIDs[0],
+				End:    uint64(genesistest.DefaultValidatorEndTime.Unix()),
+				Wght:   1,
+			},
+			Subnet: constants.PrimaryNetworkID,
+		},
+		service.vm.ctx.AVAXAssetID,
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		},
+	)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+
+	reply := VerifyTxReply{}
+	require.NoError(service.VerifyTx(nil, &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, &reply))
+	require.Equal(tx.ID(), reply.TxID)
+	require.False(reply.Valid)
+	require.NotEmpty(reply.Violations)
+	require.Equal("minDelegatorStake", reply.Violations[0].Rule)
+	require.Equal("1", reply.Violations[0].Value)
+	require.Equal(txexecutor.ErrWeightTooSmall.Error(), reply.Violations[0].Error)
+
+	// The tx wasn't issued
+	_, _, err = service.vm.state.GetTx(tx.ID())
+	require.ErrorIs(err, database.ErrNotFound)
+	_, ok := service.vm.Builder.Get(tx.ID())
+	require.False(ok)
+}
+
+func TestVerifyTxReportsAllViolations(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	// The delegator stakes too little and ends after its validator
+	service.vm.ctx.Lock.Lock()
+	wallet := newWallet(t, service.vm, walletConfig{})
+	tx, err := wallet.IssueAddPermissionlessDelegatorTx(
+		&txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: genesistest.DefaultNodeIDs[0],
+				End:    uint64(genesistest.DefaultValidatorEndTime.Add(time.Hour).Unix()),
+				Wght:   1,
+			},
+			Subnet: constants.PrimaryNetworkID,
+		},
+		service.vm.ctx.AVAXAssetID,
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		},
+	)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+
+	reply := VerifyTxReply{}
+	require.NoError(service.VerifyTx(nil, &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, &reply))
+	require.False(reply.Valid)
+
+	rules := make([]string, len(reply.Violations))
+	for i, violation := range reply.Violations {
+		rules[i] = violation.Rule
+	}
+	require.Contains(rules, "minDelegatorStake")
+	require.Contains(rules, "stakingPeriod")
+}
+
+func TestGetValidatorUptimeHistory(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	nodeID := genesistest.DefaultNodeIDs[0]
+	args := GetValidatorUptimeHistoryArgs{
+		NodeID:  nodeID,
+		ToEpoch: 1,
+	}
+	reply := GetValidatorUptimeHistoryReply{}
+	err := service.GetValidatorUptimeHistory(nil, &args, &reply)
+	require.ErrorIs(err, uptime.ErrHistoryDisabled)
+
+	service.vm.ctx.Lock.Lock()
+	now := service.vm.clock.Time()
+	service.vm.uptimeHistory = uptimehistory.New(memdb.New(), uptimehistory.Epochs{
+		Start:    now,
+		Duration: time.Hour,
+	})
+	service.vm.ctx.Lock.Unlock()
+
+	// The validators started before epoch 0, so their uptime in epoch 0 is
+	// unknown
+	service.vm.clock.Set(now.Add(time.Hour))
+	require.NoError(service.vm.observeUptimes(0))
+	service.vm.clock.Set(now.Add(2 * time.Hour))
+	require.NoError(service.vm.observeUptimes(1))
+
+	require.NoError(service.GetValidatorUptimeHistory(nil, &args, &reply))
+	require.Equal(avajson.Uint64(now.Unix()), reply.EpochStart)
+	require.Equal(avajson.Uint64(3600), reply.EpochDuration)
+	require.Len(reply.Epochs, 1)
+	require.Equal(avajson.Uint64(1), reply.Epochs[0].Epoch)
+	require.Equal(avajson.Uint64(now.Add(time.Hour).Unix()), reply.Epochs[0].StartTime)
+	require.Equal(avajson.Uint64(now.Add(2*time.Hour).Unix()), reply.Epochs[0].EndTime)
+	require.False(reply.Epochs[0].Approximate)
+
+	args.FromEpoch = 2
+	err = service.GetValidatorUptimeHistory(nil, &args, &reply)
+	require.ErrorIs(err, uptimehistory.ErrInvalidEpochRange)
+}
+
+func TestGetStakingParameters(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	reply := GetStakingParametersReply{}
+	require.NoError(service.GetStakingParameters(nil, &GetStakingParametersArgs{}, &reply))
+
+	service.vm.ctx.Lock.Lock()
+	chainTime := service.vm.state.GetTimestamp()
+	service.vm.ctx.Lock.Unlock()
+
+	require.Equal(avajson.Uint64(chainTime.Unix()), reply.Timestamp)
+	require.Equal(avajson.Uint64(service.vm.MinValidatorStake), reply.MinValidatorStake)
+	require.Equal(avajson.Uint64(service.vm.MaxValidatorStake), reply.MaxValidatorStake)
+	require.Equal(avajson.Uint64(service.vm.MinStakeDuration/time.Second), reply.MinStakeDuration)
+
+	phaseStart := chainTime.Add(time.Hour)
+	service.vm.InflationPhases = []inflation.Phase{{
+		Start: phaseStart,
+		Settings: inflation.Settings{
+			MinValidatorStake:        1,
+			MaxValidatorStake:        2,
+			MinDelegatorStake:        3,
+			MinDelegationFee:         4,
+			MinStakeDuration:         time.Hour,
+			MinDelegateDuration:      time.Minute,
+			MaxStakeDuration:         2 * time.Hour,
+			MinFutureStartTimeOffset: time.Second,
+			MaxValidatorWeightFactor: 5,
+			MinStakeStartTime:        phaseStart,
+		},
+	}}
+
+	require.NoError(service.GetStakingParameters(nil, &GetStakingParametersArgs{
+		Timestamp: avajson.Uint64(phaseStart.Unix()),
+	}, &reply))
+	require.Equal(GetStakingParametersReply{
+		Timestamp:                avajson.Uint64(phaseStart.Unix()),
+		MinValidatorStake:        1,
+		MaxValidatorStake:        2,
+		MinDelegatorStake:        3,
+		MinDelegationFee:         4,
+		MinStakeDuration:         3600,
+		MinDelegateDuration:      60,
+		MaxStakeDuration:         7200,
+		MinFutureStartTimeOffset: 1,
+		MaxValidatorWeightFactor: 5,
+		MinStakeStartTime:        avajson.Uint64(phaseStart.Unix()),
+	}, reply)
+}
+
+func TestGetDelegationCapacity(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	settings := inflation.Settings{
+		MinValidatorStake:        1,
+		MaxValidatorStake:        100 * genesistest.DefaultValidatorWeight,
+		MinDelegatorStake:        2,
+		MinStakeDuration:         time.Hour,
+		MinDelegateDuration:      time.Hour,
+		MaxStakeDuration:         365 * 24 * time.Hour,
+		MaxValidatorWeightFactor: 3,
+	}
+	service.vm.InflationPhases = []inflation.Phase{{
+		Settings: settings,
+	}}
+
+	args := GetDelegationCapacityArgs{
+		NodeID:  genesistest.DefaultNodeIDs[0],
+		EndTime: avajson.Uint64(genesistest.DefaultValidatorEndTime.Unix()),
+	}
+	reply := GetDelegationCapacityReply{}
+	require.NoError(service.GetDelegationCapacity(nil, &args, &reply))
+	require.Equal(GetDelegationCapacityReply{
+		ValidatorWeight:   avajson.Uint64(genesistest.DefaultValidatorWeight),
+		MaxWeight:         avajson.Uint64(3 * genesistest.DefaultValidatorWeight),
+		LimitingRule:      "maxValidatorWeightFactor",
+		CurrentMaxWeight:  avajson.Uint64(genesistest.DefaultValidatorWeight),
+		Capacity:          avajson.Uint64(2 * genesistest.DefaultValidatorWeight),
+		MinDelegatorStake: 2,
+	}, reply)
+
+	settings.MaxValidatorStake = 2 * genesistest.DefaultValidatorWeight
+	service.vm.InflationPhases[0].Settings = settings
+	require.NoError(service.GetDelegationCapacity(nil, &args, &reply))
+	require.Equal(avajson.Uint64(2*genesistest.DefaultValidatorWeight), reply.MaxWeight)
+	require.Equal("maxValidatorStake", reply.LimitingRule)
+	require.Equal(avajson.Uint64(genesistest.DefaultValidatorWeight), reply.Capacity)
+
+	// The delegation can't end after the validator
+	args.EndTime++
+	err := service.GetDelegationCapacity(nil, &args, &reply)
+	require.ErrorIs(err, txexecutor.ErrPeriodMismatch)
+
+	args.StartTime = args.EndTime
+	err = service.GetDelegationCapacity(nil, &args, &reply)
+	require.ErrorIs(err, errStartAfterEndTime)
+
+	args.NodeID = ids.GenerateTestNodeID()
+	args.StartTime = 0
+	err = service.GetDelegationCapacity(nil, &args, &reply)
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
+func TestGetStakerEvents(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	reply := GetStakerEventsReply{}
+	err := service.GetStakerEvents(nil, &GetStakerEventsArgs{}, &reply)
+	require.ErrorIs(err, stakerfeed.ErrIndexDisabled)
+
+	index := stakerfeed.NewIndex(memdb.New())
+	service.vm.stakerFeed = stakerfeed.New(logging.NoLog{}, index)
+
+	staker := &state.Staker{
+		TxID:     ids.GenerateTestID(),
+		NodeID:   ids.GenerateTestNodeID(),
+		SubnetID: constants.PrimaryNetworkID,
+		Weight:   1,
+		Priority: txs.PrimaryNetworkValidatorCurrentPriority,
+	}
+	timestamp := time.Unix(1_000, 0)
+	service.vm.stakerFeed.Accept(10, timestamp, &state.StakerChanges{})
+	service.vm.stakerFeed.Accept(11, timestamp, &state.StakerChanges{
+		AddedCurrent: []*state.Staker{staker},
+	})
+	service.vm.stakerFeed.Accept(12, timestamp, &state.StakerChanges{
+		DeletedCurrent: []*state.Staker{staker},
+	})
+
+	require.NoError(service.GetStakerEvents(nil, &GetStakerEventsArgs{}, &reply))
+	require.Equal(avajson.Uint64(10), reply.IndexedFromHeight)
+	require.Len(reply.Events, 2)
+	require.Equal(stakerfeed.ValidatorAdded, reply.Events[0].Type)
+	require.Equal(stakerfeed.StakerRemoved, reply.Events[1].Type)
+	require.Nil(reply.NextHeight)
+
+	reply = GetStakerEventsReply{}
+	require.NoError(service.GetStakerEvents(nil, &GetStakerEventsArgs{
+		FromHeight: 11,
+		Limit:      1,
+	}, &reply))
+	require.Len(reply.Events, 1)
+	require.Equal(staker.TxID, reply.Events[0].TxID)
+	require.NotNil(reply.NextHeight)
+	require.Equal(avajson.Uint64(12), *reply.NextHeight)
+
+	err = service.GetStakerEvents(nil, &GetStakerEventsArgs{
+		FromHeight: 9,
+	}, &GetStakerEventsReply{})
+	require.ErrorIs(err, stakerfeed.ErrHeightNotIndexed)
+}
+
 func TestGetBlock(t *testing.T) {
 	tests := []struct {
 		name     string
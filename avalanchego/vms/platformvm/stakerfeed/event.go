@@ -0,0 +1,145 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+// Package stakerfeed reports the changes made to the staker sets of the
+// P-chain by accepted blocks, both to live subscribers and, if enabled, from
+// an index of the past events.
+package stakerfeed
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+)
+
+type EventType string
+
+const (
+	// ValidatorAdded is emitted when a validator is added to the pending or
+	// the current staker set by a tx.
+	ValidatorAdded EventType = "validatorAdded"
+	// DelegatorAdded is emitted when a delegator is added to the pending or
+	// the current staker set by a tx.
+	DelegatorAdded EventType = "delegatorAdded"
+	// StakerStarted is emitted when a staker is moved from the pending to the
+	// current staker set.
+	StakerStarted EventType = "stakerStarted"
+	// StakerRemoved is emitted when a staker is removed from the current
+	// staker set.
+	StakerRemoved EventType = "stakerRemoved"
+	// RewardUTXOCreated is emitted for each reward UTXO created when a staker
+	// is removed.
+	RewardUTXOCreated EventType = "rewardUTXOCreated"
+)
+
+// Event is a change of the staker sets made by the block accepted at Height.
+type Event struct {
+	Type EventType `json:"type"`
+	// Height and Timestamp of the block
+	Height    json.Uint64 `json:"height"`
+	Timestamp json.Uint64 `json:"timestamp"`
+	// TxID is the ID of the tx that added the staker
+	TxID     ids.ID     `json:"txID"`
+	SubnetID ids.ID     `json:"subnetID"`
+	NodeID   ids.NodeID `json:"nodeID"`
+	// Weight and staking period of the staker. They are omitted in
+	// RewardUTXOCreated events.
+	Weight    json.Uint64 `json:"weight,omitempty"`
+	StartTime json.Uint64 `json:"startTime,omitempty"`
+	EndTime   json.Uint64 `json:"endTime,omitempty"`
+	// Pending is true if the staker was added to the pending staker set
+	Pending bool `json:"pending,omitempty"`
+	// UTXOID and Amount of the reward UTXO of a RewardUTXOCreated event
+	UTXOID string      `json:"utxoID,omitempty"`
+	Amount json.Uint64 `json:"amount,omitempty"`
+}
+
+// NewEvents returns the events of the [changes] made by the block accepted at
+// [height] with [timestamp]. Stakers added to the pending set come first, then
+// the stakers added to the current set, the removed stakers and their reward
+// UTXOs.
+func NewEvents(height uint64, timestamp time.Time, changes *state.StakerChanges) []Event {
+	var (
+		events         []Event
+		deletedPending = make(map[ids.ID]struct{}, len(changes.DeletedPending))
+		deleted        = make(map[ids.ID]*state.Staker)
+		newEvent       = func(typ EventType, staker *state.Staker) Event {
+			return Event{
+				Type:      typ,
+				Height:    json.Uint64(height),
+				Timestamp: json.Uint64(timestamp.Unix()),
+				TxID:      staker.TxID,
+				SubnetID:  staker.SubnetID,
+				NodeID:    staker.NodeID,
+				Weight:    json.Uint64(staker.Weight),
+				StartTime: json.Uint64(staker.StartTime.Unix()),
+				EndTime:   json.Uint64(staker.EndTime.Unix()),
+			}
+		}
+	)
+	for _, staker := range changes.DeletedPending {
+		deletedPending[staker.TxID] = struct{}{}
+	}
+
+	for _, staker := range changes.AddedPending {
+		event := newEvent(addedEventType(staker), staker)
+		event.Pending = true
+		events = append(events, event)
+	}
+	for _, staker := range changes.AddedCurrent {
+		if _, ok := deletedPending[staker.TxID]; ok {
+			delete(deletedPending, staker.TxID)
+			events = append(events, newEvent(StakerStarted, staker))
+			continue
+		}
+		events = append(events, newEvent(addedEventType(staker), staker))
+	}
+	for _, staker := range changes.DeletedCurrent {
+		deleted[staker.TxID] = staker
+		events = append(events, newEvent(StakerRemoved, staker))
+	}
+	// A pending staker is only deleted when it starts, but a deletion that
+	// isn't matched by an addition is still reported.
+	for _, staker := range changes.DeletedPending {
+		if _, ok := deletedPending[staker.TxID]; ok {
+			deleted[staker.TxID] = staker
+			events = append(events, newEvent(StakerRemoved, staker))
+		}
+	}
+
+	txIDs := make([]ids.ID, 0, len(changes.RewardUTXOs))
+	for txID := range changes.RewardUTXOs {
+		txIDs = append(txIDs, txID)
+	}
+	utils.Sort(txIDs)
+	for _, txID := range txIDs {
+		for _, utxo := range changes.RewardUTXOs[txID] {
+			event := Event{
+				Type:      RewardUTXOCreated,
+				Height:    json.Uint64(height),
+				Timestamp: json.Uint64(timestamp.Unix()),
+				TxID:      txID,
+				UTXOID:    utxo.UTXOID.String(),
+			}
+			if staker, ok := deleted[txID]; ok {
+				event.SubnetID = staker.SubnetID
+				event.NodeID = staker.NodeID
+			}
+			if out, ok := utxo.Out.(interface{ Amount() uint64 }); ok {
+				event.Amount = json.Uint64(out.Amount())
+			}
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func addedEventType(staker *state.Staker) EventType {
+	if staker.Priority.IsValidator() {
+		return ValidatorAdded
+	}
+	return DelegatorAdded
+}
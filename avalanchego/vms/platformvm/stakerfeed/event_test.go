@@ -0,0 +1,102 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package stakerfeed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func newTestStaker(priority txs.Priority) *state.Staker {
+	return &state.Staker{
+		TxID:      ids.GenerateTestID(),
+		NodeID:    ids.GenerateTestNodeID(),
+		SubnetID:  constants.PrimaryNetworkID,
+		Weight:    10,
+		StartTime: time.Unix(1_000, 0),
+		EndTime:   time.Unix(2_000, 0),
+		Priority:  priority,
+	}
+}
+
+func TestNewEvents(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		timestamp        = time.Unix(1_500, 0)
+		pendingValidator = newTestStaker(txs.PrimaryNetworkValidatorPendingPriority)
+		startedDelegator = newTestStaker(txs.PrimaryNetworkDelegatorCurrentPriority)
+		addedValidator   = newTestStaker(txs.PrimaryNetworkValidatorCurrentPriority)
+		removedValidator = newTestStaker(txs.PrimaryNetworkValidatorCurrentPriority)
+		rewardUTXO       = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID:        removedValidator.TxID,
+				OutputIndex: 1,
+			},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 5,
+			},
+		}
+	)
+	pendingDelegator := *startedDelegator
+	pendingDelegator.Priority = txs.PrimaryNetworkDelegatorBanffPendingPriority
+
+	events := NewEvents(7, timestamp, &state.StakerChanges{
+		AddedCurrent:   []*state.Staker{startedDelegator, addedValidator},
+		DeletedCurrent: []*state.Staker{removedValidator},
+		AddedPending:   []*state.Staker{pendingValidator},
+		DeletedPending: []*state.Staker{&pendingDelegator},
+		RewardUTXOs: map[ids.ID][]*avax.UTXO{
+			removedValidator.TxID: {rewardUTXO},
+		},
+	})
+
+	newEvent := func(typ EventType, staker *state.Staker) Event {
+		return Event{
+			Type:      typ,
+			Height:    7,
+			Timestamp: 1_500,
+			TxID:      staker.TxID,
+			SubnetID:  staker.SubnetID,
+			NodeID:    staker.NodeID,
+			Weight:    10,
+			StartTime: 1_000,
+			EndTime:   2_000,
+		}
+	}
+	pendingEvent := newEvent(ValidatorAdded, pendingValidator)
+	pendingEvent.Pending = true
+	require.Equal([]Event{
+		pendingEvent,
+		newEvent(StakerStarted, startedDelegator),
+		newEvent(ValidatorAdded, addedValidator),
+		newEvent(StakerRemoved, removedValidator),
+		{
+			Type:      RewardUTXOCreated,
+			Height:    7,
+			Timestamp: 1_500,
+			TxID:      removedValidator.TxID,
+			SubnetID:  removedValidator.SubnetID,
+			NodeID:    removedValidator.NodeID,
+			UTXOID:    rewardUTXO.UTXOID.String(),
+			Amount:    5,
+		},
+	}, events)
+}
+
+func TestNewEventsEmpty(t *testing.T) {
+	require := require.New(t)
+
+	events := NewEvents(1, time.Unix(0, 0), &state.StakerChanges{})
+	require.Empty(events)
+}
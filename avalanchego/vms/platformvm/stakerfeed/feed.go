@@ -0,0 +1,265 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package stakerfeed
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+)
+
+const (
+	// NotificationMethod is the method of the JSON-RPC notifications sent to
+	// the subscribers.
+	NotificationMethod = "platform.stakerEvents"
+
+	// NodeIDParam is the query parameter that restricts a subscription to the
+	// events of some nodes. It can be repeated.
+	NodeIDParam = "nodeID"
+
+	// Number of notifications buffered for a subscriber before it is
+	// disconnected for being too slow
+	subscriberBufferSize = 1024
+
+	writeWait  = 10 * time.Second
+	pongWait   = time.Minute
+	pingPeriod = pongWait * 9 / 10
+
+	// Subscribers don't send messages other than the control frames
+	maxReadMessageSize = 1024
+)
+
+var (
+	_ http.Handler = (*Feed)(nil)
+
+	errClosed = errors.New("staker feed is closed")
+)
+
+// Notification is the JSON-RPC notification sent to the subscribers for each
+// accepted block that changed the staker sets.
+type Notification struct {
+	JSONRPC string             `json:"jsonrpc"`
+	Method  string             `json:"method"`
+	Params  NotificationParams `json:"params"`
+}
+
+type NotificationParams struct {
+	Events []Event `json:"events"`
+}
+
+// Feed publishes the events of the accepted blocks to the websocket
+// subscribers and to the index, if enabled.
+type Feed struct {
+	log      logging.Logger
+	index    *Index
+	upgrader websocket.Upgrader
+
+	lock        sync.Mutex
+	closed      bool
+	subscribers set.Set[*subscriber]
+}
+
+// New returns a feed that indexes the events in [index], unless it is nil.
+func New(log logging.Logger, index *Index) *Feed {
+	return &Feed{
+		log:   log,
+		index: index,
+		upgrader: websocket.Upgrader{
+			// Subscriptions are read-only, so they can be opened from any
+			// origin
+			CheckOrigin: func(*http.Request) bool {
+				return true
+			},
+		},
+	}
+}
+
+// Index returns the index of the events, or nil if it is disabled.
+func (f *Feed) Index() *Index {
+	return f.index
+}
+
+// Accept publishes the [changes] made to the staker sets by the block accepted
+// at [height] with [timestamp].
+func (f *Feed) Accept(height uint64, timestamp time.Time, changes *state.StakerChanges) {
+	events := NewEvents(height, timestamp, changes)
+	if f.index != nil {
+		// The index is local to this node, so failing to update it isn't
+		// fatal to the chain
+		if err := f.index.Accept(height, events); err != nil {
+			f.log.Warn("failed to index staker events",
+				zap.Uint64("height", height),
+				zap.Error(err),
+			)
+		}
+	}
+	if len(events) == 0 {
+		return
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for s := range f.subscribers {
+		s.send(events)
+	}
+}
+
+// ServeHTTP subscribes the websocket connection of [r] to the feed.
+func (f *Feed) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var nodeIDs set.Set[ids.NodeID]
+	for _, nodeIDStr := range r.URL.Query()[NodeIDParam] {
+		nodeID, err := ids.NodeIDFromString(nodeIDStr)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		nodeIDs.Add(nodeID)
+	}
+
+	// Holding the lock during the handshake guarantees that the subscriber
+	// receives the events of all the blocks accepted after it completes.
+	f.lock.Lock()
+	if f.closed {
+		f.lock.Unlock()
+		http.Error(w, errClosed.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	conn, err := f.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		f.lock.Unlock()
+		// The upgrader already replied with an error
+		f.log.Debug("failed to upgrade staker feed connection",
+			zap.Error(err),
+		)
+		return
+	}
+	s := &subscriber{
+		conn:          conn,
+		nodeIDs:       nodeIDs,
+		notifications: make(chan *Notification, subscriberBufferSize),
+		closed:        make(chan struct{}),
+	}
+	f.subscribers.Add(s)
+	f.lock.Unlock()
+
+	go s.writeNotifications(f.log)
+	s.readUntilClosed()
+
+	f.lock.Lock()
+	f.subscribers.Remove(s)
+	f.lock.Unlock()
+	s.close()
+}
+
+// Close disconnects all the subscribers.
+func (f *Feed) Close() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.closed = true
+	for s := range f.subscribers {
+		s.close()
+	}
+	f.subscribers.Clear()
+}
+
+type subscriber struct {
+	conn *websocket.Conn
+	// Empty if the subscriber receives the events of all nodes
+	nodeIDs       set.Set[ids.NodeID]
+	notifications chan *Notification
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// send queues the notification of the [events] the subscriber is interested
+// in. A subscriber that can't keep up is disconnected rather than slowing down
+// the acceptance of blocks.
+func (s *subscriber) send(events []Event) {
+	if s.nodeIDs.Len() != 0 {
+		filtered := make([]Event, 0, len(events))
+		for _, event := range events {
+			if s.nodeIDs.Contains(event.NodeID) {
+				filtered = append(filtered, event)
+			}
+		}
+		events = filtered
+	}
+	if len(events) == 0 {
+		return
+	}
+
+	select {
+	case s.notifications <- &Notification{
+		JSONRPC: "2.0",
+		Method:  NotificationMethod,
+		Params: NotificationParams{
+			Events: events,
+		},
+	}:
+	default:
+		s.close()
+	}
+}
+
+func (s *subscriber) close() {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+		_ = s.conn.Close()
+	})
+}
+
+// readUntilClosed handles the control frames of the connection until it is
+// closed.
+func (s *subscriber) readUntilClosed() {
+	s.conn.SetReadLimit(maxReadMessageSize)
+	_ = s.conn.SetReadDeadline(time.Now().Add(pongWait))
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+	for {
+		if _, _, err := s.conn.NextReader(); err != nil {
+			return
+		}
+	}
+}
+
+func (s *subscriber) writeNotifications(log logging.Logger) {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		s.close()
+	}()
+
+	for {
+		select {
+		case <-s.closed:
+			return
+		case notification := <-s.notifications:
+			_ = s.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := s.conn.WriteJSON(notification); err != nil {
+				log.Debug("failed to write staker events",
+					zap.Error(err),
+				)
+				return
+			}
+		case <-ticker.C:
+			_ = s.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := s.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
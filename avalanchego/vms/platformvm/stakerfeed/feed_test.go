@@ -0,0 +1,82 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package stakerfeed
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+func TestFeed(t *testing.T) {
+	require := require.New(t)
+
+	index := NewIndex(memdb.New())
+	feed := New(logging.NoLog{}, index)
+	server := httptest.NewServer(feed)
+	defer server.Close()
+	uri := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	var (
+		validator = newTestStaker(txs.PrimaryNetworkValidatorCurrentPriority)
+		delegator = newTestStaker(txs.PrimaryNetworkDelegatorCurrentPriority)
+		timestamp = time.Unix(1_500, 0)
+	)
+
+	ctx := context.Background()
+	all, err := Subscribe(ctx, uri)
+	require.NoError(err)
+	defer all.Close()
+	filtered, err := Subscribe(ctx, uri, delegator.NodeID)
+	require.NoError(err)
+	defer filtered.Close()
+
+	// Blocks without staker changes aren't notified
+	feed.Accept(1, timestamp, &state.StakerChanges{})
+	feed.Accept(2, timestamp, &state.StakerChanges{
+		AddedCurrent: []*state.Staker{validator},
+	})
+	feed.Accept(3, timestamp, &state.StakerChanges{
+		AddedCurrent: []*state.Staker{delegator},
+	})
+
+	events, err := all.Next()
+	require.NoError(err)
+	require.Len(events, 1)
+	require.Equal(ValidatorAdded, events[0].Type)
+	require.Equal(validator.TxID, events[0].TxID)
+
+	events, err = all.Next()
+	require.NoError(err)
+	require.Len(events, 1)
+	require.Equal(DelegatorAdded, events[0].Type)
+
+	events, err = filtered.Next()
+	require.NoError(err)
+	require.Len(events, 1)
+	require.Equal(delegator.TxID, events[0].TxID)
+	require.Equal(uint64(3), uint64(events[0].Height))
+
+	// The events were indexed as well
+	indexed, _, _, err := index.GetEvents(1, 3, MaxEvents)
+	require.NoError(err)
+	require.Len(indexed, 2)
+
+	feed.Close()
+	_, err = all.Next()
+	require.Error(err) //nolint:forbidigo // the error depends on how the connection is closed
+
+	_, err = Subscribe(ctx, uri)
+	require.ErrorIs(err, websocket.ErrBadHandshake)
+}
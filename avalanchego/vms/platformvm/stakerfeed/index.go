@@ -0,0 +1,137 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package stakerfeed
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/database/versiondb"
+)
+
+// MaxEvents is the maximum number of events returned by GetEvents, unless the
+// events of a single height exceed it.
+const MaxEvents = 1024
+
+var (
+	eventsPrefix   = []byte("events")
+	metadataPrefix = []byte("metadata")
+
+	firstHeightKey = []byte("firstHeight")
+	lastHeightKey  = []byte("lastHeight")
+
+	ErrIndexDisabled      = errors.New("staker event index is disabled")
+	ErrInvalidHeightRange = errors.New("toHeight is before fromHeight")
+	ErrHeightNotIndexed   = errors.New("height is not indexed")
+)
+
+// Index stores the events of the accepted blocks by height. Only the blocks
+// accepted after the index was enabled are indexed.
+//
+// The index covers the consecutive heights from the first to the last indexed
+// block. If a block is accepted without being indexed, because the index was
+// disabled or the node stopped after the block was accepted, the events indexed
+// before it are deleted and the index only covers the blocks indexed after it.
+type Index struct {
+	db       *versiondb.Database
+	events   database.Database
+	metadata database.Database
+}
+
+func NewIndex(db database.Database) *Index {
+	vdb := versiondb.New(db)
+	return &Index{
+		db:       vdb,
+		events:   prefixdb.New(eventsPrefix, vdb),
+		metadata: prefixdb.New(metadataPrefix, vdb),
+	}
+}
+
+// Accept indexes the [events] of the block accepted at [height].
+func (i *Index) Accept(height uint64, events []Event) error {
+	defer i.db.Abort()
+
+	lastHeight, err := database.GetUInt64(i.metadata, lastHeightKey)
+	switch {
+	case errors.Is(err, database.ErrNotFound) || (err == nil && height != lastHeight+1):
+		// The blocks before [height] were not all indexed, so the events
+		// indexed before the gap are dropped.
+		if err := database.AtomicClear(i.events, i.events); err != nil {
+			return err
+		}
+		if err := database.PutUInt64(i.metadata, firstHeightKey, height); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	}
+	if err := database.PutUInt64(i.metadata, lastHeightKey, height); err != nil {
+		return err
+	}
+
+	if len(events) != 0 {
+		b, err := json.Marshal(events)
+		if err != nil {
+			return err
+		}
+		if err := i.events.Put(database.PackUInt64(height), b); err != nil {
+			return err
+		}
+	}
+	return i.db.Commit()
+}
+
+// FirstHeight returns the height of the first indexed block, or
+// [database.ErrNotFound] if no block was indexed.
+func (i *Index) FirstHeight() (uint64, error) {
+	return database.GetUInt64(i.metadata, firstHeightKey)
+}
+
+// GetEvents returns the events of the blocks from [fromHeight] to [toHeight]
+// inclusive, in order. At most [limit] events are returned, but the events of
+// a height are never split. If not all the events were returned, the height
+// to continue from is returned as well.
+//
+// [fromHeight] must not be before the first indexed block.
+func (i *Index) GetEvents(fromHeight, toHeight uint64, limit int) ([]Event, uint64, bool, error) {
+	if toHeight < fromHeight {
+		return nil, 0, false, fmt.Errorf("%w: %d < %d", ErrInvalidHeightRange, toHeight, fromHeight)
+	}
+	firstHeight, err := i.FirstHeight()
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+		return nil, 0, false, fmt.Errorf("%w: no block was indexed", ErrHeightNotIndexed)
+	case err != nil:
+		return nil, 0, false, err
+	case fromHeight < firstHeight:
+		return nil, 0, false, fmt.Errorf("%w: first indexed height is %d", ErrHeightNotIndexed, firstHeight)
+	}
+
+	it := i.events.NewIteratorWithStart(database.PackUInt64(fromHeight))
+	defer it.Release()
+
+	events := []Event{}
+	for it.Next() {
+		height, err := database.ParseUInt64(it.Key())
+		if err != nil {
+			return nil, 0, false, err
+		}
+		if height > toHeight {
+			break
+		}
+		if len(events) >= limit {
+			return events, height, true, nil
+		}
+
+		var heightEvents []Event
+		if err := json.Unmarshal(it.Value(), &heightEvents); err != nil {
+			return nil, 0, false, err
+		}
+		events = append(events, heightEvents...)
+	}
+	return events, 0, false, it.Error()
+}
@@ -0,0 +1,111 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package stakerfeed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/json"
+)
+
+func newTestEvents(height uint64, n int) []Event {
+	events := make([]Event, n)
+	for i := range events {
+		events[i] = Event{
+			Type:   ValidatorAdded,
+			Height: json.Uint64(height),
+			TxID:   ids.GenerateTestID(),
+			NodeID: ids.GenerateTestNodeID(),
+		}
+	}
+	return events
+}
+
+func TestIndex(t *testing.T) {
+	require := require.New(t)
+
+	index := NewIndex(memdb.New())
+	_, err := index.FirstHeight()
+	require.ErrorIs(err, database.ErrNotFound)
+
+	var (
+		events5 = newTestEvents(5, 2)
+		events7 = newTestEvents(7, 1)
+		events8 = newTestEvents(8, 2)
+	)
+	require.NoError(index.Accept(4, nil))
+	require.NoError(index.Accept(5, events5))
+	require.NoError(index.Accept(6, nil))
+	require.NoError(index.Accept(7, events7))
+	require.NoError(index.Accept(8, events8))
+
+	firstHeight, err := index.FirstHeight()
+	require.NoError(err)
+	require.Equal(uint64(4), firstHeight)
+
+	events, _, more, err := index.GetEvents(4, 10, MaxEvents)
+	require.NoError(err)
+	require.False(more)
+	require.Equal(append(append(events5, events7...), events8...), events)
+
+	// The events of a height are never split
+	events, nextHeight, more, err := index.GetEvents(5, 10, 1)
+	require.NoError(err)
+	require.True(more)
+	require.Equal(uint64(7), nextHeight)
+	require.Equal(events5, events)
+
+	events, _, more, err = index.GetEvents(6, 7, 1)
+	require.NoError(err)
+	require.False(more)
+	require.Equal(events7, events)
+
+	events, _, more, err = index.GetEvents(9, 10, MaxEvents)
+	require.NoError(err)
+	require.False(more)
+	require.Empty(events)
+
+	_, _, _, err = index.GetEvents(2, 1, MaxEvents)
+	require.ErrorIs(err, ErrInvalidHeightRange)
+
+	_, _, _, err = index.GetEvents(3, 10, MaxEvents)
+	require.ErrorIs(err, ErrHeightNotIndexed)
+}
+
+func TestIndexGap(t *testing.T) {
+	require := require.New(t)
+
+	index := NewIndex(memdb.New())
+	_, _, _, err := index.GetEvents(1, 10, MaxEvents)
+	require.ErrorIs(err, ErrHeightNotIndexed)
+
+	require.NoError(index.Accept(4, newTestEvents(4, 1)))
+	require.NoError(index.Accept(5, nil))
+
+	// The blocks 6 and 7 were accepted without being indexed
+	events8 := newTestEvents(8, 1)
+	require.NoError(index.Accept(8, events8))
+
+	firstHeight, err := index.FirstHeight()
+	require.NoError(err)
+	require.Equal(uint64(8), firstHeight)
+
+	_, _, _, err = index.GetEvents(4, 10, MaxEvents)
+	require.ErrorIs(err, ErrHeightNotIndexed)
+
+	// The events indexed before the gap were deleted
+	has, err := index.events.Has(database.PackUInt64(4))
+	require.NoError(err)
+	require.False(has)
+
+	events, _, more, err := index.GetEvents(8, 10, MaxEvents)
+	require.NoError(err)
+	require.False(more)
+	require.Equal(events8, events)
+}
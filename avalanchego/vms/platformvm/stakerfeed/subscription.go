@@ -0,0 +1,54 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package stakerfeed
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+// Subscription receives the events published by a feed.
+type Subscription struct {
+	conn *websocket.Conn
+}
+
+// Subscribe connects to the feed at [uri], for example
+// ws://127.0.0.1:9650/ext/bc/P/events. If [nodeIDs] are given, only their
+// events are received.
+func Subscribe(ctx context.Context, uri string, nodeIDs ...ids.NodeID) (*Subscription, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	for _, nodeID := range nodeIDs {
+		query.Add(NodeIDParam, nodeID.String())
+	}
+	u.RawQuery = query.Encode()
+
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to %s: %w", uri, err)
+	}
+	_ = resp.Body.Close()
+	return &Subscription{conn: conn}, nil
+}
+
+// Next blocks until the events of the next accepted block are received.
+func (s *Subscription) Next() ([]Event, error) {
+	var notification Notification
+	if err := s.conn.ReadJSON(&notification); err != nil {
+		return nil, err
+	}
+	return notification.Params.Events, nil
+}
+
+func (s *Subscription) Close() error {
+	return s.conn.Close()
+}
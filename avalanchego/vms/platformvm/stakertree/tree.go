@@ -0,0 +1,131 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+// Package stakertree commits to a set of stakers with a Merkle tree, whose
+// proofs can be verified on the C-chain with the MerkleProof library of
+// OpenZeppelin.
+package stakertree
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+)
+
+const (
+	ValidatorType uint8 = 0
+	DelegatorType uint8 = 1
+
+	wordLen = 32
+)
+
+var _ utils.Sortable[*Staker] = (*Staker)(nil)
+
+// Staker is a leaf of the tree.
+type Staker struct {
+	TxID    ids.ID
+	NodeID  ids.NodeID
+	Type    uint8
+	Weight  uint64
+	EndTime uint64
+}
+
+func (s *Staker) Compare(other *Staker) int {
+	return s.TxID.Compare(other.TxID)
+}
+
+// Leaf returns the hash of [s] in the tree, which is computed in Solidity as
+//
+//	keccak256(bytes.concat(keccak256(abi.encode(txID, nodeID, stakerType, weight, endTime))))
+//
+// where txID is a bytes32, nodeID is a bytes20, stakerType is a uint8 and
+// weight and endTime are uint64s.
+func (s *Staker) Leaf() common.Hash {
+	encoded := make([]byte, 5*wordLen)
+	copy(encoded, s.TxID[:])
+	// bytesN values are left aligned, while integers are right aligned
+	copy(encoded[wordLen:], s.NodeID[:])
+	encoded[3*wordLen-1] = s.Type
+	binary.BigEndian.PutUint64(encoded[4*wordLen-8:], s.Weight)
+	binary.BigEndian.PutUint64(encoded[5*wordLen-8:], s.EndTime)
+	return crypto.Keccak256Hash(crypto.Keccak256(encoded))
+}
+
+// Tree is a Merkle tree whose nodes are the keccak256 hash of their sorted
+// children. If a level has an odd number of nodes, the last node is moved to
+// the next level as is.
+type Tree struct {
+	// levels[0] are the leaves and the last level is the root
+	levels [][]common.Hash
+}
+
+// New returns the tree of [stakers], which are sorted by txID in place so
+// that the tree does not depend on their order. The proof of stakers[i] is
+// returned by Proof(i).
+func New(stakers []*Staker) *Tree {
+	utils.Sort(stakers)
+
+	level := make([]common.Hash, len(stakers))
+	for i, staker := range stakers {
+		level[i] = staker.Leaf()
+	}
+	levels := [][]common.Hash{level}
+	for len(level) > 1 {
+		nextLevel := make([]common.Hash, 0, (len(level)+1)/2)
+		for i := 0; i+1 < len(level); i += 2 {
+			nextLevel = append(nextLevel, hashPair(level[i], level[i+1]))
+		}
+		if len(level)%2 == 1 {
+			nextLevel = append(nextLevel, level[len(level)-1])
+		}
+		levels = append(levels, nextLevel)
+		level = nextLevel
+	}
+	return &Tree{
+		levels: levels,
+	}
+}
+
+// Root returns the root of the tree, or the zero hash if the tree is empty.
+func (t *Tree) Root() common.Hash {
+	root := t.levels[len(t.levels)-1]
+	if len(root) == 0 {
+		return common.Hash{}
+	}
+	return root[0]
+}
+
+// Proof returns the sibling hashes from the leaf at [index] to the root.
+func (t *Tree) Proof(index int) []common.Hash {
+	proof := []common.Hash{}
+	for _, level := range t.levels[:len(t.levels)-1] {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		index /= 2
+	}
+	return proof
+}
+
+// Verify returns true if [proof] proves that [leaf] is in the tree with
+// [root], as MerkleProof.verify does.
+func Verify(root common.Hash, leaf common.Hash, proof []common.Hash) bool {
+	hash := leaf
+	for _, sibling := range proof {
+		hash = hashPair(hash, sibling)
+	}
+	return hash == root
+}
+
+func hashPair(a, b common.Hash) common.Hash {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256Hash(a[:], b[:])
+}
@@ -0,0 +1,95 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package stakertree
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func newTestStakers(n int) []*Staker {
+	stakers := make([]*Staker, n)
+	for i := range stakers {
+		stakers[i] = &Staker{
+			TxID:    ids.GenerateTestID(),
+			NodeID:  ids.GenerateTestNodeID(),
+			Type:    uint8(i % 2),
+			Weight:  uint64(i + 1),
+			EndTime: uint64(1_000 + i),
+		}
+	}
+	return stakers
+}
+
+func TestLeafABIEncoding(t *testing.T) {
+	require := require.New(t)
+
+	newType := func(typ string) abi.Type {
+		abiType, err := abi.NewType(typ, "", nil)
+		require.NoError(err)
+		return abiType
+	}
+	args := abi.Arguments{
+		{Type: newType("bytes32")},
+		{Type: newType("bytes20")},
+		{Type: newType("uint8")},
+		{Type: newType("uint64")},
+		{Type: newType("uint64")},
+	}
+
+	staker := newTestStakers(2)[1]
+	encoded, err := args.Pack(
+		[32]byte(staker.TxID),
+		[20]byte(staker.NodeID),
+		staker.Type,
+		staker.Weight,
+		staker.EndTime,
+	)
+	require.NoError(err)
+	require.Equal(crypto.Keccak256Hash(crypto.Keccak256(encoded)), staker.Leaf())
+}
+
+func TestProof(t *testing.T) {
+	require := require.New(t)
+
+	for n := 1; n <= 9; n++ {
+		stakers := newTestStakers(n)
+		tree := New(stakers)
+		root := tree.Root()
+		for i, staker := range stakers {
+			proof := tree.Proof(i)
+			require.True(Verify(root, staker.Leaf(), proof), "n=%d i=%d", n, i)
+
+			otherStaker := *staker
+			otherStaker.Weight++
+			require.False(Verify(root, otherStaker.Leaf(), proof), "n=%d i=%d", n, i)
+		}
+	}
+}
+
+func TestNewSortsStakers(t *testing.T) {
+	require := require.New(t)
+
+	stakers := newTestStakers(5)
+	reversed := make([]*Staker, len(stakers))
+	for i, staker := range stakers {
+		reversed[len(stakers)-1-i] = staker
+	}
+
+	require.Equal(New(stakers).Root(), New(reversed).Root())
+	require.Equal(stakers, reversed)
+}
+
+func TestEmptyTree(t *testing.T) {
+	require := require.New(t)
+
+	tree := New(nil)
+	require.Equal(common.Hash{}, tree.Root())
+}
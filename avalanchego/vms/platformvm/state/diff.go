@@ -29,6 +29,10 @@ type Diff interface {
 	Chain
 
 	Apply(Chain) error
+
+	// GetStakerChanges returns the changes made by this diff to the staker
+	// sets and to the reward UTXOs of its parent.
+	GetStakerChanges() *StakerChanges
 }
 
 type diff struct {
@@ -542,6 +542,84 @@ func TestDiffPendingDelegator(t *testing.T) {
 	require.False(gotPendingDelegatorIter.Next())
 }
 
+func TestDiffStakerChanges(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	state := NewMockState(ctrl)
+	// Called in NewDiffOn
+	state.EXPECT().GetTimestamp().Return(time.Now()).Times(1)
+	state.EXPECT().GetFeeState().Return(gas.State{}).Times(1)
+	state.EXPECT().GetL1ValidatorExcess().Return(gas.Gas(0)).Times(1)
+	state.EXPECT().GetAccruedFees().Return(uint64(0)).Times(1)
+	state.EXPECT().NumActiveL1Validators().Return(0).Times(1)
+
+	d, err := NewDiffOn(state)
+	require.NoError(err)
+
+	changes := d.GetStakerChanges()
+	require.Empty(changes.AddedCurrent)
+	require.Empty(changes.DeletedCurrent)
+	require.Empty(changes.AddedPending)
+	require.Empty(changes.DeletedPending)
+	require.Empty(changes.RewardUTXOs)
+
+	var (
+		subnetID         = ids.GenerateTestID()
+		startTime        = time.Unix(1_000, 0)
+		endTime          = time.Unix(2_000, 0)
+		pendingValidator = &Staker{
+			TxID:      ids.GenerateTestID(),
+			SubnetID:  subnetID,
+			NodeID:    ids.GenerateTestNodeID(),
+			StartTime: startTime,
+			EndTime:   endTime,
+			NextTime:  startTime,
+		}
+		currentValidator = &Staker{
+			TxID:      pendingValidator.TxID,
+			SubnetID:  subnetID,
+			NodeID:    pendingValidator.NodeID,
+			StartTime: startTime,
+			EndTime:   endTime,
+			NextTime:  endTime,
+		}
+		currentDelegator = &Staker{
+			TxID:      ids.GenerateTestID(),
+			SubnetID:  subnetID,
+			NodeID:    pendingValidator.NodeID,
+			StartTime: startTime,
+			EndTime:   startTime.Add(time.Second),
+			NextTime:  startTime.Add(time.Second),
+		}
+		removedValidator = &Staker{
+			TxID:     ids.GenerateTestID(),
+			SubnetID: subnetID,
+			NodeID:   ids.GenerateTestNodeID(),
+			NextTime: startTime,
+		}
+		rewardUTXO = &avax.UTXO{
+			UTXOID: avax.UTXOID{TxID: removedValidator.TxID},
+		}
+	)
+
+	// Move the validator from the pending to the current staker set
+	d.DeletePendingValidator(pendingValidator)
+	require.NoError(d.PutCurrentValidator(currentValidator))
+	d.PutCurrentDelegator(currentDelegator)
+	d.DeleteCurrentValidator(removedValidator)
+	d.AddRewardUTXO(removedValidator.TxID, rewardUTXO)
+
+	changes = d.GetStakerChanges()
+	require.Equal([]*Staker{currentDelegator, currentValidator}, changes.AddedCurrent)
+	require.Equal([]*Staker{removedValidator}, changes.DeletedCurrent)
+	require.Empty(changes.AddedPending)
+	require.Equal([]*Staker{pendingValidator}, changes.DeletedPending)
+	require.Equal(map[ids.ID][]*avax.UTXO{
+		removedValidator.TxID: {rewardUTXO},
+	}, changes.RewardUTXOs)
+}
+
 func TestDiffSubnet(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
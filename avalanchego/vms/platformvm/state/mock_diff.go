@@ -412,6 +412,20 @@ func (mr *MockDiffMockRecorder) GetPendingValidator(subnetID, nodeID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingValidator", reflect.TypeOf((*MockDiff)(nil).GetPendingValidator), subnetID, nodeID)
 }
 
+// GetStakerChanges mocks base method.
+func (m *MockDiff) GetStakerChanges() *StakerChanges {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakerChanges")
+	ret0, _ := ret[0].(*StakerChanges)
+	return ret0
+}
+
+// GetStakerChanges indicates an expected call of GetStakerChanges.
+func (mr *MockDiffMockRecorder) GetStakerChanges() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakerChanges", reflect.TypeOf((*MockDiff)(nil).GetStakerChanges))
+}
+
 // GetSubnetOwner mocks base method.
 func (m *MockDiff) GetSubnetOwner(subnetID ids.ID) (fx.Owner, error) {
 	m.ctrl.T.Helper()
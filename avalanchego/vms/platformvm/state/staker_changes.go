@@ -0,0 +1,56 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"slices"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/iterator"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+)
+
+// StakerChanges are the changes made by a diff to the staker sets and to the
+// reward UTXOs of its parent state.
+type StakerChanges struct {
+	// Stakers added to and removed from the current staker set
+	AddedCurrent   []*Staker
+	DeletedCurrent []*Staker
+	// Stakers added to and removed from the pending staker set
+	AddedPending   []*Staker
+	DeletedPending []*Staker
+	// Staker tx ID --> reward UTXOs created for the staker
+	RewardUTXOs map[ids.ID][]*avax.UTXO
+}
+
+func (d *diff) GetStakerChanges() *StakerChanges {
+	changes := &StakerChanges{
+		RewardUTXOs: d.addedRewardUTXOs,
+	}
+	changes.AddedCurrent, changes.DeletedCurrent = d.currentStakerDiffs.changes()
+	changes.AddedPending, changes.DeletedPending = d.pendingStakerDiffs.changes()
+	return changes
+}
+
+// changes returns the added and the deleted stakers, both sorted by
+// *Staker.Less.
+func (s *diffStakers) changes() ([]*Staker, []*Staker) {
+	added := iterator.ToSlice(iterator.FromTree(s.addedStakers))
+
+	deleted := make([]*Staker, 0, len(s.deletedStakers))
+	for _, staker := range s.deletedStakers {
+		deleted = append(deleted, staker)
+	}
+	slices.SortFunc(deleted, func(a, b *Staker) int {
+		switch {
+		case a.Less(b):
+			return -1
+		case b.Less(a):
+			return 1
+		default:
+			return 0
+		}
+	})
+	return added, deleted
+}
@@ -3,41 +3,28 @@ package executor
 import (
 	"time"
 
-	"github.com/ava-labs/avalanchego/utils"
-	"github.com/ava-labs/avalanchego/utils/constants"
-	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/inflation"
 )
 
-var inflationSettingsVariants = utils.NewNetworkValue(getDefaultInflationSettings).
-	AddValue(constants.FlareID, getFlareInflationSettings).
-	AddValue(constants.CostwoID, getCostwoInflationSettings).
-	AddValue(constants.LocalFlareID, getLocalFlareInflationSettings).
-	AddValue(constants.SongbirdID, getSongbirdInflationSettings).
-	AddValue(constants.CostonID, getCostonInflationSettings).
-	AddValue(constants.LocalID, getLocalInflationSettings)
-
-type InflationSettings struct {
-	MinValidatorStake        uint64
-	MaxValidatorStake        uint64
-	MinDelegatorStake        uint64
-	MinDelegationFee         uint32
-	MinStakeDuration         time.Duration
-	MinDelegateDuration      time.Duration
-	MaxStakeDuration         time.Duration
-	MinFutureStartTimeOffset time.Duration // Will not be checked when addPermissionlessValidator tx is used
-	MaxValidatorWeightFactor uint64
-	MinStakeStartTime        time.Time
-}
-
-// The value of currentTimestamp is used to return new inflation settings over time
-func GetCurrentInflationSettings(currentTimestamp time.Time, networkID uint32, config *config.Internal) (uint64, uint64, uint64, uint32, time.Duration, time.Duration, time.Duration, time.Duration, uint64, time.Time) {
-	s := inflationSettingsVariants.GetValue(networkID)(currentTimestamp, config)
-	return s.MinValidatorStake, s.MaxValidatorStake, s.MinDelegatorStake, s.MinDelegationFee, s.MinStakeDuration, s.MinDelegateDuration, s.MaxStakeDuration, s.MinFutureStartTimeOffset, s.MaxValidatorWeightFactor, s.MinStakeStartTime
+// GetCurrentInflationSettings returns the staking parameters of the primary
+// network in effect at [currentTimestamp]. The phases of the network are taken
+// from [config.InflationPhases] if set, or from the embedded inflation schedule.
+// Networks without phases, or timestamps before the first phase, use the
+// parameters of the node config.
+func GetCurrentInflationSettings(currentTimestamp time.Time, networkID uint32, config *config.Internal) inflation.Settings {
+	phases := config.InflationPhases
+	if len(phases) == 0 {
+		phases = inflation.DefaultSchedule.Phases(networkID)
+	}
+	if s, ok := inflation.SettingsAt(phases, currentTimestamp); ok {
+		return s
+	}
+	return getDefaultInflationSettings(config)
 }
 
 func getCurrentValidatorRules(currentTimestamp time.Time, backend *Backend) *addValidatorRules {
-	s := inflationSettingsVariants.GetValue(backend.Ctx.NetworkID)(currentTimestamp, backend.Config)
+	s := GetCurrentInflationSettings(currentTimestamp, backend.Ctx.NetworkID, backend.Config)
 	return &addValidatorRules{
 		assetID:                  backend.Ctx.AVAXAssetID,
 		minValidatorStake:        s.MinValidatorStake,
@@ -51,7 +38,7 @@ func getCurrentValidatorRules(currentTimestamp time.Time, backend *Backend) *add
 }
 
 func getCurrentDelegatorRules(currentTimestamp time.Time, backend *Backend) *addDelegatorRules {
-	s := inflationSettingsVariants.GetValue(backend.Ctx.NetworkID)(currentTimestamp, backend.Config)
+	s := GetCurrentInflationSettings(currentTimestamp, backend.Ctx.NetworkID, backend.Config)
 	return &addDelegatorRules{
 		assetID:                  backend.Ctx.AVAXAssetID,
 		minDelegatorStake:        s.MinDelegatorStake,
@@ -63,168 +50,8 @@ func getCurrentDelegatorRules(currentTimestamp time.Time, backend *Backend) *add
 	}
 }
 
-func getFlareInflationSettings(currentTimestamp time.Time, _ *config.Internal) InflationSettings {
-	switch {
-	case currentTimestamp.Before(time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC)):
-		// Phase 1
-		return InflationSettings{
-			MinValidatorStake:        10 * units.MegaAvax,
-			MaxValidatorStake:        50 * units.MegaAvax,
-			MinDelegatorStake:        1 * units.KiloAvax,
-			MinDelegationFee:         0,
-			MinStakeDuration:         2 * 7 * 24 * time.Hour,
-			MinDelegateDuration:      2 * 7 * 24 * time.Hour,
-			MaxStakeDuration:         365 * 24 * time.Hour,
-			MinFutureStartTimeOffset: 3 * 24 * time.Hour,
-			MaxValidatorWeightFactor: MaxValidatorWeightFactor,
-			MinStakeStartTime:        time.Date(2023, time.July, 5, 15, 0, 0, 0, time.UTC),
-		}
-	default:
-		// Phase 2
-		return InflationSettings{
-			MinValidatorStake:        1 * units.MegaAvax,
-			MaxValidatorStake:        200 * units.MegaAvax,
-			MinDelegatorStake:        50 * units.KiloAvax,
-			MinDelegationFee:         0,
-			MinStakeDuration:         60 * 24 * time.Hour,
-			MinDelegateDuration:      2 * 7 * 24 * time.Hour,
-			MaxStakeDuration:         365 * 24 * time.Hour,
-			MinFutureStartTimeOffset: MaxFutureStartTime,
-			MaxValidatorWeightFactor: 15,
-			MinStakeStartTime:        time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC),
-		}
-	}
-}
-
-func getCostwoInflationSettings(currentTimestamp time.Time, _ *config.Internal) InflationSettings {
-	switch {
-	case currentTimestamp.Before(time.Date(2023, time.September, 7, 0, 0, 0, 0, time.UTC)):
-		// Phase 1
-		return InflationSettings{
-			MinValidatorStake:        100 * units.KiloAvax,
-			MaxValidatorStake:        50 * units.MegaAvax,
-			MinDelegatorStake:        1 * units.KiloAvax,
-			MinDelegationFee:         0,
-			MinStakeDuration:         2 * 7 * 24 * time.Hour,
-			MinDelegateDuration:      2 * 7 * 24 * time.Hour,
-			MaxStakeDuration:         365 * 24 * time.Hour,
-			MinFutureStartTimeOffset: MaxFutureStartTime,
-			MaxValidatorWeightFactor: MaxValidatorWeightFactor,
-			MinStakeStartTime:        time.Date(2023, time.May, 25, 15, 0, 0, 0, time.UTC),
-		}
-	default:
-		// Phase 2
-		return InflationSettings{
-			MinValidatorStake:        1 * units.MegaAvax,
-			MaxValidatorStake:        200 * units.MegaAvax,
-			MinDelegatorStake:        50 * units.KiloAvax,
-			MinDelegationFee:         0,
-			MinStakeDuration:         60 * 24 * time.Hour,
-			MinDelegateDuration:      2 * 7 * 24 * time.Hour,
-			MaxStakeDuration:         365 * 24 * time.Hour,
-			MinFutureStartTimeOffset: MaxFutureStartTime,
-			MaxValidatorWeightFactor: 15,
-			MinStakeStartTime:        time.Date(2023, time.September, 7, 0, 0, 0, 0, time.UTC),
-		}
-	}
-}
-
-func getLocalFlareInflationSettings(currentTimestamp time.Time, _ *config.Internal) InflationSettings {
-	switch {
-	case currentTimestamp.Before(time.Date(2023, time.August, 1, 0, 0, 0, 0, time.UTC)):
-		// Phase 1
-		return InflationSettings{
-			MinValidatorStake:        10 * units.KiloAvax,
-			MaxValidatorStake:        50 * units.MegaAvax,
-			MinDelegatorStake:        10 * units.KiloAvax,
-			MinDelegationFee:         0,
-			MinStakeDuration:         2 * 7 * 24 * time.Hour,
-			MinDelegateDuration:      1 * time.Hour,
-			MaxStakeDuration:         365 * 24 * time.Hour,
-			MinFutureStartTimeOffset: MaxFutureStartTime,
-			MaxValidatorWeightFactor: MaxValidatorWeightFactor,
-			MinStakeStartTime:        time.Date(2023, time.April, 10, 15, 0, 0, 0, time.UTC),
-		}
-	default:
-		// Phase 2
-		return InflationSettings{
-			MinValidatorStake:        10 * units.KiloAvax,
-			MaxValidatorStake:        9_000 * units.MegaAvax,
-			MinDelegatorStake:        10 * units.KiloAvax,
-			MinDelegationFee:         0,
-			MinStakeDuration:         1 * time.Hour,
-			MinDelegateDuration:      30 * time.Minute,
-			MaxStakeDuration:         365 * 24 * time.Hour,
-			MinFutureStartTimeOffset: MaxFutureStartTime,
-			MaxValidatorWeightFactor: MaxValidatorWeightFactor,
-			MinStakeStartTime:        time.Date(2023, time.April, 10, 15, 0, 0, 0, time.UTC),
-		}
-	}
-}
-
-func getSongbirdInflationSettings(currentTimestamp time.Time, config *config.Internal) InflationSettings {
-	switch {
-	case currentTimestamp.Before(time.Date(2000, time.March, 1, 0, 0, 0, 0, time.UTC)):
-		return getDefaultInflationSettings(currentTimestamp, config)
-	default:
-		// Phase 2
-		return InflationSettings{
-			MinValidatorStake:        1 * units.MegaAvax,
-			MaxValidatorStake:        200 * units.MegaAvax,
-			MinDelegatorStake:        50 * units.KiloAvax,
-			MinDelegationFee:         0,
-			MinStakeDuration:         60 * 24 * time.Hour,
-			MinDelegateDuration:      2 * 7 * 24 * time.Hour,
-			MaxStakeDuration:         365 * 24 * time.Hour,
-			MinFutureStartTimeOffset: MaxFutureStartTime,
-			MaxValidatorWeightFactor: 15,
-			MinStakeStartTime:        time.Date(2024, time.November, 19, 12, 0, 0, 0, time.UTC),
-		}
-	}
-}
-
-func getCostonInflationSettings(currentTimestamp time.Time, config *config.Internal) InflationSettings {
-	switch {
-	case currentTimestamp.Before(time.Date(2000, time.March, 1, 0, 0, 0, 0, time.UTC)):
-		return getDefaultInflationSettings(currentTimestamp, config)
-	default:
-		return InflationSettings{
-			MinValidatorStake:        100 * units.KiloAvax,
-			MaxValidatorStake:        1000 * units.MegaAvax,
-			MinDelegatorStake:        10 * units.KiloAvax,
-			MinDelegationFee:         0,
-			MinStakeDuration:         24 * time.Hour,
-			MinDelegateDuration:      1 * time.Hour,
-			MaxStakeDuration:         365 * 24 * time.Hour,
-			MinFutureStartTimeOffset: MaxFutureStartTime,
-			MaxValidatorWeightFactor: 15,
-			MinStakeStartTime:        time.Date(2024, time.July, 30, 12, 0, 0, 0, time.UTC),
-		}
-	}
-}
-
-func getLocalInflationSettings(currentTimestamp time.Time, config *config.Internal) InflationSettings {
-	switch {
-	case currentTimestamp.Before(time.Date(2000, time.March, 1, 0, 0, 0, 0, time.UTC)):
-		return getDefaultInflationSettings(currentTimestamp, config)
-	default:
-		return InflationSettings{
-			MinValidatorStake:        10 * units.KiloAvax,
-			MaxValidatorStake:        50 * units.MegaAvax,
-			MinDelegatorStake:        10 * units.KiloAvax,
-			MinDelegationFee:         0,
-			MinStakeDuration:         2 * time.Hour,
-			MinDelegateDuration:      20 * time.Minute,
-			MaxStakeDuration:         365 * 24 * time.Hour,
-			MinFutureStartTimeOffset: MaxFutureStartTime,
-			MaxValidatorWeightFactor: 15,
-			MinStakeStartTime:        time.Date(2024, time.April, 22, 15, 0, 0, 0, time.UTC),
-		}
-	}
-}
-
-func getDefaultInflationSettings(_ time.Time, config *config.Internal) InflationSettings {
-	return InflationSettings{
+func getDefaultInflationSettings(config *config.Internal) inflation.Settings {
+	return inflation.Settings{
 		MinValidatorStake:        config.MinValidatorStake,
 		MaxValidatorStake:        config.MaxValidatorStake,
 		MinDelegatorStake:        config.MinDelegatorStake,
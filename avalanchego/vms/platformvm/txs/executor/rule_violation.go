@@ -0,0 +1,69 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrStartTimeTooEarly = errors.New("staker starts too early")
+
+// RuleViolation is a staking rule that a tx doesn't follow. It wraps the error
+// returned by the verification of the tx, so it can be matched with
+// errors.Is.
+type RuleViolation struct {
+	Err error
+	// Rule is the name of the staking parameter that is violated
+	Rule string
+	// Value is the offending value of the tx and Required is the bound set by
+	// the rule
+	Value    string
+	Required string
+}
+
+func newRuleViolation(err error, rule string, value, required any) *RuleViolation {
+	return &RuleViolation{
+		Err:      err,
+		Rule:     rule,
+		Value:    formatRuleValue(value),
+		Required: formatRuleValue(required),
+	}
+}
+
+func (v *RuleViolation) Error() string {
+	return fmt.Sprintf("%s: %s but %s is %s", v.Err, v.Value, v.Rule, v.Required)
+}
+
+func (v *RuleViolation) Unwrap() error {
+	return v.Err
+}
+
+// RuleViolations returns the rule violations wrapped by [err], in the order
+// they were found.
+func RuleViolations(err error) []*RuleViolation {
+	var violations []*RuleViolation
+	switch err := err.(type) {
+	case nil:
+	case *RuleViolation:
+		violations = append(violations, err)
+	case interface{ Unwrap() []error }:
+		for _, err := range err.Unwrap() {
+			violations = append(violations, RuleViolations(err)...)
+		}
+	case interface{ Unwrap() error }:
+		violations = RuleViolations(err.Unwrap())
+	}
+	return violations
+}
+
+func formatRuleValue(value any) string {
+	switch value := value.(type) {
+	case time.Time:
+		return value.UTC().Format(time.RFC3339)
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,37 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleViolations(t *testing.T) {
+	require := require.New(t)
+
+	tooShort := newRuleViolation(ErrStakeTooShort, "minStakeDuration", time.Hour, 14*24*time.Hour)
+	tooEarly := newRuleViolation(
+		ErrStartTimeTooEarly,
+		"minStakeStartTime",
+		time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC),
+	)
+	require.Equal("1h0m0s", tooShort.Value)
+	require.Equal("336h0m0s", tooShort.Required)
+	require.Equal("2023-01-01T00:00:00Z", tooEarly.Value)
+	require.Equal("staking period is too short: 1h0m0s but minStakeDuration is 336h0m0s", tooShort.Error())
+
+	err := fmt.Errorf("failed execution: %w", errors.Join(tooShort, tooEarly))
+	require.ErrorIs(err, ErrStakeTooShort)
+	require.ErrorIs(err, ErrStartTimeTooEarly)
+	require.Equal([]*RuleViolation{tooShort, tooEarly}, RuleViolations(err))
+
+	require.Empty(RuleViolations(nil))
+	require.Empty(RuleViolations(fmt.Errorf("failed execution: %w", ErrFlowCheckFailed)))
+}
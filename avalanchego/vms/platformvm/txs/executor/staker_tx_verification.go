@@ -112,30 +112,44 @@ func verifyAddValidatorTx(
 		return nil, err
 	}
 
-	minValidatorStake, maxValidatorStake, _, minDelegationFee, minStakeDuration, _, maxStakeDuration, minFutureStartTimeOffset, _, minStakeStartTime := GetCurrentInflationSettings(currentTimestamp, backend.Ctx.NetworkID, backend.Config)
+	inflationSettings := GetCurrentInflationSettings(currentTimestamp, backend.Ctx.NetworkID, backend.Config)
 
 	startTime := tx.StartTime()
 	duration := tx.EndTime().Sub(startTime)
-	switch {
-	case tx.Validator.Wght < minValidatorStake:
+	var violations []error
+	if tx.Validator.Wght < inflationSettings.MinValidatorStake {
 		// Ensure validator is staking at least the minimum amount
-		return nil, ErrWeightTooSmall
-
-	case tx.Validator.Wght > maxValidatorStake:
+		violations = append(violations, newRuleViolation(ErrWeightTooSmall, "minValidatorStake", tx.Validator.Wght, inflationSettings.MinValidatorStake))
+	}
+	if tx.Validator.Wght > inflationSettings.MaxValidatorStake {
 		// Ensure validator isn't staking too much
-		return nil, ErrWeightTooLarge
-
-	case tx.DelegationShares < minDelegationFee:
+		violations = append(violations, newRuleViolation(ErrWeightTooLarge, "maxValidatorStake", tx.Validator.Wght, inflationSettings.MaxValidatorStake))
+	}
+	if tx.DelegationShares < inflationSettings.MinDelegationFee {
 		// Ensure the validator fee is at least the minimum amount
-		return nil, ErrInsufficientDelegationFee
-
-	case duration < minStakeDuration:
+		violations = append(violations, newRuleViolation(ErrInsufficientDelegationFee, "minDelegationFee", tx.DelegationShares, inflationSettings.MinDelegationFee))
+	}
+	if duration < inflationSettings.MinStakeDuration {
 		// Ensure staking length is not too short
-		return nil, ErrStakeTooShort
-
-	case duration > maxStakeDuration:
+		violations = append(violations, newRuleViolation(ErrStakeTooShort, "minStakeDuration", duration, inflationSettings.MinStakeDuration))
+	}
+	if duration > inflationSettings.MaxStakeDuration {
 		// Ensure staking length is not too long
-		return nil, ErrStakeTooLong
+		violations = append(violations, newRuleViolation(ErrStakeTooLong, "maxStakeDuration", duration, inflationSettings.MaxStakeDuration))
+	}
+	if backend.Bootstrapped.Get() {
+		if err := verifyStakerStartTime(false /*=isDurangoActive*/, currentTimestamp, startTime); err != nil {
+			violations = append(violations, err)
+		}
+		if !inflationSettings.MinStakeStartTime.Before(startTime) {
+			violations = append(violations, newRuleViolation(ErrStartTimeTooEarly, "minStakeStartTime", startTime, inflationSettings.MinStakeStartTime))
+		}
+		if err := verifyMinFutureStartTimeOffset(currentTimestamp, startTime, inflationSettings.MinFutureStartTimeOffset); err != nil {
+			violations = append(violations, err)
+		}
+	}
+	if len(violations) != 0 {
+		return nil, errors.Join(violations...)
 	}
 
 	outs := make([]*avax.TransferableOutput, len(tx.Outs)+len(tx.StakeOuts))
@@ -146,18 +160,6 @@ func verifyAddValidatorTx(
 		return outs, nil
 	}
 
-	if err := verifyStakerStartTime(false /*=isDurangoActive*/, currentTimestamp, startTime); err != nil {
-		return nil, err
-	}
-
-	if !minStakeStartTime.Before(startTime) {
-		return nil, fmt.Errorf(
-			"validator's start time (%s) at or before minStakeStartTime (%s)",
-			startTime,
-			minStakeStartTime,
-		)
-	}
-
 	_, err := GetValidator(chainState, constants.PrimaryNetworkID, tx.Validator.NodeID)
 	if err == nil {
 		return nil, fmt.Errorf(
@@ -192,11 +194,6 @@ func verifyAddValidatorTx(
 		return nil, fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
 	}
 
-	err = verifyMinFutureStartTimeOffset(currentTimestamp, startTime, minFutureStartTimeOffset)
-	if err != nil {
-		return nil, err
-	}
-
 	return outs, nil
 }
 
@@ -410,19 +407,19 @@ func verifyAddDelegatorTx(
 		startTime = tx.StartTime()
 		duration  = endTime.Sub(startTime)
 	)
-	_, maxValidatorStake, minDelegatorStake, _, _, minStakeDuration, maxStakeDuration, minFutureStartTimeOffset, maxValidatorWeightFactor, _ := GetCurrentInflationSettings(currentTimestamp, backend.Ctx.NetworkID, backend.Config)
-	switch {
-	case duration < minStakeDuration:
+	inflationSettings := GetCurrentInflationSettings(currentTimestamp, backend.Ctx.NetworkID, backend.Config)
+	var violations []error
+	if duration < inflationSettings.MinDelegateDuration {
 		// Ensure staking length is not too short
-		return nil, ErrStakeTooShort
-
-	case duration > maxStakeDuration:
+		violations = append(violations, newRuleViolation(ErrStakeTooShort, "minDelegateDuration", duration, inflationSettings.MinDelegateDuration))
+	}
+	if duration > inflationSettings.MaxStakeDuration {
 		// Ensure staking length is not too long
-		return nil, ErrStakeTooLong
-
-	case tx.Validator.Wght < minDelegatorStake:
+		violations = append(violations, newRuleViolation(ErrStakeTooLong, "maxStakeDuration", duration, inflationSettings.MaxStakeDuration))
+	}
+	if tx.Validator.Wght < inflationSettings.MinDelegatorStake {
 		// Ensure validator is staking at least the minimum amount
-		return nil, ErrWeightTooSmall
+		violations = append(violations, newRuleViolation(ErrWeightTooSmall, "minDelegatorStake", tx.Validator.Wght, inflationSettings.MinDelegatorStake))
 	}
 
 	outs := make([]*avax.TransferableOutput, len(tx.Outs)+len(tx.StakeOuts))
@@ -430,29 +427,38 @@ func verifyAddDelegatorTx(
 	copy(outs[len(tx.Outs):], tx.StakeOuts)
 
 	if !backend.Bootstrapped.Get() {
+		if len(violations) != 0 {
+			return nil, errors.Join(violations...)
+		}
 		return outs, nil
 	}
 
+	if err := verifyMinFutureStartTimeOffset(currentTimestamp, startTime, inflationSettings.MinFutureStartTimeOffset); err != nil {
+		violations = append(violations, err)
+	}
+
+	// The rules violated so far are reported with the errors that prevent
+	// checking the other rules.
 	if err := verifyStakerStartTime(false /*=isDurangoActive*/, currentTimestamp, startTime); err != nil {
-		return nil, err
+		return nil, errors.Join(append(violations, err)...)
 	}
 
 	primaryNetworkValidator, err := GetValidator(chainState, constants.PrimaryNetworkID, tx.Validator.NodeID)
 	if err != nil {
-		return nil, fmt.Errorf(
+		return nil, errors.Join(append(violations, fmt.Errorf(
 			"failed to fetch the primary network validator for %s: %w",
 			tx.Validator.NodeID,
 			err,
-		)
+		))...)
 	}
 
-	maximumWeight, err := safemath.Mul(maxValidatorWeightFactor, primaryNetworkValidator.Weight)
+	maximumWeight, err := safemath.Mul(inflationSettings.MaxValidatorWeightFactor, primaryNetworkValidator.Weight)
 	if err != nil {
 		return nil, ErrStakeOverflow
 	}
 
 	if backend.Config.UpgradeConfig.IsApricotPhase3Activated(currentTimestamp) {
-		maximumWeight = min(maximumWeight, maxValidatorStake)
+		maximumWeight = min(maximumWeight, inflationSettings.MaxValidatorStake)
 	}
 
 	if !txs.BoundedBy(
@@ -461,9 +467,9 @@ func verifyAddDelegatorTx(
 		primaryNetworkValidator.StartTime,
 		primaryNetworkValidator.EndTime,
 	) {
-		return nil, ErrPeriodMismatch
+		violations = append(violations, newPeriodMismatch(startTime, endTime, primaryNetworkValidator))
 	}
-	overDelegated, err := overDelegated(
+	newMaxWeight, overDelegated, err := overDelegated(
 		chainState,
 		primaryNetworkValidator,
 		maximumWeight,
@@ -475,7 +481,10 @@ func verifyAddDelegatorTx(
 		return nil, err
 	}
 	if overDelegated {
-		return nil, ErrOverDelegated
+		violations = append(violations, newRuleViolation(ErrOverDelegated, "maxValidatorWeight", newMaxWeight, maximumWeight))
+	}
+	if len(violations) != 0 {
+		return nil, errors.Join(violations...)
 	}
 
 	// Verify the flowcheck
@@ -496,11 +505,6 @@ func verifyAddDelegatorTx(
 		return nil, fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
 	}
 
-	err = verifyMinFutureStartTimeOffset(currentTimestamp, startTime, minFutureStartTimeOffset)
-	if err != nil {
-		return nil, err
-	}
-
 	return outs, nil
 }
 
@@ -533,12 +537,12 @@ func verifyAddPermissionlessValidatorTx(
 	if constants.IsFlareNetworkID(backend.Ctx.NetworkID) || constants.IsSgbNetworkID(backend.Ctx.NetworkID) {
 		// Flare does not allow permissionless validator tx before Cortina
 		if currentTimestamp.Before(backend.Config.UpgradeConfig.CortinaTime) {
-			return ErrWrongTxType
+			return newRuleViolation(ErrWrongTxType, "cortinaTime", currentTimestamp, backend.Config.UpgradeConfig.CortinaTime)
 		}
 
 		// Flare does not allow creation of subnets before Durango
 		if !isDurangoActive && tx.Subnet != constants.PrimaryNetworkID {
-			return ErrWrongTxType
+			return newRuleViolation(ErrWrongTxType, "subnetID", tx.Subnet, constants.PrimaryNetworkID)
 		}
 	}
 
@@ -558,35 +562,33 @@ func verifyAddPermissionlessValidatorTx(
 	}
 
 	stakedAssetID := tx.StakeOuts[0].AssetID()
-	switch {
-	case tx.Validator.Wght < validatorRules.minValidatorStake:
+	var violations []error
+	if tx.Validator.Wght < validatorRules.minValidatorStake {
 		// Ensure validator is staking at least the minimum amount
-		return ErrWeightTooSmall
-
-	case tx.Validator.Wght > validatorRules.maxValidatorStake:
+		violations = append(violations, newRuleViolation(ErrWeightTooSmall, "minValidatorStake", tx.Validator.Wght, validatorRules.minValidatorStake))
+	}
+	if tx.Validator.Wght > validatorRules.maxValidatorStake {
 		// Ensure validator isn't staking too much
-		return ErrWeightTooLarge
-
-	case tx.DelegationShares < validatorRules.minDelegationFee:
+		violations = append(violations, newRuleViolation(ErrWeightTooLarge, "maxValidatorStake", tx.Validator.Wght, validatorRules.maxValidatorStake))
+	}
+	if tx.DelegationShares < validatorRules.minDelegationFee {
 		// Ensure the validator fee is at least the minimum amount
-		return ErrInsufficientDelegationFee
-
-	case duration < validatorRules.minStakeDuration:
+		violations = append(violations, newRuleViolation(ErrInsufficientDelegationFee, "minDelegationFee", tx.DelegationShares, validatorRules.minDelegationFee))
+	}
+	if duration < validatorRules.minStakeDuration {
 		// Ensure staking length is not too short
-		return ErrStakeTooShort
-
-	case duration > validatorRules.maxStakeDuration:
+		violations = append(violations, newRuleViolation(ErrStakeTooShort, "minStakeDuration", duration, validatorRules.minStakeDuration))
+	}
+	if duration > validatorRules.maxStakeDuration {
 		// Ensure staking length is not too long
-		return ErrStakeTooLong
-
-	case stakedAssetID != validatorRules.assetID:
+		violations = append(violations, newRuleViolation(ErrStakeTooLong, "maxStakeDuration", duration, validatorRules.maxStakeDuration))
+	}
+	if stakedAssetID != validatorRules.assetID {
 		// Wrong assetID used
-		return fmt.Errorf(
-			"%w: %s != %s",
-			ErrWrongStakedAssetID,
-			validatorRules.assetID,
-			stakedAssetID,
-		)
+		violations = append(violations, newRuleViolation(ErrWrongStakedAssetID, "assetID", stakedAssetID, validatorRules.assetID))
+	}
+	if len(violations) != 0 {
+		return errors.Join(violations...)
 	}
 
 	_, err = GetValidator(chainState, tx.Subnet, tx.Validator.NodeID)
@@ -623,7 +625,7 @@ func verifyAddPermissionlessValidatorTx(
 		return err
 	}
 	if err := backend.FlowChecker.VerifySpend(
-		tx,
+		spentTx(backend, currentTimestamp, tx),
 		chainState,
 		tx.Ins,
 		outs,
@@ -666,7 +668,7 @@ func verifyAddPermissionlessDelegatorTx(
 
 	// Flare does not allow permissionless delegator tx before Cortina
 	if currentTimestamp.Before(backend.Config.UpgradeConfig.CortinaTime) && (constants.IsFlareNetworkID(backend.Ctx.NetworkID) || constants.IsSgbNetworkID(backend.Ctx.NetworkID)) {
-		return ErrWrongTxType
+		return newRuleViolation(ErrWrongTxType, "cortinaTime", currentTimestamp, backend.Config.UpgradeConfig.CortinaTime)
 	}
 
 	var (
@@ -688,47 +690,41 @@ func verifyAddPermissionlessDelegatorTx(
 	}
 
 	stakedAssetID := tx.StakeOuts[0].AssetID()
-	switch {
-	case tx.Validator.Wght < delegatorRules.minDelegatorStake:
+	var violations []error
+	if tx.Validator.Wght < delegatorRules.minDelegatorStake {
 		// Ensure delegator is staking at least the minimum amount
-		return ErrWeightTooSmall
-
-	case duration < delegatorRules.minStakeDuration:
+		violations = append(violations, newRuleViolation(ErrWeightTooSmall, "minDelegatorStake", tx.Validator.Wght, delegatorRules.minDelegatorStake))
+	}
+	if duration < delegatorRules.minStakeDuration {
 		// Ensure staking length is not too short
-		return ErrStakeTooShort
-
-	case duration > delegatorRules.maxStakeDuration:
+		violations = append(violations, newRuleViolation(ErrStakeTooShort, "minStakeDuration", duration, delegatorRules.minStakeDuration))
+	}
+	if duration > delegatorRules.maxStakeDuration {
 		// Ensure staking length is not too long
-		return ErrStakeTooLong
-
-	case stakedAssetID != delegatorRules.assetID:
+		violations = append(violations, newRuleViolation(ErrStakeTooLong, "maxStakeDuration", duration, delegatorRules.maxStakeDuration))
+	}
+	if stakedAssetID != delegatorRules.assetID {
 		// Wrong assetID used
-		return fmt.Errorf(
-			"%w: %s != %s",
-			ErrWrongStakedAssetID,
-			delegatorRules.assetID,
-			stakedAssetID,
-		)
+		violations = append(violations, newRuleViolation(ErrWrongStakedAssetID, "assetID", stakedAssetID, delegatorRules.assetID))
 	}
 
+	// The rules violated so far are reported with the errors that prevent
+	// checking the other rules.
 	validator, err := GetValidator(chainState, tx.Subnet, tx.Validator.NodeID)
 	if err != nil {
-		return fmt.Errorf(
+		return errors.Join(append(violations, fmt.Errorf(
 			"failed to fetch the validator for %s on %s: %w",
 			tx.Validator.NodeID,
 			tx.Subnet,
 			err,
-		)
+		))...)
 	}
 
-	maximumWeight, err := safemath.Mul(
+	maximumWeight, _ := maxValidatorWeight(
 		uint64(delegatorRules.maxValidatorWeightFactor),
+		delegatorRules.maxValidatorStake,
 		validator.Weight,
 	)
-	if err != nil {
-		maximumWeight = math.MaxUint64
-	}
-	maximumWeight = min(maximumWeight, delegatorRules.maxValidatorStake)
 
 	if !txs.BoundedBy(
 		startTime,
@@ -736,9 +732,9 @@ func verifyAddPermissionlessDelegatorTx(
 		validator.StartTime,
 		validator.EndTime,
 	) {
-		return ErrPeriodMismatch
+		violations = append(violations, newPeriodMismatch(startTime, endTime, validator))
 	}
-	overDelegated, err := overDelegated(
+	newMaxWeight, overDelegated, err := overDelegated(
 		chainState,
 		validator,
 		maximumWeight,
@@ -750,7 +746,10 @@ func verifyAddPermissionlessDelegatorTx(
 		return err
 	}
 	if overDelegated {
-		return ErrOverDelegated
+		violations = append(violations, newRuleViolation(ErrOverDelegated, "maxValidatorWeight", newMaxWeight, maximumWeight))
+	}
+	if len(violations) != 0 {
+		return errors.Join(violations...)
 	}
 
 	outs := make([]*avax.TransferableOutput, len(tx.Outs)+len(tx.StakeOuts))
@@ -775,7 +774,7 @@ func verifyAddPermissionlessDelegatorTx(
 		return err
 	}
 	if err := backend.FlowChecker.VerifySpend(
-		tx,
+		spentTx(backend, currentTimestamp, tx),
 		chainState,
 		tx.Ins,
 		outs,
@@ -874,11 +873,32 @@ func verifyMinFutureStartTimeOffset(chainTime, stakerStartTime time.Time, minFut
 	maxStartTime := chainTime.Add(MaxFutureStartTime)
 	minStartTime := maxStartTime.Add(-minFutureStartTimeOffset)
 	if stakerStartTime.Before(minStartTime) {
-		return fmt.Errorf(
-			"validator's start time (%s) at or before minStartTime (%s)",
-			stakerStartTime,
-			minStartTime,
-		)
+		return newRuleViolation(ErrStartTimeTooEarly, "minFutureStartTimeOffset", stakerStartTime, minStartTime)
 	}
 	return nil
 }
+
+// newPeriodMismatch returns the violation of a delegator whose staking period
+// isn't within the staking period of its [validator].
+func newPeriodMismatch(startTime, endTime time.Time, validator *state.Staker) *RuleViolation {
+	return &RuleViolation{
+		Err:      ErrPeriodMismatch,
+		Rule:     "stakingPeriod",
+		Value:    formatRuleValue(startTime) + " - " + formatRuleValue(endTime),
+		Required: formatRuleValue(validator.StartTime) + " - " + formatRuleValue(validator.EndTime),
+	}
+}
+
+// maxValidatorWeight returns the maximum total weight of a validator with
+// [validatorWeight] and its delegators, and the name of the staking parameter
+// that sets it.
+func maxValidatorWeight(maxValidatorWeightFactor uint64, maxValidatorStake uint64, validatorWeight uint64) (uint64, string) {
+	maximumWeight, err := safemath.Mul(maxValidatorWeightFactor, validatorWeight)
+	if err != nil {
+		maximumWeight = math.MaxUint64
+	}
+	if maximumWeight < maxValidatorStake {
+		return maximumWeight, "maxValidatorWeightFactor"
+	}
+	return maxValidatorStake, "maxValidatorStake"
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/platformvm/inflation"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
@@ -101,6 +102,7 @@ func GetValidator(state state.Chain, subnetID ids.ID, nodeID ids.NodeID) (*state
 }
 
 // overDelegated returns true if [validator] will be overdelegated when adding [delegator].
+// It also returns the maximum total weight on [validator] with [delegator].
 //
 // A [validator] would become overdelegated if:
 // - the maximum total weight on [validator] exceeds [weightLimit]
@@ -111,16 +113,66 @@ func overDelegated(
 	delegatorWeight uint64,
 	delegatorStartTime time.Time,
 	delegatorEndTime time.Time,
-) (bool, error) {
+) (uint64, bool, error) {
 	maxWeight, err := GetMaxWeight(state, validator, delegatorStartTime, delegatorEndTime)
 	if err != nil {
-		return true, err
+		return 0, true, err
 	}
 	newMaxWeight, err := math.Add(maxWeight, delegatorWeight)
 	if err != nil {
-		return true, err
+		return 0, true, err
 	}
-	return newMaxWeight > weightLimit, nil
+	return newMaxWeight, newMaxWeight > weightLimit, nil
+}
+
+// DelegationCapacity is the weight that can be delegated to a primary network
+// validator during a staking period.
+type DelegationCapacity struct {
+	// MaxWeight is the maximum total weight of the validator and its
+	// delegators, set by the staking parameter LimitingRule
+	MaxWeight    uint64
+	LimitingRule string
+	// CurrentMaxWeight is the maximum total weight of the validator and its
+	// current and pending delegators during the period
+	CurrentMaxWeight uint64
+	// Capacity is the maximum weight of an additional delegator
+	Capacity uint64
+}
+
+// GetDelegationCapacity returns the weight that can be delegated to the
+// primary network [validator] from [startTime] to [endTime], as limited by
+// [settings] when a delegator is verified.
+func GetDelegationCapacity(
+	chainState state.Chain,
+	settings inflation.Settings,
+	validator *state.Staker,
+	startTime time.Time,
+	endTime time.Time,
+) (*DelegationCapacity, error) {
+	if !txs.BoundedBy(startTime, endTime, validator.StartTime, validator.EndTime) {
+		return nil, newPeriodMismatch(startTime, endTime, validator)
+	}
+
+	maximumWeight, rule := maxValidatorWeight(
+		settings.MaxValidatorWeightFactor,
+		settings.MaxValidatorStake,
+		validator.Weight,
+	)
+	currentMaxWeight, err := GetMaxWeight(chainState, validator, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var capacity uint64
+	if currentMaxWeight < maximumWeight {
+		capacity = maximumWeight - currentMaxWeight
+	}
+	return &DelegationCapacity{
+		MaxWeight:        maximumWeight,
+		LimitingRule:     rule,
+		CurrentMaxWeight: currentMaxWeight,
+		Capacity:         capacity,
+	}, nil
 }
 
 // GetMaxWeight returns the maximum total weight of the [validator], including
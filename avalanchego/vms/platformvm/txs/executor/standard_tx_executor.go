@@ -346,7 +346,7 @@ func (e *standardTxExecutor) ImportTx(tx *txs.ImportTx) error {
 			return err
 		}
 		if err := e.backend.FlowChecker.VerifySpendUTXOs(
-			tx,
+			spentTx(e.backend, currentTimestamp, tx),
 			utxos,
 			ins,
 			tx.Outs,
@@ -406,7 +406,7 @@ func (e *standardTxExecutor) ExportTx(tx *txs.ExportTx) error {
 		return err
 	}
 	if err := e.backend.FlowChecker.VerifySpend(
-		tx,
+		spentTx(e.backend, currentTimestamp, tx),
 		e.state,
 		tx.Ins,
 		outs,
@@ -657,7 +657,7 @@ func (e *standardTxExecutor) BaseTx(tx *txs.BaseTx) error {
 		return err
 	}
 	if err := e.backend.FlowChecker.VerifySpend(
-		tx,
+		spentTx(e.backend, currentTimestamp, tx),
 		e.state,
 		tx.Ins,
 		tx.Outs,
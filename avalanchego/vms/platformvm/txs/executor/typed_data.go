@@ -0,0 +1,51 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/vms/components/eip712"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var _ secp256k1fx.TypedDataTx = (*typedDataTx)(nil)
+
+// typedDataTx is a tx whose credentials may also sign its EIP-712 typed data.
+type typedDataTx struct {
+	txs.UnsignedTx
+
+	hashed bool
+	hash   []byte
+	err    error
+}
+
+// TypedDataHash returns the hash of the typed data of the tx, which is only
+// computed for the first input that needs it.
+func (tx *typedDataTx) TypedDataHash() ([]byte, error) {
+	if !tx.hashed {
+		tx.hash, tx.err = hashTypedData(tx.UnsignedTx)
+		tx.hashed = true
+	}
+	return tx.hash, tx.err
+}
+
+func hashTypedData(tx txs.UnsignedTx) ([]byte, error) {
+	typedData, err := txs.TypedData(tx)
+	if err != nil {
+		return nil, err
+	}
+	return eip712.Hash(typedData)
+}
+
+// spentTx returns the tx passed to the flow checker to verify the credentials
+// of [tx]. The credentials may sign the typed data of [tx] only once typed data
+// signatures are activated at [chainTime].
+func spentTx(backend *Backend, chainTime time.Time, tx txs.UnsignedTx) txs.UnsignedTx {
+	if !backend.Config.UpgradeConfig.IsTypedDataSignaturesActivated(chainTime) {
+		return tx
+	}
+	return &typedDataTx{UnsignedTx: tx}
+}
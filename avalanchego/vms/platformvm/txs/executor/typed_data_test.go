@@ -0,0 +1,100 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis/genesistest"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestStandardExecutorTypedDataSignatures(t *testing.T) {
+	tests := []struct {
+		name           string
+		activationTime func(chainTime time.Time) time.Time
+		expectedErr    error
+	}{
+		{
+			name: "before activation",
+			activationTime: func(chainTime time.Time) time.Time {
+				return chainTime.Add(time.Second)
+			},
+			expectedErr: secp256k1fx.ErrWrongSig,
+		},
+		{
+			name: "at activation",
+			activationTime: func(chainTime time.Time) time.Time {
+				return chainTime
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			env := newEnvironment(t, upgradetest.Latest)
+			env.ctx.Lock.Lock()
+			defer env.ctx.Lock.Unlock()
+
+			env.config.UpgradeConfig.TypedDataSignaturesTime = test.activationTime(env.state.GetTimestamp())
+
+			key := genesistest.DefaultFundedKeys[0]
+			wallet := newWallet(t, env, walletConfig{
+				keys: genesistest.DefaultFundedKeys[:1],
+			})
+			tx, err := wallet.IssueBaseTx(
+				[]*avax.TransferableOutput{
+					{
+						Asset: avax.Asset{ID: env.ctx.AVAXAssetID},
+						Out: &secp256k1fx.TransferOutput{
+							Amt: 1,
+							OutputOwners: secp256k1fx.OutputOwners{
+								Threshold: 1,
+								Addrs:     []ids.ShortID{ids.ShortEmpty},
+							},
+						},
+					},
+				},
+			)
+			require.NoError(err)
+
+			// Replace the signatures of the tx hash by signatures of the typed
+			// data of the tx
+			typedData, err := txs.TypedData(tx.Unsigned)
+			require.NoError(err)
+			typedDataHash, _, err := apitypes.TypedDataAndHash(*typedData)
+			require.NoError(err)
+			sig, err := key.SignHash(typedDataHash)
+			require.NoError(err)
+			for _, cred := range tx.Creds {
+				cred := cred.(*secp256k1fx.Credential)
+				for i := range cred.Sigs {
+					copy(cred.Sigs[i][:], sig)
+				}
+			}
+
+			onAcceptState, err := state.NewDiff(env.state.GetLastAccepted(), env)
+			require.NoError(err)
+
+			feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
+			_, _, _, err = StandardTx(
+				&env.backend,
+				feeCalculator,
+				tx,
+				onAcceptState,
+			)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
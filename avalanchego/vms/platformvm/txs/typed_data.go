@@ -0,0 +1,114 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/eip712"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// TypedDataChainAlias is the chain alias used in the typed data of P-chain txs.
+const TypedDataChainAlias = "P"
+
+// TypedData returns the EIP-712 typed data of [tx], which can be signed
+// instead of the hash of the tx. Only BaseTx, ImportTx, ExportTx,
+// AddPermissionlessValidatorTx and AddPermissionlessDelegatorTx are supported.
+//
+// The unsigned bytes of [tx] must have been set.
+func TypedData(tx UnsignedTx) (*apitypes.TypedData, error) {
+	var b *eip712.Builder
+	switch tx := tx.(type) {
+	case *BaseTx:
+		b = newTypedDataBuilder("BaseTx", tx)
+	case *ImportTx:
+		b = newTypedDataBuilder("ImportTx", &tx.BaseTx)
+		b.AddString("sourceChain", tx.SourceChain.String())
+	case *ExportTx:
+		b = newTypedDataBuilder("ExportTx", &tx.BaseTx)
+		b.AddString("destinationChain", tx.DestinationChain.String())
+		if err := addTypedDataOutputs(b, "exportedOutputs", tx.ExportedOutputs); err != nil {
+			return nil, err
+		}
+	case *AddPermissionlessValidatorTx:
+		b = newTypedDataBuilder("AddPermissionlessValidatorTx", &tx.BaseTx)
+		addTypedDataValidator(b, &tx.Validator)
+		b.AddString("subnetID", tx.Subnet.String())
+		b.AddUint32("delegationShares", tx.DelegationShares)
+		if err := addTypedDataOutputs(b, "stake", tx.StakeOuts); err != nil {
+			return nil, err
+		}
+		if err := addTypedDataOwner(b, "validatorRewardsOwner", tx.ValidatorRewardsOwner); err != nil {
+			return nil, err
+		}
+		if err := addTypedDataOwner(b, "delegatorRewardsOwner", tx.DelegatorRewardsOwner); err != nil {
+			return nil, err
+		}
+	case *AddPermissionlessDelegatorTx:
+		b = newTypedDataBuilder("AddPermissionlessDelegatorTx", &tx.BaseTx)
+		addTypedDataValidator(b, &tx.Validator)
+		b.AddString("subnetID", tx.Subnet.String())
+		if err := addTypedDataOutputs(b, "stake", tx.StakeOuts); err != nil {
+			return nil, err
+		}
+		if err := addTypedDataOwner(b, "rewardsOwner", tx.DelegationRewardsOwner); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w: %T", eip712.ErrUnsupportedTx, tx)
+	}
+
+	if err := addTypedDataOutputs(b, "outputs", tx.Outputs()); err != nil {
+		return nil, err
+	}
+	return b.TypedData(), nil
+}
+
+// newTypedDataBuilder returns a builder of the typed data of a tx of
+// [primaryType] with the metadata of [tx].
+func newTypedDataBuilder(primaryType string, tx *BaseTx) *eip712.Builder {
+	return eip712.New(TypedDataChainAlias, tx.NetworkID, tx.BlockchainID, primaryType, tx.Bytes())
+}
+
+func addTypedDataValidator(b *eip712.Builder, vdr *Validator) {
+	b.AddString("nodeID", vdr.NodeID.String())
+	b.AddUint64("startTime", vdr.Start)
+	b.AddUint64("endTime", vdr.End)
+	b.AddUint64("weight", vdr.Wght)
+}
+
+// addTypedDataOutputs adds [outs] to the typed data, showing the outputs
+// locked by stakeable outputs in their place with the locktime of the
+// stakeable output.
+func addTypedDataOutputs(b *eip712.Builder, name string, outs []*avax.TransferableOutput) error {
+	var (
+		unlockedOuts = make([]*avax.TransferableOutput, len(outs))
+		locktimes    = make([]uint64, len(outs))
+	)
+	for i, out := range outs {
+		unlockedOuts[i] = out
+		if lockedOut, ok := out.Out.(*stakeable.LockOut); ok {
+			unlockedOuts[i] = &avax.TransferableOutput{
+				Asset: out.Asset,
+				Out:   lockedOut.TransferableOut,
+			}
+			locktimes[i] = lockedOut.Locktime
+		}
+	}
+	return b.AddStakeableOutputs(name, unlockedOuts, locktimes)
+}
+
+func addTypedDataOwner(b *eip712.Builder, name string, owner fx.Owner) error {
+	outputOwners, ok := owner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return fmt.Errorf("%w: %T", eip712.ErrUnsupportedOutput, owner)
+	}
+	return b.AddOwner(name, outputOwners)
+}
@@ -0,0 +1,217 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/eip712"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestTypedData(t *testing.T) {
+	owner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{{1}},
+	}
+	baseTx := BaseTx{
+		BaseTx: avax.BaseTx{
+			NetworkID:    constants.FlareID,
+			BlockchainID: constants.PlatformChainID,
+			Outs: []*avax.TransferableOutput{
+				{
+					Asset: avax.Asset{ID: ids.ID{2}},
+					Out: &secp256k1fx.TransferOutput{
+						Amt:          units.Avax,
+						OutputOwners: *owner,
+					},
+				},
+			},
+		},
+	}
+	stakeOuts := []*avax.TransferableOutput{
+		{
+			Asset: avax.Asset{ID: ids.ID{2}},
+			Out: &stakeable.LockOut{
+				Locktime: 12345,
+				TransferableOut: &secp256k1fx.TransferOutput{
+					Amt:          units.KiloAvax,
+					OutputOwners: *owner,
+				},
+			},
+		},
+	}
+	validator := Validator{
+		NodeID: ids.GenerateTestNodeID(),
+		Start:  1,
+		End:    2,
+		Wght:   units.KiloAvax,
+	}
+
+	tests := []struct {
+		name           string
+		tx             UnsignedTx
+		expectedFields []string
+		expectedErr    error
+	}{
+		{
+			name:           "base tx",
+			tx:             &BaseTx{BaseTx: baseTx.BaseTx},
+			expectedFields: []string{"txHash", "outputs"},
+		},
+		{
+			name: "import tx",
+			tx: &ImportTx{
+				BaseTx:      baseTx,
+				SourceChain: ids.GenerateTestID(),
+			},
+			expectedFields: []string{"txHash", "sourceChain", "outputs"},
+		},
+		{
+			name: "export tx",
+			tx: &ExportTx{
+				BaseTx:           baseTx,
+				DestinationChain: ids.GenerateTestID(),
+				ExportedOutputs:  baseTx.Outs,
+			},
+			expectedFields: []string{"txHash", "destinationChain", "exportedOutputs", "outputs"},
+		},
+		{
+			name: "add permissionless validator tx",
+			tx: &AddPermissionlessValidatorTx{
+				BaseTx:                baseTx,
+				Validator:             validator,
+				Subnet:                constants.PrimaryNetworkID,
+				Signer:                &signer.Empty{},
+				StakeOuts:             stakeOuts,
+				ValidatorRewardsOwner: owner,
+				DelegatorRewardsOwner: owner,
+				DelegationShares:      200_000,
+			},
+			expectedFields: []string{
+				"txHash", "nodeID", "startTime", "endTime", "weight", "subnetID",
+				"delegationShares", "stake", "validatorRewardsOwner",
+				"delegatorRewardsOwner", "outputs",
+			},
+		},
+		{
+			name: "add permissionless delegator tx",
+			tx: &AddPermissionlessDelegatorTx{
+				BaseTx:                 baseTx,
+				Validator:              validator,
+				Subnet:                 constants.PrimaryNetworkID,
+				StakeOuts:              stakeOuts,
+				DelegationRewardsOwner: owner,
+			},
+			expectedFields: []string{
+				"txHash", "nodeID", "startTime", "endTime", "weight", "subnetID",
+				"stake", "rewardsOwner", "outputs",
+			},
+		},
+		{
+			name: "unsupported tx",
+			tx: &CreateSubnetTx{
+				BaseTx: baseTx,
+				Owner:  owner,
+			},
+			expectedErr: eip712.ErrUnsupportedTx,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			unsignedBytes, err := Codec.Marshal(CodecVersion, &test.tx)
+			require.NoError(err)
+			test.tx.SetBytes(unsignedBytes)
+
+			typedData, err := TypedData(test.tx)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			fields := make([]string, 0, len(typedData.Types[typedData.PrimaryType]))
+			for _, field := range typedData.Types[typedData.PrimaryType] {
+				fields = append(fields, field.Name)
+			}
+			require.Equal(test.expectedFields, fields)
+
+			_, err = eip712.Hash(typedData)
+			require.NoError(err)
+		})
+	}
+}
+
+func TestTypedDataStakeableOutputs(t *testing.T) {
+	require := require.New(t)
+
+	owner := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{{1}},
+	}
+	var tx UnsignedTx = &AddPermissionlessDelegatorTx{
+		BaseTx: BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    constants.FlareID,
+				BlockchainID: constants.PlatformChainID,
+				Outs: []*avax.TransferableOutput{
+					{
+						Asset: avax.Asset{ID: ids.ID{2}},
+						Out: &secp256k1fx.TransferOutput{
+							Amt:          units.Avax,
+							OutputOwners: owner,
+						},
+					},
+				},
+			},
+		},
+		Validator: Validator{
+			NodeID: ids.GenerateTestNodeID(),
+			Start:  1,
+			End:    2,
+			Wght:   units.KiloAvax,
+		},
+		Subnet: constants.PrimaryNetworkID,
+		StakeOuts: []*avax.TransferableOutput{
+			{
+				Asset: avax.Asset{ID: ids.ID{2}},
+				Out: &stakeable.LockOut{
+					Locktime: 12345,
+					TransferableOut: &secp256k1fx.TransferOutput{
+						Amt:          units.KiloAvax,
+						OutputOwners: owner,
+					},
+				},
+			},
+		},
+		DelegationRewardsOwner: &owner,
+	}
+	unsignedBytes, err := Codec.Marshal(CodecVersion, &tx)
+	require.NoError(err)
+	tx.SetBytes(unsignedBytes)
+
+	typedData, err := TypedData(tx)
+	require.NoError(err)
+
+	// Stakeable outputs show the locktime of the stake, other outputs are not
+	// locked for staking
+	for field, expectedLocktime := range map[string]string{
+		"stake":   "12345",
+		"outputs": "0",
+	} {
+		outs := typedData.Message[field].([]interface{})
+		require.Len(outs, 1)
+		require.Equal(expectedLocktime, outs[0].(apitypes.TypedDataMessage)["locktime"])
+	}
+}
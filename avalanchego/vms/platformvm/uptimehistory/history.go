@@ -0,0 +1,255 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+// Package uptimehistory records the uptime of the primary network validators
+// observed by this node in each epoch, so that staking rewards can be
+// calculated off-chain from numbers that don't change after the epoch ends.
+package uptimehistory
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/uptime"
+	"github.com/ava-labs/avalanchego/utils/json"
+)
+
+// MaxEpochs is the maximum number of epochs returned by GetUptimeHistory.
+const MaxEpochs = 1024
+
+const (
+	recordLen   = 6 * database.Uint64Size
+	snapshotLen = 4 * database.Uint64Size
+)
+
+var (
+	_ uptime.History = (*History)(nil)
+
+	recordPrefix   = []byte("record")
+	snapshotPrefix = []byte("snapshot")
+
+	ErrInvalidEpochRange = errors.New("toEpoch is before fromEpoch")
+	ErrTooManyEpochs     = errors.New("too many epochs requested")
+	errInvalidRecord     = errors.New("invalid record")
+	errInvalidSnapshot   = errors.New("invalid snapshot")
+)
+
+// Epochs splits time into consecutive epochs of the same duration, epoch 0
+// starting at Start.
+type Epochs struct {
+	Start    time.Time
+	Duration time.Duration
+}
+
+// Epoch returns the epoch that contains [t], or false if [t] is before the
+// first epoch.
+func (e Epochs) Epoch(t time.Time) (uint64, bool) {
+	if t.Before(e.Start) {
+		return 0, false
+	}
+	return uint64(t.Sub(e.Start) / e.Duration), true
+}
+
+// Bounds returns the start time and the end time of [epoch].
+func (e Epochs) Bounds(epoch uint64) (time.Time, time.Time) {
+	start := e.Start.Add(time.Duration(epoch) * e.Duration)
+	return start, start.Add(e.Duration)
+}
+
+// Validator is a primary network validator whose uptime is observed.
+type Validator struct {
+	NodeID    ids.NodeID
+	StartTime time.Time
+}
+
+// snapshot is the last observation of a validator, which the uptime of the
+// next epoch is computed from.
+type snapshot struct {
+	epoch              uint64
+	validatorStartTime uint64
+	upDuration         time.Duration
+	time               uint64
+}
+
+// History stores the uptime of the validators in each epoch. Only the epochs
+// whose start and end were both observed by this node are recorded: the first
+// epoch after a validator is added to the history, or after this node was not
+// bootstrapped at the end of an epoch, is only recorded if the validator
+// started staking during that epoch. The uptime of a validator that stops
+// staking during an epoch is not recorded for that epoch.
+//
+// The uptimes are node-local. They are measured between the observations of
+// this node, which are made shortly after the epochs end, so the records whose
+// observed window differs from the bounds of the epoch are marked approximate.
+type History struct {
+	epochs    Epochs
+	records   database.Database
+	snapshots database.Database
+}
+
+func New(db database.Database, epochs Epochs) *History {
+	return &History{
+		epochs:    epochs,
+		records:   prefixdb.New(recordPrefix, db),
+		snapshots: prefixdb.New(snapshotPrefix, db),
+	}
+}
+
+func (h *History) Epochs() Epochs {
+	return h.epochs
+}
+
+// Observe records the uptime of [vdrs] in [epoch], which must have just
+// ended. Epochs that were already observed are ignored.
+func (h *History) Observe(epoch uint64, vdrs []Validator, calculator uptime.Calculator) error {
+	epochStart, epochEnd := h.epochs.Bounds(epoch)
+	for _, vdr := range vdrs {
+		prev, err := h.getSnapshot(vdr.NodeID)
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		hasPrev := err == nil
+		if hasPrev && prev.epoch >= epoch {
+			continue
+		}
+
+		upDuration, now, err := calculator.CalculateUptime(vdr.NodeID)
+		if err != nil {
+			return fmt.Errorf("failed to calculate uptime of %s: %w", vdr.NodeID, err)
+		}
+
+		vdrStartTime := uint64(vdr.StartTime.Unix())
+		current := &snapshot{
+			epoch:              epoch,
+			validatorStartTime: vdrStartTime,
+			upDuration:         upDuration,
+			time:               uint64(now.Unix()),
+		}
+
+		var (
+			startTime       uint64
+			startUpDuration time.Duration
+			observed        = true
+		)
+		switch {
+		case hasPrev && prev.epoch+1 == epoch && prev.validatorStartTime == vdrStartTime:
+			startTime = prev.time
+			startUpDuration = prev.upDuration
+		case !vdr.StartTime.Before(epochStart):
+			startTime = vdrStartTime
+		default:
+			observed = false
+		}
+
+		if observed && current.time > startTime {
+			elapsed := current.time - startTime
+			upSeconds := min(uint64((upDuration-startUpDuration)/time.Second), elapsed)
+			stakingStart := max(uint64(epochStart.Unix()), vdrStartTime)
+			record := &uptime.EpochUptime{
+				Epoch:            json.Uint64(epoch),
+				StartTime:        json.Uint64(startTime),
+				EndTime:          json.Uint64(current.time),
+				Approximate:      startTime != stakingStart || current.time != uint64(epochEnd.Unix()),
+				Uptime:           json.Uint64(upSeconds),
+				UptimePercentage: json.Float64(100 * float64(upSeconds) / float64(elapsed)),
+			}
+			if err := h.records.Put(recordKey(vdr.NodeID, epoch), marshalRecord(record)); err != nil {
+				return err
+			}
+		}
+		if err := h.snapshots.Put(vdr.NodeID[:], marshalSnapshot(current)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *History) GetUptimeHistory(nodeID ids.NodeID, fromEpoch, toEpoch uint64) ([]uptime.EpochUptime, error) {
+	if toEpoch < fromEpoch {
+		return nil, fmt.Errorf("%w: %d < %d", ErrInvalidEpochRange, toEpoch, fromEpoch)
+	}
+	if toEpoch-fromEpoch >= MaxEpochs {
+		return nil, fmt.Errorf("%w: at most %d epochs can be requested", ErrTooManyEpochs, MaxEpochs)
+	}
+
+	it := h.records.NewIteratorWithStartAndPrefix(recordKey(nodeID, fromEpoch), nodeID[:])
+	defer it.Release()
+
+	records := []uptime.EpochUptime{}
+	for it.Next() {
+		record, err := parseRecord(it.Value())
+		if err != nil {
+			return nil, err
+		}
+		if uint64(record.Epoch) > toEpoch {
+			break
+		}
+		records = append(records, *record)
+	}
+	return records, it.Error()
+}
+
+func (h *History) getSnapshot(nodeID ids.NodeID) (*snapshot, error) {
+	b, err := h.snapshots.Get(nodeID[:])
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != snapshotLen {
+		return nil, fmt.Errorf("%w: length %d", errInvalidSnapshot, len(b))
+	}
+	return &snapshot{
+		epoch:              binary.BigEndian.Uint64(b),
+		validatorStartTime: binary.BigEndian.Uint64(b[database.Uint64Size:]),
+		upDuration:         time.Duration(binary.BigEndian.Uint64(b[2*database.Uint64Size:])),
+		time:               binary.BigEndian.Uint64(b[3*database.Uint64Size:]),
+	}, nil
+}
+
+func recordKey(nodeID ids.NodeID, epoch uint64) []byte {
+	key := make([]byte, ids.NodeIDLen+database.Uint64Size)
+	copy(key, nodeID[:])
+	binary.BigEndian.PutUint64(key[ids.NodeIDLen:], epoch)
+	return key
+}
+
+func marshalSnapshot(s *snapshot) []byte {
+	b := make([]byte, snapshotLen)
+	binary.BigEndian.PutUint64(b, s.epoch)
+	binary.BigEndian.PutUint64(b[database.Uint64Size:], s.validatorStartTime)
+	binary.BigEndian.PutUint64(b[2*database.Uint64Size:], uint64(s.upDuration))
+	binary.BigEndian.PutUint64(b[3*database.Uint64Size:], s.time)
+	return b
+}
+
+func marshalRecord(r *uptime.EpochUptime) []byte {
+	b := make([]byte, recordLen)
+	binary.BigEndian.PutUint64(b, uint64(r.Epoch))
+	binary.BigEndian.PutUint64(b[database.Uint64Size:], uint64(r.StartTime))
+	binary.BigEndian.PutUint64(b[2*database.Uint64Size:], uint64(r.EndTime))
+	binary.BigEndian.PutUint64(b[3*database.Uint64Size:], uint64(r.Uptime))
+	binary.BigEndian.PutUint64(b[4*database.Uint64Size:], math.Float64bits(float64(r.UptimePercentage)))
+	if r.Approximate {
+		binary.BigEndian.PutUint64(b[5*database.Uint64Size:], 1)
+	}
+	return b
+}
+
+func parseRecord(b []byte) (*uptime.EpochUptime, error) {
+	if len(b) != recordLen {
+		return nil, fmt.Errorf("%w: length %d", errInvalidRecord, len(b))
+	}
+	return &uptime.EpochUptime{
+		Epoch:            json.Uint64(binary.BigEndian.Uint64(b)),
+		StartTime:        json.Uint64(binary.BigEndian.Uint64(b[database.Uint64Size:])),
+		EndTime:          json.Uint64(binary.BigEndian.Uint64(b[2*database.Uint64Size:])),
+		Uptime:           json.Uint64(binary.BigEndian.Uint64(b[3*database.Uint64Size:])),
+		UptimePercentage: json.Float64(math.Float64frombits(binary.BigEndian.Uint64(b[4*database.Uint64Size:]))),
+		Approximate:      binary.BigEndian.Uint64(b[5*database.Uint64Size:]) != 0,
+	}, nil
+}
@@ -0,0 +1,220 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package uptimehistory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/uptime"
+)
+
+var testEpochs = Epochs{
+	Start:    time.Unix(1_000, 0),
+	Duration: 100 * time.Second,
+}
+
+type testCalculator struct {
+	uptime.Calculator
+
+	now        time.Time
+	upDuration map[ids.NodeID]time.Duration
+}
+
+func (c *testCalculator) CalculateUptime(nodeID ids.NodeID) (time.Duration, time.Time, error) {
+	return c.upDuration[nodeID], c.now, nil
+}
+
+func TestEpochs(t *testing.T) {
+	require := require.New(t)
+
+	_, ok := testEpochs.Epoch(time.Unix(999, 0))
+	require.False(ok)
+
+	epoch, ok := testEpochs.Epoch(time.Unix(1_000, 0))
+	require.True(ok)
+	require.Zero(epoch)
+
+	epoch, ok = testEpochs.Epoch(time.Unix(1_250, 0))
+	require.True(ok)
+	require.Equal(uint64(2), epoch)
+
+	start, end := testEpochs.Bounds(2)
+	require.Equal(time.Unix(1_200, 0), start)
+	require.Equal(time.Unix(1_300, 0), end)
+}
+
+func TestObserve(t *testing.T) {
+	require := require.New(t)
+
+	h := New(memdb.New(), testEpochs)
+	var (
+		oldVdr = Validator{
+			NodeID:    ids.GenerateTestNodeID(),
+			StartTime: time.Unix(500, 0),
+		}
+		newVdr = Validator{
+			NodeID:    ids.GenerateTestNodeID(),
+			StartTime: time.Unix(1_050, 0),
+		}
+		calculator = &testCalculator{
+			now: time.Unix(1_100, 0),
+			upDuration: map[ids.NodeID]time.Duration{
+				oldVdr.NodeID: 500 * time.Second,
+				newVdr.NodeID: 25 * time.Second,
+			},
+		}
+	)
+
+	// The start of epoch 0 wasn't observed for the old validator
+	require.NoError(h.Observe(0, []Validator{oldVdr, newVdr}, calculator))
+
+	records, err := h.GetUptimeHistory(oldVdr.NodeID, 0, 10)
+	require.NoError(err)
+	require.Empty(records)
+
+	records, err = h.GetUptimeHistory(newVdr.NodeID, 0, 10)
+	require.NoError(err)
+	require.Equal([]uptime.EpochUptime{{
+		Epoch:            0,
+		StartTime:        1_050,
+		EndTime:          1_100,
+		Uptime:           25,
+		UptimePercentage: 50,
+	}}, records)
+
+	calculator.now = time.Unix(1_200, 0)
+	calculator.upDuration[oldVdr.NodeID] += 80 * time.Second
+	calculator.upDuration[newVdr.NodeID] += 100 * time.Second
+	require.NoError(h.Observe(1, []Validator{oldVdr, newVdr}, calculator))
+
+	// Observing an epoch again doesn't change its records
+	calculator.upDuration[oldVdr.NodeID] = 0
+	require.NoError(h.Observe(1, []Validator{oldVdr}, calculator))
+
+	records, err = h.GetUptimeHistory(oldVdr.NodeID, 0, 10)
+	require.NoError(err)
+	require.Equal([]uptime.EpochUptime{{
+		Epoch:            1,
+		StartTime:        1_100,
+		EndTime:          1_200,
+		Uptime:           80,
+		UptimePercentage: 80,
+	}}, records)
+
+	records, err = h.GetUptimeHistory(newVdr.NodeID, 1, 1)
+	require.NoError(err)
+	require.Equal([]uptime.EpochUptime{{
+		Epoch:            1,
+		StartTime:        1_100,
+		EndTime:          1_200,
+		Uptime:           100,
+		UptimePercentage: 100,
+	}}, records)
+
+	// Epoch 2 wasn't observed, so epoch 3 can't be recorded
+	calculator.now = time.Unix(1_400, 0)
+	require.NoError(h.Observe(3, []Validator{newVdr}, calculator))
+
+	records, err = h.GetUptimeHistory(newVdr.NodeID, 2, 3)
+	require.NoError(err)
+	require.Empty(records)
+}
+
+func TestObserveRestakedValidator(t *testing.T) {
+	require := require.New(t)
+
+	h := New(memdb.New(), testEpochs)
+	vdr := Validator{
+		NodeID:    ids.GenerateTestNodeID(),
+		StartTime: time.Unix(1_000, 0),
+	}
+	calculator := &testCalculator{
+		now: time.Unix(1_100, 0),
+		upDuration: map[ids.NodeID]time.Duration{
+			vdr.NodeID: 100 * time.Second,
+		},
+	}
+	require.NoError(h.Observe(0, []Validator{vdr}, calculator))
+
+	// The uptime of the new staking period starts from zero
+	vdr.StartTime = time.Unix(1_160, 0)
+	calculator.now = time.Unix(1_200, 0)
+	calculator.upDuration[vdr.NodeID] = 10 * time.Second
+	require.NoError(h.Observe(1, []Validator{vdr}, calculator))
+
+	records, err := h.GetUptimeHistory(vdr.NodeID, 1, 1)
+	require.NoError(err)
+	require.Equal([]uptime.EpochUptime{{
+		Epoch:            1,
+		StartTime:        1_160,
+		EndTime:          1_200,
+		Uptime:           10,
+		UptimePercentage: 25,
+	}}, records)
+}
+
+func TestObserveDelayed(t *testing.T) {
+	require := require.New(t)
+
+	h := New(memdb.New(), testEpochs)
+	vdr := Validator{
+		NodeID:    ids.GenerateTestNodeID(),
+		StartTime: time.Unix(1_000, 0),
+	}
+	calculator := &testCalculator{
+		now: time.Unix(1_105, 0),
+		upDuration: map[ids.NodeID]time.Duration{
+			vdr.NodeID: 105 * time.Second,
+		},
+	}
+	require.NoError(h.Observe(0, []Validator{vdr}, calculator))
+
+	calculator.now = time.Unix(1_200, 0)
+	calculator.upDuration[vdr.NodeID] += 95 * time.Second
+	require.NoError(h.Observe(1, []Validator{vdr}, calculator))
+
+	// Both records were measured over a window that differs from their epoch
+	records, err := h.GetUptimeHistory(vdr.NodeID, 0, 1)
+	require.NoError(err)
+	require.Equal([]uptime.EpochUptime{
+		{
+			Epoch:            0,
+			StartTime:        1_000,
+			EndTime:          1_105,
+			Approximate:      true,
+			Uptime:           105,
+			UptimePercentage: 100,
+		},
+		{
+			Epoch:            1,
+			StartTime:        1_105,
+			EndTime:          1_200,
+			Approximate:      true,
+			Uptime:           95,
+			UptimePercentage: 100,
+		},
+	}, records)
+}
+
+func TestGetUptimeHistoryRange(t *testing.T) {
+	require := require.New(t)
+
+	h := New(memdb.New(), testEpochs)
+	nodeID := ids.GenerateTestNodeID()
+
+	_, err := h.GetUptimeHistory(nodeID, 2, 1)
+	require.ErrorIs(err, ErrInvalidEpochRange)
+
+	_, err = h.GetUptimeHistory(nodeID, 0, MaxEpochs)
+	require.ErrorIs(err, ErrTooManyEpochs)
+
+	records, err := h.GetUptimeHistory(nodeID, 0, MaxEpochs-1)
+	require.NoError(err)
+	require.Empty(records)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/codec/linearcodec"
 	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
@@ -34,10 +35,13 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/inflation"
 	"github.com/ava-labs/avalanchego/vms/platformvm/network"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakerfeed"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/uptimehistory"
 	"github.com/ava-labs/avalanchego/vms/platformvm/utxo"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/vms/txs/mempool"
@@ -56,6 +60,12 @@ var (
 	_ snowmanblock.BuildBlockWithContextChainVM = (*VM)(nil)
 	_ secp256k1fx.VM                            = (*VM)(nil)
 	_ validators.State                          = (*VM)(nil)
+
+	errInflationScheduleOverride = errors.New("inflation schedule can only be overridden on local networks")
+	errNegativeEpochDuration     = errors.New("uptime history epoch duration is negative")
+
+	uptimeHistoryPrefix    = []byte("uptimeHistory")
+	stakerEventIndexPrefix = []byte("stakerEventIndex")
 )
 
 type VM struct {
@@ -71,6 +81,12 @@ type VM struct {
 
 	uptimeManager uptime.Manager
 
+	// Nil if the uptime history is disabled
+	uptimeHistory *uptimehistory.History
+
+	// Publishes the changes of the staker sets made by the accepted blocks
+	stakerFeed *stakerfeed.Feed
+
 	// The context of this vm
 	ctx *snow.Context
 	db  database.Database
@@ -126,6 +142,16 @@ func (vm *VM) Initialize(
 	vm.ctx = chainCtx
 	vm.db = db
 
+	if len(execConfig.InflationSchedule) != 0 {
+		if chainCtx.NetworkID != constants.LocalID && chainCtx.NetworkID != constants.LocalFlareID {
+			return fmt.Errorf("%w: network %d", errInflationScheduleOverride, chainCtx.NetworkID)
+		}
+		if err := inflation.VerifyPhases(execConfig.InflationSchedule); err != nil {
+			return fmt.Errorf("invalid inflation schedule: %w", err)
+		}
+		vm.InflationPhases = execConfig.InflationSchedule
+	}
+
 	// Note: this codec is never used to serialize anything
 	vm.codecRegistry = linearcodec.NewDefault()
 	vm.fx = &secp256k1fx.Fx{}
@@ -156,6 +182,21 @@ func (vm *VM) Initialize(
 	vm.uptimeManager = uptime.NewManager(vm.state, &vm.clock)
 	vm.UptimeLockedCalculator.SetCalculator(&vm.bootstrapped, &chainCtx.Lock, vm.uptimeManager)
 
+	switch {
+	case execConfig.UptimeHistoryEpochDuration < 0:
+		return errNegativeEpochDuration
+	case execConfig.UptimeHistoryEpochDuration > 0:
+		// The history is local to this node, so it isn't stored in the state
+		vm.uptimeHistory = uptimehistory.New(
+			prefixdb.New(uptimeHistoryPrefix, vm.db),
+			uptimehistory.Epochs{
+				Start:    execConfig.UptimeHistoryEpochStart,
+				Duration: execConfig.UptimeHistoryEpochDuration,
+			},
+		)
+		vm.UptimeLockedHistory.SetHistory(vm.uptimeHistory)
+	}
+
 	txExecutorBackend := &txexecutor.Backend{
 		Config:       &vm.Internal,
 		Ctx:          vm.ctx,
@@ -172,12 +213,19 @@ func (vm *VM) Initialize(
 		return fmt.Errorf("failed to create mempool: %w", err)
 	}
 
+	var stakerEventIndex *stakerfeed.Index
+	if execConfig.StakerEventIndexEnabled {
+		stakerEventIndex = stakerfeed.NewIndex(prefixdb.New(stakerEventIndexPrefix, vm.db))
+	}
+	vm.stakerFeed = stakerfeed.New(chainCtx.Log, stakerEventIndex)
+
 	vm.manager = blockexecutor.NewManager(
 		mempool,
 		vm.metrics,
 		vm.state,
 		txExecutorBackend,
 		validatorManager,
+		vm.stakerFeed,
 	)
 
 	txVerifier := network.NewLockedTxVerifier(&txExecutorBackend.Ctx.Lock, vm.manager)
@@ -235,6 +283,10 @@ func (vm *VM) Initialize(
 	// [periodicallyPruneMempool] grabs the context lock.
 	go vm.periodicallyPruneMempool(execConfig.MempoolPruneFrequency)
 
+	if vm.uptimeHistory != nil {
+		go vm.observeUptimesEachEpoch()
+	}
+
 	go func() {
 		err := vm.state.ReindexBlocks(&vm.ctx.Lock, vm.ctx.Log)
 		if err != nil {
@@ -290,6 +342,65 @@ func (vm *VM) pruneMempool() error {
 	return nil
 }
 
+// observeUptimesEachEpoch records the uptimes of the validators at the end of
+// each epoch.
+func (vm *VM) observeUptimesEachEpoch() {
+	epochs := vm.uptimeHistory.Epochs()
+	for {
+		now := vm.clock.Time()
+		epoch, started := epochs.Epoch(now)
+		epochEnd := epochs.Start
+		if started {
+			_, epochEnd = epochs.Bounds(epoch)
+		}
+
+		timer := time.NewTimer(epochEnd.Sub(now))
+		select {
+		case <-vm.onShutdownCtx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if !started {
+			continue
+		}
+		if err := vm.observeUptimes(epoch); err != nil {
+			vm.ctx.Log.Warn("failed to record uptimes",
+				zap.Uint64("epoch", epoch),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func (vm *VM) observeUptimes(epoch uint64) error {
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	// The uptimes are only tracked once the chain is bootstrapped
+	if !vm.bootstrapped.Get() {
+		vm.ctx.Log.Info("skipping uptime recording while bootstrapping",
+			zap.Uint64("epoch", epoch),
+		)
+		return nil
+	}
+
+	vdrIDs := vm.Validators.GetValidatorIDs(constants.PrimaryNetworkID)
+	vdrs := make([]uptimehistory.Validator, len(vdrIDs))
+	for i, nodeID := range vdrIDs {
+		staker, err := vm.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+		if err != nil {
+			return fmt.Errorf("failed to get validator %s: %w", nodeID, err)
+		}
+		vdrs[i] = uptimehistory.Validator{
+			NodeID:    nodeID,
+			StartTime: staker.StartTime,
+		}
+	}
+	return vm.uptimeHistory.Observe(epoch, vdrs, vm.uptimeManager)
+}
+
 // Create all chains that exist that this node validates.
 func (vm *VM) initBlockchains() error {
 	if vm.Internal.PartialSyncPrimaryNetwork {
@@ -394,6 +505,7 @@ func (vm *VM) Shutdown(context.Context) error {
 
 	vm.onShutdownCtxCancel()
 	vm.Builder.ShutdownBlockTimer()
+	vm.stakerFeed.Close()
 
 	if vm.uptimeManager.StartedTracking() {
 		primaryVdrIDs := vm.Validators.GetValidatorIDs(constants.PrimaryNetworkID)
@@ -461,7 +573,8 @@ func (vm *VM) CreateHandlers(context.Context) (map[string]http.Handler, error) {
 	}
 	err := server.RegisterService(service, "platform")
 	return map[string]http.Handler{
-		"": server,
+		"":        server,
+		"/events": vm.stakerFeed,
 	}, err
 }
 
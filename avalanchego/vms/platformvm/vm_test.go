@@ -7,6 +7,8 @@ import (
 	"bytes"
 	"context"
 	"math/big"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -55,6 +57,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis/genesistest"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakerfeed"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -68,6 +71,7 @@ import (
 	smeng "github.com/ava-labs/avalanchego/snow/engine/snowman"
 	snowgetter "github.com/ava-labs/avalanchego/snow/engine/snowman/getter"
 	timetracker "github.com/ava-labs/avalanchego/snow/networking/tracker"
+	avajson "github.com/ava-labs/avalanchego/utils/json"
 	blockbuilder "github.com/ava-labs/avalanchego/vms/platformvm/block/builder"
 	blockexecutor "github.com/ava-labs/avalanchego/vms/platformvm/block/executor"
 	txexecutor "github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
@@ -359,6 +363,76 @@ func TestAddValidatorCommit(t *testing.T) {
 	require.NoError(err)
 }
 
+func TestStakerFeed(t *testing.T) {
+	require := require.New(t)
+	vm, _, _ := defaultVM(t, upgradetest.Latest)
+
+	server := httptest.NewServer(vm.stakerFeed)
+	defer server.Close()
+
+	nodeID := ids.GenerateTestNodeID()
+	subscription, err := stakerfeed.Subscribe(
+		context.Background(),
+		"ws"+strings.TrimPrefix(server.URL, "http"),
+		nodeID,
+	)
+	require.NoError(err)
+	defer subscription.Close()
+
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	wallet := newWallet(t, vm, walletConfig{})
+	endTime := vm.clock.Time().Add(defaultMinStakingDuration)
+	rewardsOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	sk, err := localsigner.New()
+	require.NoError(err)
+	pop, err := signer.NewProofOfPossession(sk)
+	require.NoError(err)
+
+	tx, err := wallet.IssueAddPermissionlessValidatorTx(
+		&txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				End:    uint64(endTime.Unix()),
+				Wght:   vm.MinValidatorStake,
+			},
+			Subnet: constants.PrimaryNetworkID,
+		},
+		pop,
+		vm.ctx.AVAXAssetID,
+		rewardsOwner,
+		rewardsOwner,
+		reward.PercentDenominator,
+	)
+	require.NoError(err)
+
+	vm.ctx.Lock.Unlock()
+	require.NoError(vm.issueTxFromRPC(tx))
+	vm.ctx.Lock.Lock()
+	require.NoError(buildAndAcceptStandardBlock(vm))
+
+	blk, err := vm.manager.GetBlock(vm.state.GetLastAccepted())
+	require.NoError(err)
+
+	events, err := subscription.Next()
+	require.NoError(err)
+	require.Equal([]stakerfeed.Event{{
+		Type:      stakerfeed.ValidatorAdded,
+		Height:    avajson.Uint64(blk.Height()),
+		Timestamp: avajson.Uint64(vm.state.GetTimestamp().Unix()),
+		TxID:      tx.ID(),
+		SubnetID:  constants.PrimaryNetworkID,
+		NodeID:    nodeID,
+		Weight:    avajson.Uint64(vm.MinValidatorStake),
+		StartTime: avajson.Uint64(vm.state.GetTimestamp().Unix()),
+		EndTime:   avajson.Uint64(endTime.Unix()),
+	}}, events)
+}
+
 // verify invalid attempt to add validator to primary network
 func TestInvalidAddValidatorCommit(t *testing.T) {
 	require := require.New(t)
@@ -196,6 +196,10 @@ func (fx *Fx) VerifyCredentials(utx UnsignedTx, in *Input, cred *Credential, out
 	txHash := hashing.ComputeHash256(utx.Bytes())
 	txHashStr := hex.EncodeToString(txHash)
 	txHashEth := accounts.TextHash([]byte(txHashStr))
+	var (
+		typedDataHash    []byte
+		typedDataChecked bool
+	)
 	for i, index := range in.SigIndices {
 		// Make sure the input references an address that exists
 		if index >= uint32(len(out.Addrs)) {
@@ -226,12 +230,42 @@ func (fx *Fx) VerifyCredentials(utx UnsignedTx, in *Input, cred *Credential, out
 			continue
 		}
 
+		// Try to recover the address from the signature of the EIP-712 typed
+		// data of the transaction, if typed data signatures are activated
+		if !typedDataChecked {
+			typedDataHash = hashTypedData(utx)
+			typedDataChecked = true
+		}
+		if typedDataHash != nil {
+			pk, err = fx.RecoverPublicKeyFromHash(typedDataHash, sig[:])
+			if err != nil {
+				return err
+			}
+			if expectedAddress == pk.Address() {
+				continue
+			}
+		}
+
 		return ErrWrongSig
 	}
 
 	return nil
 }
 
+// hashTypedData returns the hash of the EIP-712 typed data of [utx], or nil if
+// [utx] can not be signed as typed data.
+func hashTypedData(utx UnsignedTx) []byte {
+	tx, ok := utx.(TypedDataTx)
+	if !ok {
+		return nil
+	}
+	hash, err := tx.TypedDataHash()
+	if err != nil {
+		return nil
+	}
+	return hash
+}
+
 // CreateOutput creates a new output with the provided control group worth
 // the specified amount
 func (*Fx) CreateOutput(amount uint64, ownerIntf interface{}) (interface{}, error) {
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/codec/linearcodec"
@@ -1136,3 +1137,101 @@ func TestVerifyPermission(t *testing.T) {
 		})
 	}
 }
+
+type typedDataTestTx struct {
+	TestTx
+}
+
+func (tx *typedDataTestTx) TypedData() (*apitypes.TypedData, error) {
+	return &apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+			},
+			"Tx": {
+				{Name: "bytes", Type: "bytes"},
+			},
+		},
+		PrimaryType: "Tx",
+		Domain: apitypes.TypedDataDomain{
+			Name: "Test",
+		},
+		Message: apitypes.TypedDataMessage{
+			"bytes": tx.Bytes(),
+		},
+	}, nil
+}
+
+func (tx *typedDataTestTx) TypedDataHash() ([]byte, error) {
+	typedData, err := tx.TypedData()
+	if err != nil {
+		return nil, err
+	}
+	hash, _, err := apitypes.TypedDataAndHash(*typedData)
+	return hash, err
+}
+
+func TestFxVerifyTransferTypedData(t *testing.T) {
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(t, err)
+
+	typedDataHash, err := (&typedDataTestTx{TestTx: TestTx{UnsignedBytes: txBytes}}).TypedDataHash()
+	require.NoError(t, err)
+	sig, err := key.SignHash(typedDataHash)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		tx          UnsignedTx
+		expectedErr error
+	}{
+		{
+			name: "typed data signature",
+			tx:   &typedDataTestTx{TestTx: TestTx{UnsignedBytes: txBytes}},
+		},
+		{
+			name:        "typed data of another tx",
+			tx:          &typedDataTestTx{TestTx: TestTx{UnsignedBytes: []byte{5, 4, 3, 2, 1, 0}}},
+			expectedErr: ErrWrongSig,
+		},
+		{
+			name:        "tx without typed data",
+			tx:          &TestTx{UnsignedBytes: txBytes},
+			expectedErr: ErrWrongSig,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			vm := TestVM{
+				Codec: linearcodec.NewDefault(),
+				Log:   logging.NoLog{},
+			}
+			fx := Fx{}
+			require.NoError(fx.Initialize(&vm))
+			require.NoError(fx.Bootstrapped())
+
+			out := &TransferOutput{
+				Amt: 1,
+				OutputOwners: OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{key.Address()},
+				},
+			}
+			in := &TransferInput{
+				Amt: 1,
+				Input: Input{
+					SigIndices: []uint32{0},
+				},
+			}
+			cred := &Credential{
+				Sigs: make([][secp256k1.SignatureLen]byte, 1),
+			}
+			copy(cred.Sigs[0][:], sig)
+
+			err := fx.VerifyTransfer(test.tx, in, cred, out)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package secp256k1fx
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/coreth/accounts"
+
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+)
+
+// EthSigRecoveryOffset is added to the recovery id of the signatures by
+// Ethereum signers.
+const EthSigRecoveryOffset = 27
+
+// SignText returns the signature of [text] by [key] with the standard Ethereum
+// prefix (EIP-191), as done by personal_sign.
+//
+// The signature is in the format [r || s || v], where the recovery id v is
+// offset by EthSigRecoveryOffset as it is by Ethereum signers.
+func SignText(key *secp256k1.PrivateKey, text []byte) ([]byte, error) {
+	sig, err := key.SignHash(accounts.TextHash(text))
+	if err != nil {
+		return nil, err
+	}
+	sig[secp256k1.SignatureLen-1] += EthSigRecoveryOffset
+	return sig, nil
+}
+
+// RecoverTextSigner returns the public key that signed [text] with the
+// standard Ethereum prefix (EIP-191), as done by personal_sign.
+//
+// [sig] is in the format [r || s || v]. The recovery id v may be offset by
+// EthSigRecoveryOffset, as it is by Ethereum signers.
+func RecoverTextSigner(text, sig []byte) (*secp256k1.PublicKey, error) {
+	rawSig, err := ParseEthSignature(sig)
+	if err != nil {
+		return nil, err
+	}
+	return secp256k1.RecoverPublicKeyFromHash(accounts.TextHash(text), rawSig)
+}
+
+// ParseEthSignature returns a copy of the signature [sig] of an Ethereum
+// signer in the format [r || s || v] of the credentials, where the recovery id
+// v is not offset by EthSigRecoveryOffset.
+func ParseEthSignature(sig []byte) ([]byte, error) {
+	if len(sig) != secp256k1.SignatureLen {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", secp256k1.ErrInvalidSig, secp256k1.SignatureLen, len(sig))
+	}
+	rawSig := make([]byte, secp256k1.SignatureLen)
+	copy(rawSig, sig)
+	if rawSig[secp256k1.SignatureLen-1] >= EthSigRecoveryOffset {
+		rawSig[secp256k1.SignatureLen-1] -= EthSigRecoveryOffset
+	}
+	return rawSig, nil
+}
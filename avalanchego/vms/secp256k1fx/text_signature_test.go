@@ -0,0 +1,50 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package secp256k1fx
+
+import (
+	"testing"
+
+	"github.com/ava-labs/coreth/accounts"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+)
+
+func TestRecoverTextSigner(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+
+	text := []byte("link my addresses")
+	sig, err := sk.SignHash(accounts.TextHash(text))
+	require.NoError(err)
+
+	pk, err := RecoverTextSigner(text, sig)
+	require.NoError(err)
+	require.Equal(sk.PublicKey().Address(), pk.Address())
+
+	// Ethereum signers offset the recovery id by 27
+	ethSig, err := SignText(sk, text)
+	require.NoError(err)
+	require.Equal(sig[len(sig)-1]+EthSigRecoveryOffset, ethSig[len(ethSig)-1])
+
+	pk, err = RecoverTextSigner(text, ethSig)
+	require.NoError(err)
+	require.Equal(sk.PublicKey().EthAddress(), pk.EthAddress())
+
+	rawSig, err := ParseEthSignature(ethSig)
+	require.NoError(err)
+	require.Equal(sig, rawSig)
+
+	// A signature of another text recovers another key
+	pk, err = RecoverTextSigner([]byte("another text"), sig)
+	if err == nil {
+		require.NotEqual(sk.PublicKey().Address(), pk.Address())
+	}
+
+	_, err = RecoverTextSigner(text, sig[1:])
+	require.ErrorIs(err, secp256k1.ErrInvalidSig)
+}
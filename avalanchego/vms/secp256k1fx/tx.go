@@ -8,6 +8,17 @@ type UnsignedTx interface {
 	Bytes() []byte
 }
 
+// TypedDataTx is an UnsignedTx whose credentials may also sign its EIP-712
+// typed data. VMs pass their txs to the fx as TypedDataTx once typed data
+// signatures are activated at the chain time the txs are verified at.
+type TypedDataTx interface {
+	UnsignedTx
+	// TypedDataHash returns the hash of the typed data of the tx, or an error
+	// if the tx can not be signed as typed data. It is called for every input
+	// of the tx, so the hash should only be computed once.
+	TypedDataHash() ([]byte, error)
+}
+
 var _ UnsignedTx = (*TestTx)(nil)
 
 // TestTx is a minimal implementation of a Tx
@@ -33,8 +33,9 @@ type Backend interface {
 }
 
 type txSigner struct {
-	kc      keychain.Keychain
-	backend Backend
+	kc        keychain.Keychain
+	backend   Backend
+	typedData bool
 }
 
 func New(kc keychain.Keychain, backend Backend) Signer {
@@ -44,12 +45,24 @@ func New(kc keychain.Keychain, backend Backend) Signer {
 	}
 }
 
+// NewTypedData returns a signer that signs the EIP-712 typed data of the txs
+// that support it, see builder.TypedData, rather than their hash. Other txs
+// are signed as by a signer returned by New.
+func NewTypedData(kc keychain.Keychain, backend Backend) Signer {
+	return &txSigner{
+		kc:        kc,
+		backend:   backend,
+		typedData: true,
+	}
+}
+
 func (s *txSigner) Sign(ctx stdcontext.Context, tx *txs.Tx) error {
 	return tx.Unsigned.Visit(&visitor{
-		kc:      s.kc,
-		backend: s.backend,
-		ctx:     ctx,
-		tx:      tx,
+		kc:        s.kc,
+		backend:   s.backend,
+		ctx:       ctx,
+		tx:        tx,
+		typedData: s.typedData,
 	})
 }
 
@@ -15,10 +15,12 @@ import (
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/eip712"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/builder"
 )
 
 var (
@@ -37,10 +39,11 @@ var (
 
 // visitor handles signing transactions for the signer
 type visitor struct {
-	kc      keychain.Keychain
-	backend Backend
-	ctx     context.Context
-	tx      *txs.Tx
+	kc        keychain.Keychain
+	backend   Backend
+	ctx       context.Context
+	tx        *txs.Tx
+	typedData bool
 }
 
 func (*visitor) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
@@ -111,7 +114,7 @@ func (s *visitor) ImportTx(tx *txs.ImportTx) error {
 		return err
 	}
 	txSigners = append(txSigners, txImportSigners...)
-	return sign(s.tx, false, txSigners)
+	return s.signTypedData(false, txSigners)
 }
 
 func (s *visitor) ExportTx(tx *txs.ExportTx) error {
@@ -119,7 +122,7 @@ func (s *visitor) ExportTx(tx *txs.ExportTx) error {
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, false, txSigners)
+	return s.signTypedData(false, txSigners)
 }
 
 func (s *visitor) RemoveSubnetValidatorTx(tx *txs.RemoveSubnetValidatorTx) error {
@@ -153,7 +156,7 @@ func (s *visitor) AddPermissionlessValidatorTx(tx *txs.AddPermissionlessValidato
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, true, txSigners)
+	return s.signTypedData(true, txSigners)
 }
 
 func (s *visitor) AddPermissionlessDelegatorTx(tx *txs.AddPermissionlessDelegatorTx) error {
@@ -161,7 +164,7 @@ func (s *visitor) AddPermissionlessDelegatorTx(tx *txs.AddPermissionlessDelegato
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, true, txSigners)
+	return s.signTypedData(true, txSigners)
 }
 
 func (s *visitor) TransferSubnetOwnershipTx(tx *txs.TransferSubnetOwnershipTx) error {
@@ -182,7 +185,7 @@ func (s *visitor) BaseTx(tx *txs.BaseTx) error {
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, false, txSigners)
+	return s.signTypedData(false, txSigners)
 }
 
 func (s *visitor) ConvertSubnetToL1Tx(tx *txs.ConvertSubnetToL1Tx) error {
@@ -327,13 +330,46 @@ func (s *visitor) getAuthSigners(ownerID ids.ID, auth verify.Verifiable) ([]keyc
 	return authSigners, nil
 }
 
+// signTypedData signs the EIP-712 typed data of [s.tx] with [txSigners] if the
+// signer was created with NewTypedData, or signs [s.tx] as [sign] otherwise.
+func (s *visitor) signTypedData(signHash bool, txSigners [][]keychain.Signer) error {
+	if !s.typedData {
+		return sign(s.tx, signHash, txSigners)
+	}
+
+	typedData, err := builder.TypedData(s.tx.Unsigned)
+	if err != nil {
+		return err
+	}
+	typedDataHash, err := eip712.Hash(typedData)
+	if err != nil {
+		return fmt.Errorf("couldn't hash typed data: %w", err)
+	}
+	return signWith(s.tx, txSigners, func(signer keychain.Signer, _ []byte) ([]byte, error) {
+		return signer.SignHash(typedDataHash)
+	})
+}
+
 // TODO: remove [signHash] after the ledger supports signing all transactions.
 func sign(tx *txs.Tx, signHash bool, txSigners [][]keychain.Signer) error {
+	return signWith(tx, txSigners, func(signer keychain.Signer, unsignedBytes []byte) ([]byte, error) {
+		if signHash {
+			return signer.SignHash(hashing.ComputeHash256(unsignedBytes))
+		}
+		return signer.Sign(unsignedBytes)
+	})
+}
+
+// signWith adds the signatures of [tx] produced by [signFunc] for [txSigners].
+func signWith(
+	tx *txs.Tx,
+	txSigners [][]keychain.Signer,
+	signFunc func(signer keychain.Signer, unsignedBytes []byte) ([]byte, error),
+) error {
 	unsignedBytes, err := txs.Codec.Marshal(txs.CodecVersion, &tx.Unsigned)
 	if err != nil {
 		return fmt.Errorf("couldn't marshal unsigned tx: %w", err)
 	}
-	unsignedHash := hashing.ComputeHash256(unsignedBytes)
 
 	if expectedLen := len(txSigners); expectedLen != len(tx.Creds) {
 		tx.Creds = make([]verify.Verifiable, expectedLen)
@@ -376,12 +412,7 @@ func sign(tx *txs.Tx, signHash bool, txSigners [][]keychain.Signer) error {
 				continue
 			}
 
-			var sig []byte
-			if signHash {
-				sig, err = signer.SignHash(unsignedHash)
-			} else {
-				sig, err = signer.Sign(unsignedBytes)
-			}
+			sig, err := signFunc(signer, unsignedBytes)
 			if err != nil {
 				return fmt.Errorf("problem signing tx: %w", err)
 			}
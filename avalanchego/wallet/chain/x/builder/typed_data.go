@@ -0,0 +1,27 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package builder
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+)
+
+// TypedData returns the EIP-712 typed data of [utx], so that it can be signed
+// by wallets that display its contents. The hash of the typed data, see
+// eip712.Hash, can be signed in place of the hash of the tx.
+//
+// Only BaseTx, ImportTx and ExportTx moving outputs of the secp256k1fx are
+// supported.
+func TypedData(utx txs.UnsignedTx) (*apitypes.TypedData, error) {
+	unsignedBytes, err := Parser.Codec().Marshal(txs.CodecVersion, &utx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't marshal unsigned tx: %w", err)
+	}
+	utx.SetBytes(unsignedBytes)
+	return txs.TypedData(utx)
+}
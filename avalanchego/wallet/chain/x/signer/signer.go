@@ -31,8 +31,9 @@ type Backend interface {
 }
 
 type signer struct {
-	kc      keychain.Keychain
-	backend Backend
+	kc        keychain.Keychain
+	backend   Backend
+	typedData bool
 }
 
 func New(kc keychain.Keychain, backend Backend) Signer {
@@ -42,12 +43,24 @@ func New(kc keychain.Keychain, backend Backend) Signer {
 	}
 }
 
+// NewTypedData returns a signer that signs the EIP-712 typed data of the txs
+// that support it, see builder.TypedData, rather than their hash. Other txs
+// are signed as by a signer returned by New.
+func NewTypedData(kc keychain.Keychain, backend Backend) Signer {
+	return &signer{
+		kc:        kc,
+		backend:   backend,
+		typedData: true,
+	}
+}
+
 func (s *signer) Sign(ctx context.Context, tx *txs.Tx) error {
 	return tx.Unsigned.Visit(&visitor{
-		kc:      s.kc,
-		backend: s.backend,
-		ctx:     ctx,
-		tx:      tx,
+		kc:        s.kc,
+		backend:   s.backend,
+		ctx:       ctx,
+		tx:        tx,
+		typedData: s.typedData,
 	})
 }
 
@@ -15,6 +15,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/avm/fxs"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/eip712"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/nftfx"
 	"github.com/ava-labs/avalanchego/vms/propertyfx"
@@ -37,10 +38,11 @@ var (
 
 // visitor handles signing transactions for the signer
 type visitor struct {
-	kc      keychain.Keychain
-	backend Backend
-	ctx     context.Context
-	tx      *txs.Tx
+	kc        keychain.Keychain
+	backend   Backend
+	ctx       context.Context
+	tx        *txs.Tx
+	typedData bool
 }
 
 func (s *visitor) BaseTx(tx *txs.BaseTx) error {
@@ -48,7 +50,7 @@ func (s *visitor) BaseTx(tx *txs.BaseTx) error {
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, txCreds, txSigners)
+	return s.signTypedData(txCreds, txSigners)
 }
 
 func (s *visitor) CreateAssetTx(tx *txs.CreateAssetTx) error {
@@ -84,7 +86,7 @@ func (s *visitor) ImportTx(tx *txs.ImportTx) error {
 	}
 	txCreds = append(txCreds, txImportCreds...)
 	txSigners = append(txSigners, txImportSigners...)
-	return sign(s.tx, txCreds, txSigners)
+	return s.signTypedData(txCreds, txSigners)
 }
 
 func (s *visitor) ExportTx(tx *txs.ExportTx) error {
@@ -92,7 +94,7 @@ func (s *visitor) ExportTx(tx *txs.ExportTx) error {
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, txCreds, txSigners)
+	return s.signTypedData(txCreds, txSigners)
 }
 
 func (s *visitor) getSigners(ctx context.Context, sourceChainID ids.ID, ins []*avax.TransferableInput) ([]verify.Verifiable, [][]keychain.Signer, error) {
@@ -218,7 +220,37 @@ func (s *visitor) getOpsSigners(ctx context.Context, sourceChainID ids.ID, ops [
 	return txCreds, txSigners, nil
 }
 
+// signTypedData signs the EIP-712 typed data of [s.tx] with [txSigners] if the
+// signer was created with NewTypedData, or signs [s.tx] as [sign] otherwise.
+func (s *visitor) signTypedData(creds []verify.Verifiable, txSigners [][]keychain.Signer) error {
+	if !s.typedData {
+		return sign(s.tx, creds, txSigners)
+	}
+
+	typedData, err := builder.TypedData(s.tx.Unsigned)
+	if err != nil {
+		return err
+	}
+	typedDataHash, err := eip712.Hash(typedData)
+	if err != nil {
+		return fmt.Errorf("couldn't hash typed data: %w", err)
+	}
+	return signWith(s.tx, creds, txSigners, func(signer keychain.Signer, _ []byte) ([]byte, error) {
+		return signer.SignHash(typedDataHash)
+	})
+}
+
 func sign(tx *txs.Tx, creds []verify.Verifiable, txSigners [][]keychain.Signer) error {
+	return signWith(tx, creds, txSigners, keychain.Signer.Sign)
+}
+
+// signWith adds the signatures of [tx] produced by [signFunc] for [txSigners].
+func signWith(
+	tx *txs.Tx,
+	creds []verify.Verifiable,
+	txSigners [][]keychain.Signer,
+	signFunc func(signer keychain.Signer, unsignedBytes []byte) ([]byte, error),
+) error {
 	codec := builder.Parser.Codec()
 	unsignedBytes, err := codec.Marshal(txs.CodecVersion, &tx.Unsigned)
 	if err != nil {
@@ -282,7 +314,7 @@ func sign(tx *txs.Tx, creds []verify.Verifiable, txSigners [][]keychain.Signer)
 				continue
 			}
 
-			sig, err := signer.Sign(unsignedBytes)
+			sig, err := signFunc(signer, unsignedBytes)
 			if err != nil {
 				return fmt.Errorf("problem signing tx: %w", err)
 			}
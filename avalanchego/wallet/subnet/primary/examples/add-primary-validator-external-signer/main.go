@@ -0,0 +1,97 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/external"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+)
+
+func main() {
+	signerURI := "http://localhost:8550" // default HTTP endpoint of Clef
+	signerAddr := common.HexToAddress("0xb3d82b1367d362de99ab59a658165aff520cbd4d")
+	uri := primary.LocalAPIURI
+	startTime := time.Now().Add(time.Minute)
+	duration := 3 * 7 * 24 * time.Hour // 3 weeks
+	weight := 2_000 * units.Avax
+	delegationFee := uint32(reward.PercentDenominator / 2) // 50%
+
+	ctx := context.Background()
+	infoClient := info.NewClient(uri)
+
+	// NewKeychain asks the external signer to sign a message with the account,
+	// to recover its P-chain address. Every tx is then signed by the external
+	// signer, which may ask for approval.
+	kcStartTime := time.Now()
+	kc, err := external.NewKeychain(ctx, external.NewClient(signerURI), signerAddr)
+	if err != nil {
+		log.Fatalf("failed to initialize keychain: %s\n", err)
+	}
+	pAddr := kc.Addresses().List()[0]
+	log.Printf("linked %s to %s in %s\n", signerAddr, pAddr, time.Since(kcStartTime))
+
+	validatorRewardAddr := pAddr
+	delegatorRewardAddr := pAddr
+
+	nodeInfoStartTime := time.Now()
+	nodeID, nodePOP, err := infoClient.GetNodeID(ctx)
+	if err != nil {
+		log.Fatalf("failed to fetch node IDs: %s\n", err)
+	}
+	log.Printf("fetched node ID %s in %s\n", nodeID, time.Since(nodeInfoStartTime))
+
+	// MakePWallet fetches the available UTXOs owned by [kc] on the P-chain that
+	// [uri] is hosting.
+	walletSyncStartTime := time.Now()
+	wallet, err := primary.MakePWallet(
+		ctx,
+		uri,
+		kc,
+		primary.WalletConfig{},
+	)
+	if err != nil {
+		log.Fatalf("failed to initialize wallet: %s\n", err)
+	}
+	log.Printf("synced wallet in %s\n", time.Since(walletSyncStartTime))
+
+	// Get the chain context
+	context := wallet.Builder().Context()
+
+	addValidatorStartTime := time.Now()
+	addValidatorTx, err := wallet.IssueAddPermissionlessValidatorTx(
+		&txs.SubnetValidator{Validator: txs.Validator{
+			NodeID: nodeID,
+			Start:  uint64(startTime.Unix()),
+			End:    uint64(startTime.Add(duration).Unix()),
+			Wght:   weight,
+		}},
+		nodePOP,
+		context.AVAXAssetID,
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{validatorRewardAddr},
+		},
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{delegatorRewardAddr},
+		},
+		delegationFee,
+	)
+	if err != nil {
+		log.Fatalf("failed to issue add permissionless validator transaction: %s\n", err)
+	}
+	log.Printf("added new primary network validator %s with %s in %s\n", nodeID, addValidatorTx.ID(), time.Since(addValidatorStartTime))
+}
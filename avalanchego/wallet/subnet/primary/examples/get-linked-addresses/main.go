@@ -0,0 +1,42 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ava-labs/avalanchego/utils/crypto/external"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+)
+
+func main() {
+	signerURI := "http://localhost:8550" // default HTTP endpoint of Clef
+	signerAddr := common.HexToAddress("0xb3d82b1367d362de99ab59a658165aff520cbd4d")
+	uri := primary.LocalAPIURI
+	message := "Link the addresses of " + signerAddr.Hex()
+
+	ctx := context.Background()
+
+	// The external signer signs the message with the standard Ethereum prefix,
+	// as done by personal_sign in browser wallets.
+	signature, err := external.NewClient(signerURI).SignText(ctx, signerAddr, []byte(message))
+	if err != nil {
+		log.Fatalf("failed to sign message: %s\n", err)
+	}
+
+	fetchStartTime := time.Now()
+	linked, err := primary.FetchLinkedAddresses(ctx, uri, message, signature)
+	if err != nil {
+		log.Fatalf("failed to fetch linked addresses: %s\n", err)
+	}
+	log.Printf("fetched linked addresses of %s in %s\n", signerAddr, time.Since(fetchStartTime))
+
+	log.Printf("P-chain: %s holds %d nAVAX\n", linked.PChainAddress, linked.PChainBalance)
+	log.Printf("X-chain: %s holds %d nAVAX\n", linked.XChainAddress, linked.XChainBalance)
+	log.Printf("C-chain: %s holds %s wei\n", linked.CChainAddress, linked.CChainBalance)
+}
@@ -0,0 +1,138 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/coreth/ethclient"
+
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/wallet/chain/x"
+	"github.com/ava-labs/avalanchego/wallet/chain/x/builder"
+
+	walletcommon "github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// LinkedAddresses are the addresses of a key on the P-, X- and C-chain, and
+// its balances of the native asset on each chain.
+type LinkedAddresses struct {
+	PChainAddress string
+	XChainAddress string
+	CChainAddress ethcommon.Address
+	// PChainBalance and XChainBalance are in nAVAX, CChainBalance is in wei
+	PChainBalance uint64
+	XChainBalance uint64
+	CChainBalance *big.Int
+}
+
+// FetchLinkedAddresses returns the addresses and the balances of the key that
+// signed [message] with the standard Ethereum prefix (EIP-191), as done by
+// personal_sign. This allows the P-chain and X-chain addresses of an Ethereum
+// account to be found without exporting its private key.
+//
+// The addresses and the P-chain balance are returned by the node at [uri],
+// which recovers the key from [signature]. The balances on the X-chain and
+// C-chain are then fetched from the same node.
+func FetchLinkedAddresses(
+	ctx context.Context,
+	uri string,
+	message string,
+	signature []byte,
+) (*LinkedAddresses, error) {
+	pClient := platformvm.NewClient(uri)
+	reply, err := pClient.GetLinkedAddresses(ctx, message, signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get linked addresses: %w", err)
+	}
+
+	xBalance, err := fetchXChainBalance(ctx, uri, reply.XChainAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch X-chain balance: %w", err)
+	}
+
+	cClient, err := ethclient.Dial(fmt.Sprintf(
+		"%s/ext/%s/C/rpc",
+		uri,
+		constants.ChainAliasPrefix,
+	))
+	if err != nil {
+		return nil, err
+	}
+	defer cClient.Close()
+
+	cBalance, err := cClient.BalanceAt(ctx, reply.CChainAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch C-chain balance: %w", err)
+	}
+
+	return &LinkedAddresses{
+		PChainAddress: reply.PChainAddress,
+		XChainAddress: reply.XChainAddress,
+		CChainAddress: reply.CChainAddress,
+		PChainBalance: uint64(reply.PChainBalance.Balance),
+		XChainBalance: xBalance,
+		CChainBalance: cBalance,
+	}, nil
+}
+
+// fetchXChainBalance returns the amount of the native asset held by [addrStr]
+// in the UTXOs of the X-chain, including the time-locked UTXOs. The UTXOs
+// exported to the X-chain but not imported yet are not included.
+func fetchXChainBalance(ctx context.Context, uri string, addrStr string) (uint64, error) {
+	addr, err := address.ParseToID(addrStr)
+	if err != nil {
+		return 0, err
+	}
+
+	xClient := avm.NewClient(uri, builder.Alias)
+	xCTX, err := x.NewContextFromClients(ctx, info.NewClient(uri), xClient)
+	if err != nil {
+		return 0, err
+	}
+
+	utxos := walletcommon.NewUTXOs()
+	err = AddAllUTXOs(
+		ctx,
+		utxos,
+		xClient,
+		builder.Parser.Codec(),
+		xCTX.BlockchainID,
+		xCTX.BlockchainID,
+		[]ids.ShortID{addr},
+	)
+	if err != nil {
+		return 0, err
+	}
+	xUTXOs, err := utxos.UTXOs(ctx, xCTX.BlockchainID, xCTX.BlockchainID)
+	if err != nil {
+		return 0, err
+	}
+
+	var balance uint64
+	for _, utxo := range xUTXOs {
+		if utxo.AssetID() != xCTX.AVAXAssetID {
+			continue
+		}
+		out, ok := utxo.Out.(avax.Amounter)
+		if !ok {
+			continue
+		}
+		balance, err = math.Add(balance, out.Amount())
+		if err != nil {
+			return 0, err
+		}
+	}
+	return balance, nil
+}
@@ -66,6 +66,10 @@ type WalletConfig struct {
 	// Validation IDs that the wallet should know about to be able to generate
 	// transactions.
 	ValidationIDs []ids.ID // optional
+	// If true, the P-chain and X-chain txs that support it are signed as
+	// EIP-712 typed data rather than by their hash. Such txs are only valid
+	// once typed data signatures are activated on the network.
+	TypedDataSignatures bool // optional
 }
 
 // MakeWallet returns a wallet that supports issuing transactions to the chains
@@ -107,12 +111,18 @@ func MakeWallet(
 	pClient := p.NewClient(avaxState.PClient, pBackend)
 	pBuilder := pbuilder.New(avaxAddrs, avaxState.PCTX, pBackend)
 	pSigner := psigner.New(avaxKeychain, pBackend)
+	if config.TypedDataSignatures {
+		pSigner = psigner.NewTypedData(avaxKeychain, pBackend)
+	}
 
 	xChainID := avaxState.XCTX.BlockchainID
 	xUTXOs := common.NewChainUTXOs(xChainID, avaxState.UTXOs)
 	xBackend := x.NewBackend(avaxState.XCTX, xUTXOs)
 	xBuilder := xbuilder.New(avaxAddrs, avaxState.XCTX, xBackend)
 	xSigner := xsigner.New(avaxKeychain, xBackend)
+	if config.TypedDataSignatures {
+		xSigner = xsigner.NewTypedData(avaxKeychain, xBackend)
+	}
 
 	cChainID := avaxState.CCTX.BlockchainID
 	cUTXOs := common.NewChainUTXOs(cChainID, avaxState.UTXOs)
@@ -158,5 +168,8 @@ func MakePWallet(
 	pClient := p.NewClient(client, pBackend)
 	pBuilder := pbuilder.New(addrs, context, pBackend)
 	pSigner := psigner.New(keychain, pBackend)
+	if config.TypedDataSignatures {
+		pSigner = psigner.NewTypedData(keychain, pBackend)
+	}
 	return pwallet.New(pClient, pBuilder, pSigner), nil
 }
@@ -259,6 +259,7 @@ type BlockChain struct {
 	logsAcceptedFeed  event.Feed
 	blockProcFeed     event.Feed
 	txAcceptedFeed    event.Feed
+	daemonResultsFeed event.Feed
 	scope             event.SubscriptionScope
 	genesisBlock      *types.Block
 
@@ -580,6 +581,9 @@ func (bc *BlockChain) startAcceptor() {
 		if len(next.Transactions()) != 0 {
 			bc.txAcceptedFeed.Send(NewTxsEvent{next.Transactions()})
 		}
+		if daemonResults := rawdb.ReadDaemonResults(bc.db, next.Hash(), next.NumberU64()); len(daemonResults) > 0 {
+			bc.daemonResultsFeed.Send(DaemonResultsEvent{Block: next, Results: daemonResults})
+		}
 
 		bc.acceptorWg.Done()
 
@@ -1126,8 +1130,8 @@ func (bc *BlockChain) newTip(block *types.Block) bool {
 // canonical chain.
 // writeBlockAndSetHead expects to be the last verification step during InsertBlock
 // since it creates a reference that will only be cleaned up by Accept/Reject.
-func (bc *BlockChain) writeBlockAndSetHead(block *types.Block, receipts []*types.Receipt, logs []*types.Log, state *state.StateDB) error {
-	if err := bc.writeBlockWithState(block, receipts, state); err != nil {
+func (bc *BlockChain) writeBlockAndSetHead(block *types.Block, receipts []*types.Receipt, logs []*types.Log, daemonResults types.DaemonResults, state *state.StateDB) error {
+	if err := bc.writeBlockWithState(block, receipts, daemonResults, state); err != nil {
 		return err
 	}
 
@@ -1144,7 +1148,7 @@ func (bc *BlockChain) writeBlockAndSetHead(block *types.Block, receipts []*types
 
 // writeBlockWithState writes the block and all associated state to the database,
 // but it expects the chain mutex to be held.
-func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.Receipt, state *state.StateDB) error {
+func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.Receipt, daemonResults types.DaemonResults, state *state.StateDB) error {
 	// Irrelevant of the canonical status, write the block itself to the database.
 	//
 	// Note all the components of block(hash->number map, header, body, receipts)
@@ -1152,6 +1156,12 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 	blockBatch := bc.db.NewBatch()
 	rawdb.WriteBlock(blockBatch, block)
 	rawdb.WriteReceipts(blockBatch, block.Hash(), block.NumberU64(), receipts)
+	if len(daemonResults) > 0 {
+		rawdb.WriteDaemonResults(blockBatch, block.Hash(), block.NumberU64(), daemonResults)
+	}
+	if prioritisedFees := types.Receipts(receipts).PrioritisedFees(); len(prioritisedFees) > 0 {
+		rawdb.WritePrioritisedFees(blockBatch, block.Hash(), block.NumberU64(), prioritisedFees)
+	}
 	rawdb.WritePreimages(blockBatch, state.Preimages())
 	if err := blockBatch.Write(); err != nil {
 		log.Crit("Failed to write block into disk", "err", err)
@@ -1311,7 +1321,7 @@ func (bc *BlockChain) insertBlock(block *types.Block, writes bool) error {
 
 	// Process block using the parent state as reference point
 	pstart := time.Now()
-	receipts, logs, usedGas, err := bc.processor.Process(block, parent, statedb, bc.vmConfig)
+	receipts, logs, daemonResults, usedGas, err := bc.processor.Process(block, parent, statedb, bc.vmConfig)
 	if serr := statedb.Error(); serr != nil {
 		log.Error("statedb error encountered", "err", serr, "number", block.Number(), "hash", block.Hash())
 	}
@@ -1358,7 +1368,7 @@ func (bc *BlockChain) insertBlock(block *types.Block, writes bool) error {
 	// will be cleaned up in Accept/Reject so we need to ensure an error cannot occur
 	// later in verification, since that would cause the referenced root to never be dereferenced.
 	wstart := time.Now()
-	if err := bc.writeBlockAndSetHead(block, receipts, logs, statedb); err != nil {
+	if err := bc.writeBlockAndSetHead(block, receipts, logs, daemonResults, statedb); err != nil {
 		return err
 	}
 	// Update the metrics touched during block commit
@@ -1669,7 +1679,7 @@ func (bc *BlockChain) reprocessBlock(parent *types.Block, current *types.Block)
 	defer statedb.StopPrefetcher()
 
 	// Process previously stored block
-	receipts, _, usedGas, err := bc.processor.Process(current, parent.Header(), statedb, vm.Config{})
+	receipts, _, _, usedGas, err := bc.processor.Process(current, parent.Header(), statedb, vm.Config{})
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("failed to re-process block (%s: %d): %v", current.Hash().Hex(), current.NumberU64(), err)
 	}
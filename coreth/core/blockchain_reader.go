@@ -354,6 +354,18 @@ func (bc *BlockChain) SubscribeAcceptedTransactionEvent(ch chan<- NewTxsEvent) e
 	return bc.scope.Track(bc.txAcceptedFeed.Subscribe(ch))
 }
 
+// SubscribeAcceptedDaemonResultsEvent registers a subscription of daemon results
+// recorded in accepted blocks.
+func (bc *BlockChain) SubscribeAcceptedDaemonResultsEvent(ch chan<- DaemonResultsEvent) event.Subscription {
+	return bc.scope.Track(bc.daemonResultsFeed.Subscribe(ch))
+}
+
+// GetDaemonResults retrieves the daemon results recorded while processing the
+// block with the given hash and number.
+func (bc *BlockChain) GetDaemonResults(hash common.Hash, number uint64) types.DaemonResults {
+	return rawdb.ReadDaemonResults(bc.db, hash, number)
+}
+
 // GetLogs fetches all logs from a given block.
 func (bc *BlockChain) GetLogs(hash common.Hash, number uint64) [][]*types.Log {
 	logs, ok := bc.acceptedLogsCache.Get(hash) // this cache is thread-safe
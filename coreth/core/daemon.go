@@ -4,17 +4,17 @@
 package core
 
 import (
-	"bytes"
+	"errors"
 	"fmt"
-	"math"
 	"math/big"
-	"os"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/holiman/uint256"
 
+	"github.com/ava-labs/coreth/core/types"
 	"github.com/ava-labs/coreth/core/vm"
 	"github.com/ava-labs/coreth/params"
 	"github.com/ava-labs/coreth/utils"
@@ -28,14 +28,19 @@ var (
 	submitterContractActivationTimeSongbird = uint64(time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC).Unix())
 	submitterContractActivationTimeCoston   = uint64(time.Date(2024, time.February, 29, 12, 0, 0, 0, time.UTC).Unix())
 
+	// Define activation times for data prefix checks
+	dataPrefixActivationTimeFlare    = uint64(time.Date(2024, time.October, 10, 15, 0, 0, 0, time.UTC).Unix())
+	dataPrefixActivationTimeCostwo   = uint64(time.Date(2024, time.October, 10, 10, 0, 0, 0, time.UTC).Unix())
+	dataPrefixActivationTimeSongbird = uint64(time.Date(2024, time.October, 10, 13, 0, 0, 0, time.UTC).Unix())
+	dataPrefixActivationTimeCoston   = uint64(time.Date(2024, time.October, 10, 8, 0, 0, 0, time.UTC).Unix())
+
 	// Define ftso and submitter contract addresses
 	prioritisedFTSOContractAddress = common.HexToAddress("0x1000000000000000000000000000000000000003")
 
-	prioritisedSubmitterContractAddress    = common.HexToAddress("0x2cA6571Daa15ce734Bbd0Bf27D5C9D16787fc33f") // for flare, costwo, songbird and coston
-	prioritisedSubmitterContractAddressEnv = common.HexToAddress(os.Getenv("SUBMITTER_CONTRACT_ADDRESS"))      // for local and staging chains
+	prioritisedSubmitterContractAddress = common.HexToAddress("0x2cA6571Daa15ce734Bbd0Bf27D5C9D16787fc33f") // for flare, costwo, songbird and coston
 
 	// Define data prefixes for submitter and prioritized ftso contracts
-	submitterDataPrefixes = [][4]byte{
+	submitterDataPrefixes = []hexutil.Bytes{
 		{0x6c, 0x53, 0x2f, 0xae},
 		{0x9d, 0x00, 0xc9, 0xfd},
 		{0xe1, 0xb1, 0x57, 0xe7},
@@ -43,12 +48,12 @@ var (
 		{0x83, 0x3b, 0xf6, 0xc0},
 	}
 
-	prioritisedFTSOContractDataPrefixesFlareNetworks = [][4]byte{
+	prioritisedFTSOContractDataPrefixesFlareNetworks = []hexutil.Bytes{
 		{0x8f, 0xc6, 0xf6, 0x67},
 		{0xe2, 0xdb, 0x5a, 0x52},
 	}
 
-	prioritisedFTSOContractDataPrefixesSongbirdNetworks = [][4]byte{
+	prioritisedFTSOContractDataPrefixesSongbirdNetworks = []hexutil.Bytes{
 		{0xc5, 0xad, 0xc5, 0x39},
 		{0x60, 0x84, 0x8b, 0x44},
 	}
@@ -56,69 +61,90 @@ var (
 
 const (
 	prioritisedCallDataCap = 4500 // 4500 bytes
-)
 
-type prioritisedParams struct {
-	submitterActivationTime  uint64
-	submitterAddress         common.Address
-	maxGasLimit              uint64
-	dataPrefixActivationTime uint64
-	submitterDataPrefixes    [][4]byte
-	ftsoDataPrefixes         [][4]byte
-}
+	prioritisedMaxGasFlareNetworks = 3000000
+)
 
+// Default prioritised contracts per chain, local chains have none unless
+// specified in the node or upgrade config.
 var (
-	prioritisedContractVariants = utils.NewChainValue(&prioritisedParams{
-		0, common.Address{}, 0, 0, [][4]byte{}, [][4]byte{},
-	}).
-		AddValue(params.FlareChainID, &prioritisedParams{
+	prioritisedContractVariants = utils.NewChainValue([]params.PrioritisedContractsUpgrade{}).
+		AddValue(params.FlareChainID, defaultPrioritisedContracts(
 			submitterContractActivationTimeFlare,
-			prioritisedSubmitterContractAddress,
-			3000000,
-			uint64(time.Date(2024, time.October, 10, 15, 0, 0, 0, time.UTC).Unix()),
-			submitterDataPrefixes,
+			dataPrefixActivationTimeFlare,
+			prioritisedMaxGasFlareNetworks,
 			prioritisedFTSOContractDataPrefixesFlareNetworks,
-		}).
-		AddValue(params.CostwoChainID, &prioritisedParams{
+		)).
+		AddValue(params.CostwoChainID, defaultPrioritisedContracts(
 			submitterContractActivationTimeCostwo,
-			prioritisedSubmitterContractAddress,
-			3000000,
-			uint64(time.Date(2024, time.October, 10, 10, 0, 0, 0, time.UTC).Unix()),
-			submitterDataPrefixes,
+			dataPrefixActivationTimeCostwo,
+			prioritisedMaxGasFlareNetworks,
 			prioritisedFTSOContractDataPrefixesFlareNetworks,
-		}).
-		AddValue(params.SongbirdChainID, &prioritisedParams{
+		)).
+		AddValue(params.SongbirdChainID, defaultPrioritisedContracts(
 			submitterContractActivationTimeSongbird,
-			prioritisedSubmitterContractAddress,
-			math.MaxUint64,
-			uint64(time.Date(2024, time.October, 10, 13, 0, 0, 0, time.UTC).Unix()),
-			submitterDataPrefixes,
+			dataPrefixActivationTimeSongbird,
+			0,
 			prioritisedFTSOContractDataPrefixesSongbirdNetworks,
-		}).
-		AddValue(params.CostonChainID, &prioritisedParams{
+		)).
+		AddValue(params.CostonChainID, defaultPrioritisedContracts(
 			submitterContractActivationTimeCoston,
-			prioritisedSubmitterContractAddress,
-			math.MaxUint64,
-			uint64(time.Date(2024, time.October, 10, 8, 0, 0, 0, time.UTC).Unix()),
-			submitterDataPrefixes,
-			prioritisedFTSOContractDataPrefixesSongbirdNetworks,
-		}).
-		AddValue(params.LocalFlareChainID, &prioritisedParams{
-			0,
-			prioritisedSubmitterContractAddressEnv,
-			3000000,
+			dataPrefixActivationTimeCoston,
 			0,
-			[][4]byte{},
-			[][4]byte{},
-		}).
-		AddValue(params.LocalChainID, &prioritisedParams{
-			0,
-			prioritisedSubmitterContractAddressEnv,
-			math.MaxUint64,
-			0,
-			[][4]byte{},
-			[][4]byte{},
-		})
+			prioritisedFTSOContractDataPrefixesSongbirdNetworks,
+		))
+)
+
+// Gas cap of the prioritised calls to the submitter contract of local chains
+var localSubmitterMaxGasVariants = utils.NewChainValue(uint64(0)).AddValue(params.LocalFlareChainID, prioritisedMaxGasFlareNetworks)
+
+// defaultPrioritisedContracts returns the prioritised contracts schedule of the
+// public networks. The FTSO contract is always prioritised, the submitter contract
+// after [submitterActivationTime], and both are restricted to known selectors
+// after [dataPrefixActivationTime]. Activation times are exclusive.
+func defaultPrioritisedContracts(submitterActivationTime uint64, dataPrefixActivationTime uint64, maxGas uint64, ftsoDataPrefixes []hexutil.Bytes) []params.PrioritisedContractsUpgrade {
+	ftso := params.PrioritisedContract{
+		Address: prioritisedFTSOContractAddress,
+		MaxGas:  maxGas,
+	}
+	submitter := params.PrioritisedContract{
+		Address:            prioritisedSubmitterContractAddress,
+		MaxGas:             maxGas,
+		RequireReturnValue: true,
+	}
+	ftsoWithPrefixes := ftso
+	ftsoWithPrefixes.Selectors = ftsoDataPrefixes
+	submitterWithPrefixes := submitter
+	submitterWithPrefixes.Selectors = submitterDataPrefixes
+	submitterWithPrefixes.CallDataCap = prioritisedCallDataCap
+
+	return []params.PrioritisedContractsUpgrade{
+		{Timestamp: 0, Contracts: []params.PrioritisedContract{ftso}},
+		{Timestamp: submitterActivationTime + 1, Contracts: []params.PrioritisedContract{ftso, submitter}},
+		{Timestamp: dataPrefixActivationTime + 1, Contracts: []params.PrioritisedContract{ftsoWithPrefixes, submitterWithPrefixes}},
+	}
+}
+
+// Error classes recorded in the daemon results
+const (
+	DaemonErrorCallFailed      = "call-failed"
+	DaemonErrorInvalidData     = "invalid-data"
+	DaemonErrorDataEmpty       = "data-empty"
+	DaemonErrorMaxMintExceeded = "max-mint-exceeded"
+)
+
+// ErrNotPrioritisedContract is returned when the callee is not a prioritised
+// contract at the time of the call.
+var ErrNotPrioritisedContract = errors.New("callee is not a prioritised contract")
+
+// Reasons a call is charged the full fee instead of the nominal fee
+const (
+	PrioritisedReasonNotPrioritisedContract = "not-prioritised-contract"
+	PrioritisedReasonExecutionFailed        = "execution-failed"
+	PrioritisedReasonGasCapExceeded         = "gas-cap-exceeded"
+	PrioritisedReasonCallDataCapExceeded    = "calldata-cap-exceeded"
+	PrioritisedReasonSelectorNotAllowed     = "selector-not-allowed"
+	PrioritisedReasonZeroReturnValue        = "zero-return-value"
 )
 
 // Define errors
@@ -149,74 +175,60 @@ type EVMCaller interface {
 	AddBalance(addr common.Address, amount *uint256.Int)
 }
 
-func GetDaemonGasMultiplier(blockTime uint64) uint64 {
-	switch {
-	default:
-		return 100
+// GetPrioritisedContracts returns the prioritised contracts active at [blockTime].
+// The submitter contract of the node config and the contracts specified in the
+// upgrade config are added to the default registry of the network, replacing
+// the default contracts with the same address.
+func GetPrioritisedContracts(config *params.ChainConfig, blockTime uint64) []params.PrioritisedContract {
+	contracts := params.PrioritisedContractsAt(prioritisedContractVariants.GetValue(config.ChainID), blockTime)
+	if config.PrioritisedSubmitterAddress != (common.Address{}) {
+		contracts = params.MergePrioritisedContracts(contracts, []params.PrioritisedContract{{
+			Address:            config.PrioritisedSubmitterAddress,
+			MaxGas:             localSubmitterMaxGasVariants.GetValue(config.ChainID),
+			RequireReturnValue: true,
+		}})
 	}
+	return params.MergePrioritisedContracts(contracts, params.PrioritisedContractsAt(config.PrioritisedContracts, blockTime))
 }
 
-func GetDaemonContractAddr(blockTime uint64) string {
-	switch {
-	default:
-		return "0x1000000000000000000000000000000000000002"
-	}
-}
-
-func GetDaemonSelector(blockTime uint64) []byte {
-	switch {
-	default:
-		return []byte{0x7f, 0xec, 0x8d, 0x38}
-	}
+// IsPrioritisedContractCall returns true if a call to [to] is only charged the
+// nominal fee at [blockTime].
+func IsPrioritisedContractCall(config *params.ChainConfig, blockTime uint64, to *common.Address, data []byte, ret []byte, initialGas uint64) bool {
+	return CheckPrioritisedContractCall(config, blockTime, to, data, ret, initialGas) == nil
 }
 
-func IsPrioritisedContractCall(chainID *big.Int, blockTime uint64, to *common.Address, data []byte, ret []byte, initialGas uint64) bool {
-	if to == nil || chainID == nil {
-		return false
+// CheckPrioritisedContractCall returns the reason a call to [to] at [blockTime]
+// is charged the full fee, or nil if the call is prioritised.
+func CheckPrioritisedContractCall(config *params.ChainConfig, blockTime uint64, to *common.Address, data []byte, ret []byte, initialGas uint64) error {
+	if to == nil || config == nil || config.ChainID == nil {
+		return ErrNotPrioritisedContract
 	}
 
-	chainValue := prioritisedContractVariants.GetValue(chainID)
-
-	switch {
-	case initialGas > chainValue.maxGasLimit:
-		return false
-	case *to == prioritisedFTSOContractAddress:
-		if blockTime > chainValue.dataPrefixActivationTime {
-			return checkDataPrefix(data, chainValue.ftsoDataPrefixes)
+	for _, contract := range GetPrioritisedContracts(config, blockTime) {
+		if contract.Address == *to {
+			return contract.CheckPrioritisedCall(data, ret, initialGas)
 		}
-		return true
-	case *to == chainValue.submitterAddress && blockTime > chainValue.submitterActivationTime && !isZeroSlice(ret):
-		if blockTime > chainValue.dataPrefixActivationTime {
-			return len(data) <= prioritisedCallDataCap && checkDataPrefix(data, chainValue.submitterDataPrefixes)
-		}
-		return true
-	default:
-		return false
-	}
-}
-
-func GetMaximumMintRequest(chainID *big.Int, blockTime uint64) *uint256.Int {
-	maxRequest := new(uint256.Int)
-	switch {
-	case chainID.Cmp(params.FlareChainID) == 0 || chainID.Cmp(params.CostwoChainID) == 0 || chainID.Cmp(params.LocalFlareChainID) == 0:
-		maxRequest.SetFromDecimal("60000000000000000000000000") // we ignore error, the number fits into uint256
-	default: // Songbird, Coston
-		maxRequest.SetFromDecimal("50000000000000000000000000") // we ignore error, the number fits into uint256
 	}
-	return maxRequest
+	return ErrNotPrioritisedContract
 }
 
-func daemon(evm EVMCaller) (int, *uint256.Int, error) {
+func daemon(evm EVMCaller) (int, *uint256.Int, uint64, error) {
 	bigZero := uint256.NewInt(0)
 	// Get the contract to call
-	daemonContract := common.HexToAddress(GetDaemonContractAddr(evm.GetBlockTime()))
+	daemonParams := params.GetDaemonParams(evm.GetChainID(), evm.GetBlockTime())
+	daemonContract := daemonParams.ContractAddress
+	daemonGas := daemonParams.GasMultiplier * evm.GetGasLimit()
 
 	// Call the method
-	daemonSnapshot, daemonRet, _, daemonErr := evm.DaemonCall(
+	daemonSnapshot, daemonRet, daemonLeftOverGas, daemonErr := evm.DaemonCall(
 		vm.AccountRef(daemonContract),
 		daemonContract,
-		GetDaemonSelector(evm.GetBlockTime()),
-		GetDaemonGasMultiplier(evm.GetBlockTime())*evm.GetGasLimit())
+		daemonParams.Selector[:],
+		daemonGas)
+	var daemonGasUsed uint64
+	if daemonGas > daemonLeftOverGas {
+		daemonGasUsed = daemonGas - daemonLeftOverGas
+	}
 	// If no error and a value came back...
 	if daemonErr == nil && daemonRet != nil {
 		// Did we get one big int?
@@ -225,27 +237,28 @@ func daemon(evm EVMCaller) (int, *uint256.Int, error) {
 			// Mint request cannot be less than 0 as SetBytes treats value as unsigned
 			mintRequest := new(uint256.Int).SetBytes32(daemonRet)
 			// return the mint request
-			return daemonSnapshot, mintRequest, nil
+			return daemonSnapshot, mintRequest, daemonGasUsed, nil
 		} else {
 			// Returned length was not 32 bytes
-			return 0, bigZero, &ErrInvalidDaemonData{}
+			return 0, bigZero, daemonGasUsed, &ErrInvalidDaemonData{}
 		}
 	} else {
 		if daemonErr != nil {
-			return 0, bigZero, daemonErr
+			return 0, bigZero, daemonGasUsed, daemonErr
 		} else {
-			return 0, bigZero, &ErrDaemonDataEmpty{}
+			return 0, bigZero, daemonGasUsed, &ErrDaemonDataEmpty{}
 		}
 	}
 }
 
 func mint(evm EVMCaller, mintRequest *uint256.Int) error {
 	// If the mint request is greater than zero and less than max
-	max := GetMaximumMintRequest(evm.GetChainID(), evm.GetBlockTime())
+	daemonParams := params.GetDaemonParams(evm.GetChainID(), evm.GetBlockTime())
+	max := daemonParams.MaxMintRequest
 	if mintRequest.Cmp(uint256.NewInt(0)) > 0 &&
 		mintRequest.Cmp(max) <= 0 {
 		// Mint the amount asked for on to the daemon contract
-		evm.AddBalance(common.HexToAddress(GetDaemonContractAddr(evm.GetBlockTime())), mintRequest)
+		evm.AddBalance(daemonParams.ContractAddress, mintRequest)
 	} else if mintRequest.Cmp(max) > 0 {
 		// Return error
 		return &ErrMaxMintExceeded{
@@ -256,9 +269,18 @@ func mint(evm EVMCaller, mintRequest *uint256.Int) error {
 	return nil
 }
 
-func atomicDaemonAndMint(evm EVMCaller, log log.Logger) {
+// atomicDaemonAndMint calls the daemon and mints the requested amount, reverting
+// the daemon state transition if the mint fails. The outcome is returned so it
+// can be recorded with the block, TxHash and TxIndex are left for the caller.
+func atomicDaemonAndMint(evm EVMCaller, log log.Logger) *types.DaemonResult {
+	result := &types.DaemonResult{
+		MintRequest: new(big.Int),
+		Minted:      new(big.Int),
+	}
 	// Call the daemon
-	daemonSnapshot, mintRequest, daemonErr := daemon(evm)
+	daemonSnapshot, mintRequest, daemonGasUsed, daemonErr := daemon(evm)
+	result.GasUsed = daemonGasUsed
+	result.MintRequest = mintRequest.ToBig()
 	// If no error...
 	if daemonErr == nil {
 		// time to mint
@@ -266,30 +288,50 @@ func atomicDaemonAndMint(evm EVMCaller, log log.Logger) {
 			log.Warn("Error minting inflation request", "error", mintError)
 			// Revert to snapshot to unwind daemon state transition
 			evm.DaemonRevertToSnapshot(daemonSnapshot)
+			result.ErrorClass = DaemonErrorClass(mintError)
+			result.Error = mintError.Error()
+		} else {
+			result.Minted = mintRequest.ToBig()
 		}
 	} else {
 		log.Warn("Daemon error", "error", daemonErr)
+		result.ErrorClass = DaemonErrorClass(daemonErr)
+		result.Error = daemonErr.Error()
 	}
+	return result
 }
 
-func isZeroSlice(s []byte) bool {
-	for i := len(s) - 1; i >= 0; i-- {
-		if s[i] != 0 {
-			return false
-		}
+// PrioritisedReason classifies an error returned by CheckPrioritisedContractCall.
+func PrioritisedReason(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, params.ErrPrioritisedGasCapExceeded):
+		return PrioritisedReasonGasCapExceeded
+	case errors.Is(err, params.ErrPrioritisedCallDataCapExceeded):
+		return PrioritisedReasonCallDataCapExceeded
+	case errors.Is(err, params.ErrPrioritisedSelectorNotAllowed):
+		return PrioritisedReasonSelectorNotAllowed
+	case errors.Is(err, params.ErrPrioritisedZeroReturnValue):
+		return PrioritisedReasonZeroReturnValue
+	default:
+		return PrioritisedReasonNotPrioritisedContract
 	}
-	return true
 }
 
-func checkDataPrefix(data []byte, prefixes [][4]byte) bool {
-	if len(data) < 4 {
-		return false
-	}
-	dataPrefix := data[:4]
-	for _, prefix := range prefixes {
-		if bytes.Equal(dataPrefix, prefix[:]) {
-			return true
-		}
+// DaemonErrorClass classifies an error returned by the daemon or the mint that
+// follows it.
+func DaemonErrorClass(err error) string {
+	switch err.(type) {
+	case nil:
+		return ""
+	case *ErrInvalidDaemonData:
+		return DaemonErrorInvalidData
+	case *ErrDaemonDataEmpty:
+		return DaemonErrorDataEmpty
+	case *ErrMaxMintExceeded:
+		return DaemonErrorMaxMintExceeded
+	default:
+		return DaemonErrorCallFailed
 	}
-	return false
 }
@@ -4,6 +4,7 @@
 package core
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"math/big"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/holiman/uint256"
 
@@ -31,6 +33,9 @@ type MockEVMCallerData struct {
 	mintRequestReturn     uint256.Int
 	lastAddBalanceAddr    common.Address
 	lastAddBalanceAmount  *uint256.Int
+	lastCallAddr          common.Address
+	lastCallInput         []byte
+	lastCallGas           uint64
 }
 
 // Define a mock structure to spy and mock values for logger calls
@@ -41,6 +46,9 @@ type MockLoggerData struct {
 // Set up default mock method calls
 func defaultDaemonCall(e *MockEVMCallerData, caller vm.ContractRef, addr common.Address, input []byte, gas uint64) (snapshot int, ret []byte, leftOverGas uint64, err error) {
 	e.callCalls++
+	e.lastCallAddr = addr
+	e.lastCallInput = input
+	e.lastCallGas = gas
 
 	bytes := e.mintRequestReturn.Bytes32()
 	return 0, bytes[:], 0, nil
@@ -106,13 +114,35 @@ func TestDaemonShouldReturnMintRequest(t *testing.T) {
 		mockEVMCallerData: *mockEVMCallerData,
 	}
 
-	_, mintRequest, _ := daemon(defaultEVMMock)
+	_, mintRequest, _, _ := daemon(defaultEVMMock)
 
 	if mintRequest.Cmp(mintRequestReturn) != 0 {
 		t.Errorf("got %s want %q", mintRequest.String(), "60000000000000000000000000")
 	}
 }
 
+func TestDaemonUsesScheduledParams(t *testing.T) {
+	defaultEVMMock := &DefaultEVMMock{
+		mockEVMCallerData: MockEVMCallerData{
+			blockTime: 0,
+			gasLimit:  8000000,
+		},
+	}
+
+	daemon(defaultEVMMock)
+
+	want := params.GetDaemonParams(params.FlareChainID, 0)
+	if got := defaultEVMMock.mockEVMCallerData.lastCallAddr; got != want.ContractAddress {
+		t.Errorf("got addr %s want %s", got, want.ContractAddress)
+	}
+	if got := defaultEVMMock.mockEVMCallerData.lastCallInput; !bytes.Equal(got, want.Selector[:]) {
+		t.Errorf("got input %x want %x", got, want.Selector)
+	}
+	if got := defaultEVMMock.mockEVMCallerData.lastCallGas; got != want.GasMultiplier*8000000 {
+		t.Errorf("got gas %d want %d", got, want.GasMultiplier*8000000)
+	}
+}
+
 func TestDaemonShouldNotLetMintRequestOverflow(t *testing.T) {
 	var mintRequestReturn uint256.Int
 	// TODO: Compact with exponent?
@@ -128,7 +158,7 @@ func TestDaemonShouldNotLetMintRequestOverflow(t *testing.T) {
 		mockEVMCallerData: *mockEVMCallerData,
 	}
 
-	snapshot, mintRequest, mintRequestError := daemon(defaultEVMMock)
+	snapshot, mintRequest, _, mintRequestError := daemon(defaultEVMMock)
 
 	if mintRequestError != nil {
 		t.Errorf("received unexpected error %s", mintRequestError)
@@ -189,7 +219,7 @@ func TestDaemonValidatesMintRequestReturnValueSize(t *testing.T) {
 		mockEVMCallerData: *mockEVMCallerData,
 	}
 	// Call to return less than 32 bytes
-	_, _, err := daemon(badMintReturnSizeEVMMock)
+	_, _, _, err := daemon(badMintReturnSizeEVMMock)
 
 	if err != nil {
 		if err, ok := err.(*ErrInvalidDaemonData); !ok {
@@ -239,7 +269,7 @@ func TestDaemonReturnsCallError(t *testing.T) {
 		mockEVMCallerData: *mockEVMCallerData,
 	}
 	// Call to return less than 32 bytes
-	_, _, err := daemon(badDaemonCallEVMMock)
+	_, _, _, err := daemon(badDaemonCallEVMMock)
 
 	if err == nil {
 		t.Errorf("no error received")
@@ -341,7 +371,7 @@ func TestDaemonHandlesNilMintRequest(t *testing.T) {
 		mockEVMCallerData: *mockEVMCallerData,
 	}
 	// Call to return less than 32 bytes
-	_, _, err := daemon(returnNilMintRequestEVMMock)
+	_, _, _, err := daemon(returnNilMintRequestEVMMock)
 
 	if err != nil {
 		if err, ok := err.(*ErrDaemonDataEmpty); !ok {
@@ -371,7 +401,7 @@ func TestDaemonShouldNotMintMoreThanMax(t *testing.T) {
 		if err, ok := err.(*ErrMaxMintExceeded); !ok {
 			want := &ErrMaxMintExceeded{
 				mintRequest: mintRequest,
-				mintMax:     GetMaximumMintRequest(params.FlareChainID, 0),
+				mintMax:     params.GetDaemonParams(params.FlareChainID, 0).MaxMintRequest,
 			}
 			t.Errorf("got '%s' want '%s'", err.Error(), want.Error())
 		}
@@ -401,8 +431,9 @@ func TestDaemonShouldMint(t *testing.T) {
 		if defaultEVMMock.mockEVMCallerData.addBalanceCalls != 1 {
 			t.Errorf("AddBalance not called as expected")
 		}
-		if defaultEVMMock.mockEVMCallerData.lastAddBalanceAddr.String() != GetDaemonContractAddr(0) {
-			t.Errorf("wanted addr %s; got addr %s", GetDaemonContractAddr(0), defaultEVMMock.mockEVMCallerData.lastAddBalanceAddr)
+		daemon := params.GetDaemonParams(params.FlareChainID, 0).ContractAddress
+		if defaultEVMMock.mockEVMCallerData.lastAddBalanceAddr != daemon {
+			t.Errorf("wanted addr %s; got addr %s", daemon, defaultEVMMock.mockEVMCallerData.lastAddBalanceAddr)
 		}
 		if defaultEVMMock.mockEVMCallerData.lastAddBalanceAmount.Cmp(mintRequest) != 0 {
 			t.Errorf("wanted amount %s; got amount %s", mintRequest.String(), defaultEVMMock.mockEVMCallerData.lastAddBalanceAmount.String())
@@ -487,6 +518,63 @@ func TestDaemonShouldNotMintMoreThanLimit(t *testing.T) {
 	}
 }
 
+func TestAtomicDaemonAndMintRecordsResult(t *testing.T) {
+	mintRequestReturn := new(uint256.Int)
+	mintRequestReturn.SetFromDecimal("60000000000000000000000000")
+	defaultEVMMock := &DefaultEVMMock{
+		mockEVMCallerData: MockEVMCallerData{
+			mintRequestReturn: *mintRequestReturn,
+		},
+	}
+
+	result := atomicDaemonAndMint(defaultEVMMock, log.New())
+
+	if result.ErrorClass != "" {
+		t.Errorf("unexpected error class %s", result.ErrorClass)
+	}
+	if result.MintRequest.Cmp(mintRequestReturn.ToBig()) != 0 {
+		t.Errorf("wanted mint request %s; got %s", mintRequestReturn, result.MintRequest)
+	}
+	if result.Minted.Cmp(mintRequestReturn.ToBig()) != 0 {
+		t.Errorf("wanted minted %s; got %s", mintRequestReturn, result.Minted)
+	}
+}
+
+func TestAtomicDaemonAndMintRecordsMaxMintExceeded(t *testing.T) {
+	mintRequestReturn := new(uint256.Int)
+	mintRequestReturn.SetFromDecimal("60000000000000000000000001")
+	defaultEVMMock := &DefaultEVMMock{
+		mockEVMCallerData: MockEVMCallerData{
+			mintRequestReturn: *mintRequestReturn,
+		},
+	}
+
+	result := atomicDaemonAndMint(defaultEVMMock, log.New())
+
+	if result.ErrorClass != DaemonErrorMaxMintExceeded {
+		t.Errorf("wanted error class %s; got %s", DaemonErrorMaxMintExceeded, result.ErrorClass)
+	}
+	if result.MintRequest.Cmp(mintRequestReturn.ToBig()) != 0 {
+		t.Errorf("wanted mint request %s; got %s", mintRequestReturn, result.MintRequest)
+	}
+	if result.Minted.Sign() != 0 {
+		t.Errorf("wanted nothing minted; got %s", result.Minted)
+	}
+}
+
+func TestAtomicDaemonAndMintRecordsCallError(t *testing.T) {
+	badDaemonCallEVMMock := &BadDaemonCallEVMMock{}
+
+	result := atomicDaemonAndMint(badDaemonCallEVMMock, log.New())
+
+	if result.ErrorClass != DaemonErrorCallFailed {
+		t.Errorf("wanted error class %s; got %s", DaemonErrorCallFailed, result.ErrorClass)
+	}
+	if result.Error != "Call error happened" {
+		t.Errorf("did not get expected error; got %s", result.Error)
+	}
+}
+
 func TestPrioritisedContract(t *testing.T) {
 	address := common.HexToAddress("0x123456789aBCdEF123456789aBCdef123456789A")
 	preForkTime := uint64(time.Date(2024, time.March, 20, 12, 0, 0, 0, time.UTC).Unix())
@@ -497,38 +585,177 @@ func TestPrioritisedContract(t *testing.T) {
 	ret1 := [32]byte{}
 	ret1[31] = 1
 	data := []byte{0x01, 0x02, 0x03, 0x04, 0x05}
+	flareConfig := &params.ChainConfig{ChainID: params.FlareChainID}
 
-	if IsPrioritisedContractCall(params.FlareChainID, preForkTime, &address, data, nil, initialGas) {
+	if IsPrioritisedContractCall(flareConfig, preForkTime, &address, data, nil, initialGas) {
 		t.Errorf("Expected false for wrong address")
 	}
-	if !IsPrioritisedContractCall(params.FlareChainID, preForkTime, &prioritisedFTSOContractAddress, nil, nil, initialGas) {
+	if !IsPrioritisedContractCall(flareConfig, preForkTime, &prioritisedFTSOContractAddress, nil, nil, initialGas) {
 		t.Errorf("Expected true for FTSO contract")
 	}
-	if IsPrioritisedContractCall(params.FlareChainID, preForkTime, &prioritisedSubmitterContractAddress, data, ret1[:], initialGas) {
+	if IsPrioritisedContractCall(flareConfig, preForkTime, &prioritisedSubmitterContractAddress, data, ret1[:], initialGas) {
 		t.Errorf("Expected false for submitter contract before activation")
 	}
-	if !IsPrioritisedContractCall(params.FlareChainID, postForkTime, &prioritisedSubmitterContractAddress, data, ret1[:], initialGas) {
+	if !IsPrioritisedContractCall(flareConfig, postForkTime, &prioritisedSubmitterContractAddress, data, ret1[:], initialGas) {
 		t.Errorf("Expected true for submitter contract after activation")
 	}
-	if IsPrioritisedContractCall(params.FlareChainID, postForkTime, &prioritisedSubmitterContractAddress, data, ret0[:], initialGas) {
+	if IsPrioritisedContractCall(flareConfig, postForkTime, &prioritisedSubmitterContractAddress, data, ret0[:], initialGas) {
 		t.Errorf("Expected false for submitter contract with wrong return value")
 	}
-	if IsPrioritisedContractCall(params.FlareChainID, postForkTime, &prioritisedSubmitterContractAddress, data, nil, initialGas) {
+	if IsPrioritisedContractCall(flareConfig, postForkTime, &prioritisedSubmitterContractAddress, data, nil, initialGas) {
 		t.Errorf("Expected false for submitter contract with no return value")
 	}
-	if IsPrioritisedContractCall(params.FlareChainID, postPrefixForkTime, &prioritisedSubmitterContractAddress, data, ret1[:], initialGas) {
+	if IsPrioritisedContractCall(flareConfig, postPrefixForkTime, &prioritisedSubmitterContractAddress, data, ret1[:], initialGas) {
 		t.Errorf("Expected false for submitter contract after prefix activation with wrong data")
 	}
-	if !IsPrioritisedContractCall(params.FlareChainID, postPrefixForkTime, &prioritisedSubmitterContractAddress, []byte{0xe1, 0xb1, 0x57, 0xe7, 0x00, 0x00}, ret1[:], initialGas) {
+	if !IsPrioritisedContractCall(flareConfig, postPrefixForkTime, &prioritisedSubmitterContractAddress, []byte{0xe1, 0xb1, 0x57, 0xe7, 0x00, 0x00}, ret1[:], initialGas) {
 		t.Errorf("Expected true for submitter contract after prefix activation with correct data")
 	}
-	if IsPrioritisedContractCall(params.FlareChainID, postPrefixForkTime, &prioritisedSubmitterContractAddress, make([]byte, prioritisedCallDataCap+1), ret1[:], initialGas) {
+	if IsPrioritisedContractCall(flareConfig, postPrefixForkTime, &prioritisedSubmitterContractAddress, make([]byte, prioritisedCallDataCap+1), ret1[:], initialGas) {
 		t.Errorf("Expected false for submitter contract after prefix activation with too long data")
 	}
-	if IsPrioritisedContractCall(params.FlareChainID, postPrefixForkTime, &prioritisedFTSOContractAddress, data, nil, initialGas) {
+	if IsPrioritisedContractCall(flareConfig, postPrefixForkTime, &prioritisedFTSOContractAddress, data, nil, initialGas) {
 		t.Errorf("Expected false for FTSO contract after prefix activation with wrong data")
 	}
-	if !IsPrioritisedContractCall(params.FlareChainID, postPrefixForkTime, &prioritisedFTSOContractAddress, []byte{0x8f, 0xc6, 0xf6, 0x67, 0x05}, nil, initialGas) {
+	if !IsPrioritisedContractCall(flareConfig, postPrefixForkTime, &prioritisedFTSOContractAddress, []byte{0x8f, 0xc6, 0xf6, 0x67, 0x05}, nil, initialGas) {
 		t.Errorf("Expected true for FTSO contract after prefix activation with correct data")
 	}
 }
+
+func TestPrioritisedContractActivationBoundary(t *testing.T) {
+	flareConfig := &params.ChainConfig{ChainID: params.FlareChainID}
+	ret1 := [32]byte{}
+	ret1[31] = 1
+	data := []byte{0x01, 0x02, 0x03, 0x04, 0x05}
+
+	if IsPrioritisedContractCall(flareConfig, submitterContractActivationTimeFlare, &prioritisedSubmitterContractAddress, data, ret1[:], 0) {
+		t.Errorf("Expected false for submitter contract at activation time")
+	}
+	if !IsPrioritisedContractCall(flareConfig, submitterContractActivationTimeFlare+1, &prioritisedSubmitterContractAddress, data, ret1[:], 0) {
+		t.Errorf("Expected true for submitter contract after activation time")
+	}
+	if !IsPrioritisedContractCall(flareConfig, dataPrefixActivationTimeFlare, &prioritisedFTSOContractAddress, data, nil, 0) {
+		t.Errorf("Expected true for FTSO contract at prefix activation time with any data")
+	}
+	if IsPrioritisedContractCall(flareConfig, dataPrefixActivationTimeFlare+1, &prioritisedFTSOContractAddress, data, nil, 0) {
+		t.Errorf("Expected false for FTSO contract after prefix activation time with wrong data")
+	}
+	if IsPrioritisedContractCall(flareConfig, dataPrefixActivationTimeFlare, &prioritisedFTSOContractAddress, nil, nil, prioritisedMaxGasFlareNetworks+1) {
+		t.Errorf("Expected false for FTSO contract with gas over the limit")
+	}
+}
+
+func TestPrioritisedContractUpgradeConfig(t *testing.T) {
+	address := common.HexToAddress("0x123456789aBCdEF123456789aBCdef123456789A")
+	localConfig := &params.ChainConfig{
+		ChainID: params.LocalFlareChainID,
+		UpgradeConfig: params.UpgradeConfig{
+			PrioritisedContracts: []params.PrioritisedContractsUpgrade{
+				{
+					Timestamp: 100,
+					Contracts: []params.PrioritisedContract{{
+						Address:     address,
+						Selectors:   []hexutil.Bytes{{0x01, 0x02, 0x03, 0x04}},
+						MaxGas:      1000000,
+						CallDataCap: 8,
+					}},
+				},
+				{
+					Timestamp: 200,
+					Contracts: []params.PrioritisedContract{},
+				},
+			},
+		},
+	}
+	data := []byte{0x01, 0x02, 0x03, 0x04, 0x05}
+
+	if IsPrioritisedContractCall(&params.ChainConfig{ChainID: params.LocalFlareChainID}, 150, &address, data, nil, 0) {
+		t.Errorf("Expected false for local chain without upgrade config")
+	}
+	if IsPrioritisedContractCall(localConfig, 99, &address, data, nil, 0) {
+		t.Errorf("Expected false before the scheduled upgrade")
+	}
+	if !IsPrioritisedContractCall(localConfig, 100, &address, data, nil, 0) {
+		t.Errorf("Expected true after the scheduled upgrade")
+	}
+	if IsPrioritisedContractCall(localConfig, 150, &address, []byte{0x01, 0x02, 0x03, 0x05}, nil, 0) {
+		t.Errorf("Expected false for a selector that is not allowed")
+	}
+	if IsPrioritisedContractCall(localConfig, 150, &address, make([]byte, 9), nil, 0) {
+		t.Errorf("Expected false for calldata over the cap")
+	}
+	if IsPrioritisedContractCall(localConfig, 150, &address, data, nil, 1000001) {
+		t.Errorf("Expected false for gas over the limit")
+	}
+	if IsPrioritisedContractCall(localConfig, 200, &address, data, nil, 0) {
+		t.Errorf("Expected false after the contract was removed")
+	}
+}
+
+func TestPrioritisedContractLocalDefaults(t *testing.T) {
+	submitter := common.HexToAddress("0x123456789aBCdEF123456789aBCdef123456789A")
+	ret1 := [32]byte{}
+	ret1[31] = 1
+	data := []byte{0x01, 0x02, 0x03, 0x04, 0x05}
+	localFlareConfig := &params.ChainConfig{ChainID: params.LocalFlareChainID}
+	localConfig := &params.ChainConfig{ChainID: params.LocalChainID}
+
+	if IsPrioritisedContractCall(localFlareConfig, 150, &prioritisedFTSOContractAddress, data, nil, 0) {
+		t.Errorf("Expected false for FTSO contract on local flare chain")
+	}
+	if IsPrioritisedContractCall(localConfig, 150, &prioritisedFTSOContractAddress, data, nil, 0) {
+		t.Errorf("Expected false for FTSO contract on local chain")
+	}
+	if IsPrioritisedContractCall(localFlareConfig, 150, &submitter, data, ret1[:], 0) {
+		t.Errorf("Expected false for submitter contract without node config")
+	}
+
+	submitterConfig := &params.ChainConfig{
+		ChainID:                     params.LocalFlareChainID,
+		PrioritisedSubmitterAddress: submitter,
+	}
+	if !IsPrioritisedContractCall(submitterConfig, 150, &submitter, data, ret1[:], 0) {
+		t.Errorf("Expected true for submitter contract of the node config")
+	}
+	if IsPrioritisedContractCall(submitterConfig, 150, &submitter, data, nil, 0) {
+		t.Errorf("Expected false for submitter contract with no return value")
+	}
+	if IsPrioritisedContractCall(submitterConfig, 150, &submitter, data, ret1[:], prioritisedMaxGasFlareNetworks+1) {
+		t.Errorf("Expected false for submitter contract with gas over the limit")
+	}
+	if IsPrioritisedContractCall(submitterConfig, 150, &prioritisedFTSOContractAddress, data, nil, 0) {
+		t.Errorf("Expected false for FTSO contract with submitter contract of the node config")
+	}
+}
+
+func TestPrioritisedContractUpgradeConfigOverridesSubmitter(t *testing.T) {
+	submitter := common.HexToAddress("0x123456789aBCdEF123456789aBCdef123456789A")
+	ret1 := [32]byte{}
+	ret1[31] = 1
+	data := []byte{0x01, 0x02, 0x03, 0x04, 0x05}
+	localConfig := &params.ChainConfig{
+		ChainID:                     params.LocalFlareChainID,
+		PrioritisedSubmitterAddress: submitter,
+		UpgradeConfig: params.UpgradeConfig{
+			PrioritisedContracts: []params.PrioritisedContractsUpgrade{{
+				Timestamp: 100,
+				Contracts: []params.PrioritisedContract{
+					{Address: submitter, MaxGas: 1000},
+				},
+			}},
+		},
+	}
+
+	if IsPrioritisedContractCall(localConfig, 50, &submitter, data, nil, 0) {
+		t.Errorf("Expected false for submitter contract with no return value before the scheduled upgrade")
+	}
+	if !IsPrioritisedContractCall(localConfig, 50, &submitter, data, ret1[:], prioritisedMaxGasFlareNetworks) {
+		t.Errorf("Expected true for submitter contract before the scheduled upgrade")
+	}
+	if IsPrioritisedContractCall(localConfig, 100, &submitter, data, ret1[:], 1001) {
+		t.Errorf("Expected false for submitter contract with gas over the overridden limit")
+	}
+	if !IsPrioritisedContractCall(localConfig, 100, &submitter, data, nil, 1000) {
+		t.Errorf("Expected true for submitter contract without return value after the override")
+	}
+}
@@ -55,3 +55,9 @@ type ChainSideEvent struct {
 }
 
 type ChainHeadEvent struct{ Block *types.Block }
+
+// DaemonResultsEvent is posted when a block with daemon results is accepted.
+type DaemonResultsEvent struct {
+	Block   *types.Block
+	Results types.DaemonResults
+}
@@ -0,0 +1,191 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/holiman/uint256"
+
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/params"
+	"github.com/ava-labs/coreth/plugin/evm/upgrade/ap4"
+	"github.com/ava-labs/coreth/utils"
+)
+
+var (
+	errInvalidCoinbase = errors.New("invalid value for block.coinbase")
+
+	// systemCoinbase is the coinbase of blocks produced by validators, calls made
+	// with a different coinbase (e.g. eth_call) do not trigger the system hooks.
+	systemCoinbase = common.HexToAddress("0x0100000000000000000000000000000000000000")
+
+	defaultBurnAddress = common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+)
+
+// FlareExtensions is the network specific behaviour applied by the state
+// transition on top of the standard EVM execution.
+type FlareExtensions interface {
+	// BurnAddress returns the address credited with the transaction fees of a
+	// block with the given [coinbase], or an error if the coinbase is invalid.
+	BurnAddress(coinbase common.Address) (common.Address, error)
+
+	// NominalGasPrice returns the gas price charged for prioritised calls.
+	NominalGasPrice() uint64
+
+	// PostCall runs the system hooks after a successful call described by [msg].
+	PostCall(st *StateTransition, isDurango bool, msg *Message, ret []byte)
+
+	// Daemon invokes the daemon after a successful transaction, returning nil if
+	// the network does not have a daemon.
+	Daemon(evm EVMCaller, log log.Logger) *types.DaemonResult
+
+	// IsPrioritisedCall returns true if the call is only charged the nominal fee.
+	IsPrioritisedCall(config *params.ChainConfig, blockTime uint64, to *common.Address, data []byte, ret []byte, initialGas uint64) bool
+}
+
+var flareExtensionsVariants = utils.NewChainValue[FlareExtensions](&nonFlareExtensions{}).
+	AddValues([]*big.Int{params.FlareChainID, params.CostwoChainID, params.LocalFlareChainID}, &flareExtensions{}).
+	AddValues([]*big.Int{params.SongbirdChainID, params.CostonChainID, params.LocalChainID}, &songbirdExtensions{})
+
+// RegisterFlareExtensions sets the extensions used by the chain with [chainID].
+// It must be called before any chain is created.
+func RegisterFlareExtensions(chainID *big.Int, extensions FlareExtensions) {
+	flareExtensionsVariants.AddValue(chainID, extensions)
+}
+
+// GetFlareExtensions returns the extensions registered for [chainID].
+func GetFlareExtensions(chainID *big.Int) FlareExtensions {
+	return flareExtensionsVariants.GetValue(chainID)
+}
+
+// PrioritisedCallFee returns the fee charged for a prioritised call using
+// [gasUsed] gas at [price], which is capped at the nominal fee of a transfer,
+// and the part of gasUsed * price refunded to the sender.
+func PrioritisedCallFee(extensions FlareExtensions, gasUsed uint64, price *uint256.Int) (fee *uint256.Int, refund *uint256.Int) {
+	nominalFee := new(uint256.Int).Mul(uint256.NewInt(params.TxGas), uint256.NewInt(extensions.NominalGasPrice()))
+	actualFee := new(uint256.Int).Mul(uint256.NewInt(gasUsed), price)
+	if actualFee.Cmp(nominalFee) > 0 {
+		return nominalFee, new(uint256.Int).Sub(actualFee, nominalFee)
+	}
+	return actualFee, new(uint256.Int)
+}
+
+// nonFlareExtensions is used by chains that are not Flare networks (e.g. in tests)
+type nonFlareExtensions struct{}
+
+func (*nonFlareExtensions) BurnAddress(common.Address) (common.Address, error) {
+	return defaultBurnAddress, nil
+}
+
+func (*nonFlareExtensions) NominalGasPrice() uint64 {
+	return uint64(ap4.MinBaseFee)
+}
+
+func (*nonFlareExtensions) PostCall(*StateTransition, bool, *Message, []byte) {}
+
+func (*nonFlareExtensions) Daemon(EVMCaller, log.Logger) *types.DaemonResult {
+	return nil
+}
+
+func (*nonFlareExtensions) IsPrioritisedCall(config *params.ChainConfig, blockTime uint64, to *common.Address, data []byte, ret []byte, initialGas uint64) bool {
+	return IsPrioritisedContractCall(config, blockTime, to, data, ret, initialGas)
+}
+
+// daemonExtensions is the daemon and prioritised call behaviour shared by all
+// Flare networks.
+type daemonExtensions struct{}
+
+func (*daemonExtensions) Daemon(evm EVMCaller, log log.Logger) *types.DaemonResult {
+	return atomicDaemonAndMint(evm, log)
+}
+
+func (*daemonExtensions) IsPrioritisedCall(config *params.ChainConfig, blockTime uint64, to *common.Address, data []byte, ret []byte, initialGas uint64) bool {
+	return IsPrioritisedContractCall(config, blockTime, to, data, ret, initialGas)
+}
+
+// flareExtensions is used by Flare, Coston2 and Local (Flare)
+type flareExtensions struct {
+	daemonExtensions
+}
+
+func (*flareExtensions) BurnAddress(common.Address) (common.Address, error) {
+	return defaultBurnAddress, nil
+}
+
+func (*flareExtensions) NominalGasPrice() uint64 {
+	return uint64(ap4.MinBaseFee)
+}
+
+func (*flareExtensions) PostCall(st *StateTransition, isDurango bool, msg *Message, ret []byte) {
+	if st.evm.Context.Coinbase != systemCoinbase {
+		return
+	}
+
+	chainID := st.evm.ChainConfig().ChainID
+	timestamp := st.evm.Context.Time
+	if isSubmitAttestationCall(isDurango, chainID, timestamp, msg, ret) {
+		st.finalisePreviousRound(chainID, timestamp, msg.Data[4:36])
+	} else if GetGovernanceSettingIsActivatedAndCalled(chainID, timestamp, *msg.To) && len(msg.Data) == 36 {
+		if bytes.Equal(msg.Data[0:4], SetGovernanceAddressSelector(chainID, timestamp)) {
+			if err := st.SetGovernanceAddress(chainID, timestamp, msg.Data[4:36]); err != nil {
+				log.Warn("Error setting governance address", "error", err)
+			}
+		} else if bytes.Equal(msg.Data[0:4], SetTimelockSelector(chainID, timestamp)) {
+			if err := st.SetTimelock(chainID, timestamp, msg.Data[4:36]); err != nil {
+				log.Warn("Error setting governance timelock", "error", err)
+			}
+		}
+	} else if GetInitialAirdropChangeIsActivatedAndCalled(chainID, timestamp, *msg.To) && len(msg.Data) == 4 {
+		if bytes.Equal(msg.Data[0:4], UpdateInitialAirdropAddressSelector(chainID, timestamp)) {
+			if err := st.UpdateInitialAirdropAddress(chainID, timestamp); err != nil {
+				log.Warn("Error updating initialAirdrop contract", "error", err)
+			}
+		}
+	} else if GetDistributionChangeIsActivatedAndCalled(chainID, timestamp, *msg.To) && len(msg.Data) == 4 {
+		if bytes.Equal(msg.Data[0:4], UpdateDistributionAddressSelector(chainID, timestamp)) {
+			if err := st.UpdateDistributionAddress(chainID, timestamp); err != nil {
+				log.Warn("Error updating distribution contract", "error", err)
+			}
+		}
+	}
+}
+
+// songbirdExtensions is used by Songbird, Coston and Local (Songbird)
+type songbirdExtensions struct {
+	daemonExtensions
+}
+
+func (*songbirdExtensions) BurnAddress(coinbase common.Address) (common.Address, error) {
+	if coinbase != systemCoinbase {
+		return common.Address{}, errInvalidCoinbase
+	}
+	return coinbase, nil
+}
+
+func (*songbirdExtensions) NominalGasPrice() uint64 {
+	return 225_000_000_000
+}
+
+func (*songbirdExtensions) PostCall(st *StateTransition, isDurango bool, msg *Message, ret []byte) {
+	chainID := st.evm.ChainConfig().ChainID
+	timestamp := st.evm.Context.Time
+	if isSubmitAttestationCall(isDurango, chainID, timestamp, msg, ret) {
+		st.finalisePreviousRound(chainID, timestamp, msg.Data[4:36])
+	}
+}
+
+// isSubmitAttestationCall returns true if [msg] is a successful attestation
+// submission to the state connector that starts a new round.
+func isSubmitAttestationCall(isDurango bool, chainID *big.Int, timestamp uint64, msg *Message, ret []byte) bool {
+	return GetStateConnectorIsActivatedAndCalled(isDurango, chainID, timestamp, *msg.To) &&
+		len(msg.Data) >= 36 && len(ret) == 32 &&
+		bytes.Equal(msg.Data[0:4], SubmitAttestationSelector(chainID, timestamp)) &&
+		binary.BigEndian.Uint64(ret[24:32]) > 0
+}
@@ -0,0 +1,66 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ava-labs/coreth/params"
+)
+
+func TestFlareExtensionsRegistry(t *testing.T) {
+	if _, ok := GetFlareExtensions(params.FlareChainID).(*flareExtensions); !ok {
+		t.Errorf("Flare chain should use the flare extensions")
+	}
+	if _, ok := GetFlareExtensions(params.CostonChainID).(*songbirdExtensions); !ok {
+		t.Errorf("Coston chain should use the songbird extensions")
+	}
+	if _, ok := GetFlareExtensions(nil).(*nonFlareExtensions); !ok {
+		t.Errorf("Unknown chain should use the non-flare extensions")
+	}
+
+	chainID := big.NewInt(1234567)
+	RegisterFlareExtensions(chainID, &songbirdExtensions{})
+	t.Cleanup(func() {
+		flareExtensionsVariants.RemoveValue(chainID)
+	})
+	if _, ok := GetFlareExtensions(chainID).(*songbirdExtensions); !ok {
+		t.Errorf("Registered chain should use the registered extensions")
+	}
+}
+
+func TestFlareExtensionsBurnAddress(t *testing.T) {
+	other := common.HexToAddress("0x0200000000000000000000000000000000000000")
+
+	for _, chainID := range []*big.Int{params.FlareChainID, params.CostwoChainID, params.LocalFlareChainID} {
+		burnAddress, err := GetFlareExtensions(chainID).BurnAddress(other)
+		if err != nil || burnAddress != defaultBurnAddress {
+			t.Errorf("Chain %v: want burn address %v, have %v (err %v)", chainID, defaultBurnAddress, burnAddress, err)
+		}
+	}
+	for _, chainID := range []*big.Int{params.SongbirdChainID, params.CostonChainID, params.LocalChainID} {
+		extensions := GetFlareExtensions(chainID)
+		if _, err := extensions.BurnAddress(other); err != errInvalidCoinbase {
+			t.Errorf("Chain %v: want %v, have %v", chainID, errInvalidCoinbase, err)
+		}
+		burnAddress, err := extensions.BurnAddress(systemCoinbase)
+		if err != nil || burnAddress != systemCoinbase {
+			t.Errorf("Chain %v: want burn address %v, have %v (err %v)", chainID, systemCoinbase, burnAddress, err)
+		}
+	}
+}
+
+func TestNonFlareExtensionsSkipDaemon(t *testing.T) {
+	defaultEVMMock := &DefaultEVMMock{}
+	if result := GetFlareExtensions(nil).Daemon(defaultEVMMock, log.New()); result != nil {
+		t.Errorf("Non-flare chains should not call the daemon, have result %v", result)
+	}
+	if defaultEVMMock.mockEVMCallerData.callCalls != 0 {
+		t.Errorf("Non-flare chains should not call the daemon, have %d calls", defaultEVMMock.mockEVMCallerData.callCalls)
+	}
+}
@@ -0,0 +1,43 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package core
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+
+	"github.com/ava-labs/coreth/core/vm"
+)
+
+// systemCallLogger returns the tracer if it collects Flare system calls.
+func (st *StateTransition) systemCallLogger() vm.FlareSystemCallLogger {
+	logger, _ := st.evm.Config.Tracer.(vm.FlareSystemCallLogger)
+	return logger
+}
+
+// systemCall makes a Flare system call from [caller] to [addr], which is
+// reported to the tracer as a single frame of type [typ].
+func (st *StateTransition) systemCall(typ vm.FlareSystemCallType, caller common.Address, addr common.Address, input []byte, gas uint64) (snapshot int, ret []byte, leftOverGas uint64, err error) {
+	logger := st.systemCallLogger()
+	if logger != nil {
+		logger.CaptureFlareSystemCallStart(typ, caller, addr, input, gas, common.Big0)
+	}
+	snapshot, ret, leftOverGas, err = st.evm.DaemonCall(vm.AccountRef(caller), addr, input, gas)
+	if logger != nil {
+		logger.CaptureFlareSystemCallEnd(ret, gas-leftOverGas, err)
+	}
+	return snapshot, ret, leftOverGas, err
+}
+
+// captureSystemTransfer reports a balance change of [amount] from [from] to
+// [to] made outside of the EVM to the tracer as a frame of type [typ]. It must
+// be called before the balances are changed.
+func (st *StateTransition) captureSystemTransfer(typ vm.FlareSystemCallType, from common.Address, to common.Address, amount *uint256.Int) {
+	logger := st.systemCallLogger()
+	if logger == nil {
+		return
+	}
+	logger.CaptureFlareSystemCallStart(typ, from, to, nil, 0, amount.ToBig())
+	logger.CaptureFlareSystemCallEnd(nil, 0, nil)
+}
@@ -266,7 +266,7 @@ func (st *StateTransition) SetGovernanceAddress(chainID *big.Int, timestamp uint
 			st.evm.Context.Coinbase = originalCoinbase
 		}()
 		st.evm.Context.Coinbase = coinbaseSignal
-		_, _, _, err := st.evm.DaemonCall(vm.AccountRef(coinbaseSignal), st.to(), st.msg.Data, st.evm.Context.GasLimit)
+		_, _, _, err := st.systemCall(vm.FlareGovernance, coinbaseSignal, st.to(), st.msg.Data, st.evm.Context.GasLimit)
 		if err != nil {
 			return err
 		}
@@ -282,7 +282,7 @@ func (st *StateTransition) SetTimelock(chainID *big.Int, timestamp uint64, newTi
 			st.evm.Context.Coinbase = originalCoinbase
 		}()
 		st.evm.Context.Coinbase = coinbaseSignal
-		_, _, _, err := st.evm.DaemonCall(vm.AccountRef(coinbaseSignal), st.to(), st.msg.Data, st.evm.Context.GasLimit)
+		_, _, _, err := st.systemCall(vm.FlareGovernance, coinbaseSignal, st.to(), st.msg.Data, st.evm.Context.GasLimit)
 		if err != nil {
 			return err
 		}
@@ -297,7 +297,7 @@ func (st *StateTransition) UpdateInitialAirdropAddress(chainID *big.Int, timesta
 		st.evm.Context.Coinbase = originalCoinbase
 	}()
 	st.evm.Context.Coinbase = coinbaseSignal
-	_, _, _, err := st.evm.DaemonCall(vm.AccountRef(coinbaseSignal), st.to(), st.msg.Data, st.evm.Context.GasLimit)
+	_, _, _, err := st.systemCall(vm.FlareGovernance, coinbaseSignal, st.to(), st.msg.Data, st.evm.Context.GasLimit)
 	if err != nil {
 		return err
 	}
@@ -306,6 +306,7 @@ func (st *StateTransition) UpdateInitialAirdropAddress(chainID *big.Int, timesta
 
 	if initialAirdropAddress != targetAidropAddress {
 		airdropBalance := st.state.GetBalance(initialAirdropAddress)
+		st.captureSystemTransfer(vm.FlareTransfer, initialAirdropAddress, targetAidropAddress, airdropBalance)
 		st.state.SubBalance(initialAirdropAddress, airdropBalance)
 		st.state.AddBalance(targetAidropAddress, airdropBalance)
 	}
@@ -319,7 +320,7 @@ func (st *StateTransition) UpdateDistributionAddress(chainID *big.Int, timestamp
 		st.evm.Context.Coinbase = originalCoinbase
 	}()
 	st.evm.Context.Coinbase = coinbaseSignal
-	_, _, _, err := st.evm.DaemonCall(vm.AccountRef(coinbaseSignal), st.to(), st.msg.Data, st.evm.Context.GasLimit)
+	_, _, _, err := st.systemCall(vm.FlareGovernance, coinbaseSignal, st.to(), st.msg.Data, st.evm.Context.GasLimit)
 	if err != nil {
 		return err
 	}
@@ -328,6 +329,7 @@ func (st *StateTransition) UpdateDistributionAddress(chainID *big.Int, timestamp
 
 	if distributionAddress != targetDistributionAddress {
 		distributionBalance := st.state.GetBalance(distributionAddress)
+		st.captureSystemTransfer(vm.FlareTransfer, distributionAddress, targetDistributionAddress, distributionBalance)
 		st.state.SubBalance(distributionAddress, distributionBalance)
 		st.state.AddBalance(targetDistributionAddress, distributionBalance)
 	}
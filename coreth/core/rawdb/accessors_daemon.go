@@ -0,0 +1,45 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package rawdb
+
+import (
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// ReadDaemonResults retrieves the daemon results recorded while processing the
+// block with the given hash and number. Returns nil if none were recorded.
+func ReadDaemonResults(db ethdb.KeyValueReader, hash common.Hash, number uint64) types.DaemonResults {
+	data, _ := db.Get(daemonResultsKey(number, hash))
+	if len(data) == 0 {
+		return nil
+	}
+	var results types.DaemonResults
+	if err := rlp.DecodeBytes(data, &results); err != nil {
+		log.Error("Invalid daemon results RLP", "hash", hash, "err", err)
+		return nil
+	}
+	return results
+}
+
+// WriteDaemonResults stores the daemon results recorded while processing a block.
+func WriteDaemonResults(db ethdb.KeyValueWriter, hash common.Hash, number uint64, results types.DaemonResults) {
+	bytes, err := rlp.EncodeToBytes(results)
+	if err != nil {
+		log.Crit("Failed to encode daemon results", "err", err)
+	}
+	if err := db.Put(daemonResultsKey(number, hash), bytes); err != nil {
+		log.Crit("Failed to store daemon results", "err", err)
+	}
+}
+
+// DeleteDaemonResults removes the daemon results associated with a block.
+func DeleteDaemonResults(db ethdb.KeyValueWriter, hash common.Hash, number uint64) {
+	if err := db.Delete(daemonResultsKey(number, hash)); err != nil {
+		log.Crit("Failed to delete daemon results", "err", err)
+	}
+}
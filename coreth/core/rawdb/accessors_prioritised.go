@@ -0,0 +1,55 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package rawdb
+
+import (
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// ReadPrioritisedFees retrieves the fee accounting of the prioritised calls made
+// within the block with the given hash and number. Returns nil if there were none.
+func ReadPrioritisedFees(db ethdb.KeyValueReader, hash common.Hash, number uint64) types.PrioritisedFees {
+	data, _ := db.Get(prioritisedFeesKey(number, hash))
+	if len(data) == 0 {
+		return nil
+	}
+	var fees types.PrioritisedFees
+	if err := rlp.DecodeBytes(data, &fees); err != nil {
+		log.Error("Invalid prioritised fees RLP", "hash", hash, "err", err)
+		return nil
+	}
+	return fees
+}
+
+// WritePrioritisedFees stores the fee accounting of the prioritised calls made
+// within a block.
+func WritePrioritisedFees(db ethdb.KeyValueWriter, hash common.Hash, number uint64, fees types.PrioritisedFees) {
+	bytes, err := rlp.EncodeToBytes(fees)
+	if err != nil {
+		log.Crit("Failed to encode prioritised fees", "err", err)
+	}
+	if err := db.Put(prioritisedFeesKey(number, hash), bytes); err != nil {
+		log.Crit("Failed to store prioritised fees", "err", err)
+	}
+}
+
+// DeletePrioritisedFees removes the prioritised fees associated with a block.
+func DeletePrioritisedFees(db ethdb.KeyValueWriter, hash common.Hash, number uint64) {
+	if err := db.Delete(prioritisedFeesKey(number, hash)); err != nil {
+		log.Crit("Failed to delete prioritised fees", "err", err)
+	}
+}
+
+// setPrioritisedFees attaches the stored prioritised fees of a block to its receipts.
+func setPrioritisedFees(db ethdb.KeyValueReader, hash common.Hash, number uint64, receipts types.Receipts) {
+	for _, fee := range ReadPrioritisedFees(db, hash, number) {
+		if fee.TxIndex < uint64(len(receipts)) {
+			receipts[fee.TxIndex].PrioritisedFee = fee
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package rawdb
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/params"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestPrioritisedFeesStorage(t *testing.T) {
+	db := NewMemoryDatabase()
+
+	tx1 := types.NewTransaction(1, common.HexToAddress("0x1"), big.NewInt(1), 1, big.NewInt(1), nil)
+	tx2 := types.NewTransaction(2, common.HexToAddress("0x2"), big.NewInt(2), 2, big.NewInt(2), nil)
+	body := &types.Body{Transactions: types.Transactions{tx1, tx2}}
+	receipts := types.Receipts{
+		{Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: 1, TxHash: tx1.Hash(), GasUsed: 1},
+		{Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: 3, TxHash: tx2.Hash(), GasUsed: 2},
+	}
+	fees := types.PrioritisedFees{{TxIndex: 1, Fee: big.NewInt(100), Refund: big.NewInt(25)}}
+
+	header := &types.Header{Number: big.NewInt(0), Extra: []byte("test header")}
+	hash := header.Hash()
+	if fs := ReadPrioritisedFees(db, hash, 0); fs != nil {
+		t.Fatalf("non existent prioritised fees returned: %v", fs)
+	}
+	WriteHeader(db, header)
+	WriteBody(db, hash, 0, body)
+	WriteReceipts(db, hash, 0, receipts)
+	WritePrioritisedFees(db, hash, 0, fees)
+
+	rs := ReadReceipts(db, hash, 0, 0, params.TestFlareChainConfig)
+	if len(rs) != 2 {
+		t.Fatalf("receipts count mismatch: have %d, want 2", len(rs))
+	}
+	if rs[0].PrioritisedFee != nil {
+		t.Fatalf("regular receipt has prioritised fee: %v", rs[0].PrioritisedFee)
+	}
+	if fee := rs[1].PrioritisedFee; fee == nil || fee.Fee.Cmp(big.NewInt(100)) != 0 || fee.Refund.Cmp(big.NewInt(25)) != 0 {
+		t.Fatalf("prioritised fee mismatch: have %v, want %v", fee, fees[0])
+	}
+	if have := rs.PrioritisedFees(); len(have) != 1 || have[0].TxIndex != 1 {
+		t.Fatalf("receipts prioritised fees mismatch: have %v", have)
+	}
+
+	DeleteBlock(db, hash, 0)
+	if fs := ReadPrioritisedFees(db, hash, 0); fs != nil {
+		t.Fatalf("deleted prioritised fees returned: %v", fs)
+	}
+}
@@ -0,0 +1,59 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package rawdb
+
+import (
+	"encoding/binary"
+
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// ReadSupplyDelta retrieves the indexed supply delta of the canonical block with
+// the given number. Returns nil if the block has not been indexed.
+func ReadSupplyDelta(db ethdb.KeyValueReader, number uint64) *types.SupplyDelta {
+	data, _ := db.Get(supplyDeltaKey(number))
+	if len(data) == 0 {
+		return nil
+	}
+	delta := new(types.SupplyDelta)
+	if err := rlp.DecodeBytes(data, delta); err != nil {
+		log.Error("Invalid supply delta RLP", "number", number, "err", err)
+		return nil
+	}
+	return delta
+}
+
+// WriteSupplyDelta stores the supply delta of the canonical block with the
+// given number.
+func WriteSupplyDelta(db ethdb.KeyValueWriter, number uint64, delta *types.SupplyDelta) {
+	bytes, err := rlp.EncodeToBytes(delta)
+	if err != nil {
+		log.Crit("Failed to encode supply delta", "err", err)
+	}
+	if err := db.Put(supplyDeltaKey(number), bytes); err != nil {
+		log.Crit("Failed to store supply delta", "err", err)
+	}
+}
+
+// ReadSupplyDeltaStart retrieves the number of the first block of the supply
+// delta index. Returns nil if the index has not been started.
+func ReadSupplyDeltaStart(db ethdb.KeyValueReader) *uint64 {
+	data, _ := db.Get(supplyDeltaStartKey)
+	if len(data) != 8 {
+		return nil
+	}
+	number := binary.BigEndian.Uint64(data)
+	return &number
+}
+
+// WriteSupplyDeltaStart stores the number of the first block of the supply
+// delta index.
+func WriteSupplyDeltaStart(db ethdb.KeyValueWriter, number uint64) {
+	if err := db.Put(supplyDeltaStartKey, encodeBlockNumber(number)); err != nil {
+		log.Crit("Failed to store the supply delta start", "err", err)
+	}
+}
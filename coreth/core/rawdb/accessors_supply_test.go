@@ -0,0 +1,54 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package rawdb
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/coreth/core/types"
+)
+
+func TestSupplyDeltaStorage(t *testing.T) {
+	db := NewMemoryDatabase()
+
+	delta := &types.SupplyDelta{
+		Minted:            big.NewInt(10),
+		Burned:            big.NewInt(3),
+		PrioritisedRefund: big.NewInt(1),
+		TotalMinted:       big.NewInt(100),
+		TotalBurned:       big.NewInt(130),
+	}
+	if d := ReadSupplyDelta(db, 7); d != nil {
+		t.Fatalf("non existent supply delta returned: %v", d)
+	}
+	WriteSupplyDelta(db, 7, delta)
+
+	d := ReadSupplyDelta(db, 7)
+	if d == nil {
+		t.Fatalf("stored supply delta not found")
+	}
+	if d.Minted.Cmp(delta.Minted) != 0 || d.Burned.Cmp(delta.Burned) != 0 || d.PrioritisedRefund.Cmp(delta.PrioritisedRefund) != 0 ||
+		d.TotalMinted.Cmp(delta.TotalMinted) != 0 || d.TotalBurned.Cmp(delta.TotalBurned) != 0 {
+		t.Fatalf("supply delta mismatch: have %v, want %v", d, delta)
+	}
+	if change := d.CirculatingSupplyChange(); change.Cmp(big.NewInt(-30)) != 0 {
+		t.Fatalf("circulating supply change mismatch: have %v, want -30", change)
+	}
+	if d := ReadSupplyDelta(db, 8); d != nil {
+		t.Fatalf("supply delta returned for another block: %v", d)
+	}
+}
+
+func TestSupplyDeltaStartStorage(t *testing.T) {
+	db := NewMemoryDatabase()
+
+	if start := ReadSupplyDeltaStart(db); start != nil {
+		t.Fatalf("non existent supply delta start returned: %d", *start)
+	}
+	WriteSupplyDeltaStart(db, 4096)
+	if start := ReadSupplyDeltaStart(db); start == nil || *start != 4096 {
+		t.Fatalf("supply delta start mismatch: have %v, want 4096", start)
+	}
+}
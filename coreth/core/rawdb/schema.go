@@ -124,6 +124,15 @@ var (
 	// State sync metadata
 	syncPerformedPrefix    = []byte("sync_performed")
 	syncPerformedKeyLength = len(syncPerformedPrefix) + wrappers.LongLen // prefix + block number as uint64
+
+	// Flare execution records
+	daemonResultsPrefix   = []byte("flare_daemon")          // daemonResultsPrefix + num (uint64 big endian) + hash -> block daemon results
+	prioritisedFeesPrefix = []byte("flare_prioritised")     // prioritisedFeesPrefix + num (uint64 big endian) + hash -> block prioritised fees
+	supplyDeltaPrefix     = []byte("flare_supply")          // supplyDeltaPrefix + num (uint64 big endian) -> canonical block supply delta
+	supplyDeltaStartKey   = []byte("FlareSupplyDeltaStart") // tracks the first block of the supply delta index
+
+	// SupplyDeltaIndexPrefix is the data table of a chain indexer to track its progress
+	SupplyDeltaIndexPrefix = []byte("iS")
 )
 
 // LegacyTxLookupEntry is the legacy TxLookupEntry definition with some unnecessary
@@ -171,6 +180,21 @@ func blockReceiptsKey(number uint64, hash common.Hash) []byte {
 	return append(append(blockReceiptsPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
 }
 
+// daemonResultsKey = daemonResultsPrefix + num (uint64 big endian) + hash
+func daemonResultsKey(number uint64, hash common.Hash) []byte {
+	return append(append(daemonResultsPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
+}
+
+// prioritisedFeesKey = prioritisedFeesPrefix + num (uint64 big endian) + hash
+func prioritisedFeesKey(number uint64, hash common.Hash) []byte {
+	return append(append(prioritisedFeesPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
+}
+
+// supplyDeltaKey = supplyDeltaPrefix + num (uint64 big endian)
+func supplyDeltaKey(number uint64) []byte {
+	return append(supplyDeltaPrefix, encodeBlockNumber(number)...)
+}
+
 // txLookupKey = txLookupPrefix + hash
 func txLookupKey(hash common.Hash) []byte {
 	return append(txLookupPrefix, hash.Bytes()...)
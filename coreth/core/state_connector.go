@@ -4,24 +4,24 @@
 package core
 
 import (
+	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
-	"os"
-	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ava-labs/coreth/core/vm"
 	"github.com/ava-labs/coreth/params"
 	"github.com/ava-labs/coreth/utils"
 )
 
-const (
-	localAttestorEnv  = "SC_LOCAL_ATTESTATORS"
-	forkingEnabledEnv = "SC_FORKING_ENABLED"
-)
+// ErrRoundNotFinalised is returned when tracing a transaction that does not
+// finalise a state connector round.
+var ErrRoundNotFinalised = errors.New("transaction does not finalise a state connector round")
 
 var (
 	flareActivationTime      = uint64(time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC).Unix())
@@ -44,6 +44,19 @@ type AttestationVotes struct {
 	abstainedAttestors []common.Address
 }
 
+func (v *AttestationVotes) ReachedMajority() bool                { return v.reachedMajority }
+func (v *AttestationVotes) MajorityDecision() string             { return v.majorityDecision }
+func (v *AttestationVotes) MajorityAttestors() []common.Address  { return v.majorityAttestors }
+func (v *AttestationVotes) DivergentAttestors() []common.Address { return v.divergentAttestors }
+func (v *AttestationVotes) AbstainedAttestors() []common.Address { return v.abstainedAttestors }
+
+// AttestationRound is the tally of the votes of a set of attestors on a round.
+type AttestationRound struct {
+	Attestors       []common.Address
+	Votes           AttestationVotes
+	HashFrequencies map[string][]common.Address
+}
+
 var (
 	stateConnectorActivationVariants = utils.NewChainValue(func(uint64, common.Address) bool { return false }).
 		AddValue(params.FlareChainID, GetStateConnectorIsActivatedAndCalledFlare).
@@ -104,6 +117,35 @@ func GetStateConnectorIsActivatedAndCalledCoston(blockTime uint64, to common.Add
 	}
 }
 
+// GetStateConnectorAddr returns the address of the state connector contract at
+// [blockTime], or the zero address if the state connector is not active.
+func GetStateConnectorAddr(chainID *big.Int, blockTime uint64) common.Address {
+	var addr common.Address
+	switch {
+	case chainID.Cmp(params.FlareChainID) == 0 || chainID.Cmp(params.CostwoChainID) == 0 ||
+		chainID.Cmp(params.LocalFlareChainID) == 0 || chainID.Cmp(params.LocalChainID) == 0:
+		addr = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	case chainID.Cmp(params.SongbirdChainID) == 0:
+		switch {
+		case blockTime > songbirdOct22ForkTime:
+			addr = common.HexToAddress("0x0c13aDA1C7143Cf0a0795FFaB93eEBb6FAD6e4e3")
+		default:
+			addr = common.HexToAddress("0x3A1b3220527aBA427d1e13e4b4c48c31460B4d91")
+		}
+	case chainID.Cmp(params.CostonChainID) == 0:
+		switch {
+		case blockTime > costonOct22ForkTime:
+			addr = common.HexToAddress("0x0c13aDA1C7143Cf0a0795FFaB93eEBb6FAD6e4e3")
+		default:
+			addr = common.HexToAddress("0x947c76694491d3fD67a73688003c4d36C8780A97")
+		}
+	}
+	if !stateConnectorActivationVariants.GetValue(chainID)(blockTime, addr) {
+		return common.Address{}
+	}
+	return addr
+}
+
 // Signalling block.coinbase value
 // address public constant SIGNAL_COINBASE = address(0x00000000000000000000000000000000000DEaD1);
 // https://gitlab.com/flarenetwork/flare-smart-contracts/-/blob/6b6e5480c3cf769b5a650b961992b4f082761d76/contracts/genesis/implementation/StateConnector.sol#L17
@@ -261,28 +303,24 @@ func GetDefaultAttestors(chainID *big.Int, blockTime uint64) []common.Address {
 	}
 }
 
-func GetLocalAttestors() []common.Address {
-	var localAttestors []common.Address
-	localAttestorList := os.Getenv(localAttestorEnv)
-	if localAttestorList != "" {
-		localAttestorEntries := strings.Split(localAttestorList, ",")
-		for _, localAttestorEntry := range localAttestorEntries {
-			localAttestors = append(localAttestors, common.HexToAddress(localAttestorEntry))
-		}
-	}
-	return localAttestors
+func (st *StateTransition) GetAttestation(attestor common.Address, instructions []byte) (string, error) {
+	return getAttestation(st.evm, st.to(), attestor, instructions)
 }
 
-func (st *StateTransition) GetAttestation(attestor common.Address, instructions []byte) (string, error) {
-	_, merkleRootHash, _, err := st.evm.DaemonCall(vm.AccountRef(attestor), st.to(), instructions, params.TxGas)
+func (st *StateTransition) GetAttestations(attestors []common.Address, instructions []byte) (AttestationVotes, int, map[string][]common.Address) {
+	return getAttestations(st.evm, st.to(), attestors, instructions)
+}
+
+func getAttestation(evm *vm.EVM, stateConnector common.Address, attestor common.Address, instructions []byte) (string, error) {
+	_, merkleRootHash, _, err := evm.DaemonCall(vm.AccountRef(attestor), stateConnector, instructions, params.TxGas)
 	return hex.EncodeToString(merkleRootHash), err
 }
 
-func (st *StateTransition) GetAttestations(attestors []common.Address, instructions []byte) (AttestationVotes, int, map[string][]common.Address) {
+func getAttestations(evm *vm.EVM, stateConnector common.Address, attestors []common.Address, instructions []byte) (AttestationVotes, int, map[string][]common.Address) {
 	var attestationVotes AttestationVotes
 	hashFrequencies := make(map[string][]common.Address)
 	for i, a := range attestors {
-		h, err := st.GetAttestation(a, instructions)
+		h, err := getAttestation(evm, stateConnector, a, instructions)
 		if err != nil {
 			attestationVotes.abstainedAttestors = append(attestationVotes.abstainedAttestors, a)
 		}
@@ -291,6 +329,63 @@ func (st *StateTransition) GetAttestations(attestors []common.Address, instructi
 	return attestationVotes, len(attestors), hashFrequencies
 }
 
+// TraceAttestationRound counts the votes of the default and local attestors on
+// [currentRoundNumber] against the state of [evm], as done by FinalisePreviousRound,
+// without finalising the round. The local round is nil if there are no local attestors.
+func TraceAttestationRound(evm *vm.EVM, currentRoundNumber []byte) (*AttestationRound, *AttestationRound, error) {
+	chainID := evm.ChainConfig().ChainID
+	timestamp := evm.Context.Time
+	stateConnector := GetStateConnectorAddr(chainID, timestamp)
+	if stateConnector == (common.Address{}) {
+		return nil, nil, fmt.Errorf("state connector is not active at timestamp %d", timestamp)
+	}
+	getAttestationSelector := GetAttestationSelector(chainID, timestamp)
+	instructions := append(getAttestationSelector[:], currentRoundNumber[:]...)
+
+	countRound := func(attestors []common.Address) *AttestationRound {
+		votes, numAttestors, hashFrequencies := getAttestations(evm, stateConnector, attestors, instructions)
+		return &AttestationRound{
+			Attestors:       attestors,
+			Votes:           CountAttestations(votes, numAttestors, hashFrequencies),
+			HashFrequencies: hashFrequencies,
+		}
+	}
+	defaultRound := countRound(GetDefaultAttestors(chainID, timestamp))
+	var localRound *AttestationRound
+	if localAttestors := evm.ChainConfig().LocalAttestors; len(localAttestors) > 0 {
+		localRound = countRound(localAttestors)
+	}
+	return defaultRound, localRound, nil
+}
+
+// IsSubmitAttestationTx returns true if a transaction to [to] with [data]
+// submits an attestation for [currentRoundNumber] to the state connector.
+func IsSubmitAttestationTx(isDurango bool, chainID *big.Int, timestamp uint64, to *common.Address, data []byte, currentRoundNumber []byte) bool {
+	return to != nil && GetStateConnectorIsActivatedAndCalled(isDurango, chainID, timestamp, *to) &&
+		len(data) >= 36 &&
+		bytes.Equal(data[0:4], SubmitAttestationSelector(chainID, timestamp)) &&
+		bytes.Equal(data[4:36], currentRoundNumber)
+}
+
+// TraceSubmitAttestation applies [msg] to the state of [evm] like ApplyMessage
+// and, if [msg] submits an attestation that finalises a state connector round,
+// counts the votes on the round against the state at that point of the
+// transaction, without finalising it. The state of [evm] is modified.
+func TraceSubmitAttestation(evm *vm.EVM, msg *Message, gp *GasPool) (*AttestationRound, *AttestationRound, error) {
+	var (
+		defaultRound, localRound *AttestationRound
+		traceErr                 = ErrRoundNotFinalised
+	)
+	st := NewStateTransition(evm, msg, gp)
+	st.traceAttestationRound = func(currentRoundNumber []byte) {
+		defaultRound, localRound, traceErr = TraceAttestationRound(evm, currentRoundNumber)
+	}
+	if _, err := st.TransitionDb(); err != nil {
+		return nil, nil, err
+	}
+	return defaultRound, localRound, traceErr
+}
+
 func CountAttestations(attestationVotes AttestationVotes, numAttestors int, hashFrequencies map[string][]common.Address) AttestationVotes {
 	// Find the plurality
 	var pluralityNum int
@@ -314,16 +409,29 @@ func CountAttestations(attestationVotes AttestationVotes, numAttestors int, hash
 	return attestationVotes
 }
 
+// finalisePreviousRound finalises the state connector round before
+// [currentRoundNumber], or only counts its votes if the transition is traced by
+// TraceSubmitAttestation.
+func (st *StateTransition) finalisePreviousRound(chainID *big.Int, timestamp uint64, currentRoundNumber []byte) {
+	if st.traceAttestationRound != nil {
+		st.traceAttestationRound(currentRoundNumber)
+		return
+	}
+	if err := st.FinalisePreviousRound(chainID, timestamp, currentRoundNumber); err != nil {
+		log.Warn("Error finalising state connector round", "error", err)
+	}
+}
+
 func (st *StateTransition) FinalisePreviousRound(chainID *big.Int, timestamp uint64, currentRoundNumber []byte) error {
 	getAttestationSelector := GetAttestationSelector(chainID, timestamp)
 	instructions := append(getAttestationSelector[:], currentRoundNumber[:]...)
 	defaultAttestors := GetDefaultAttestors(chainID, timestamp)
 	defaultAttestationVotes := CountAttestations(st.GetAttestations(defaultAttestors, instructions))
-	localAttestors := GetLocalAttestors()
+	localAttestors := st.evm.ChainConfig().LocalAttestors
 	finalityReached := defaultAttestationVotes.reachedMajority
 	if len(localAttestors) > 0 {
 		localAttestationVotes := CountAttestations(st.GetAttestations(localAttestors, instructions))
-		if finalityReached && defaultAttestationVotes.majorityDecision != localAttestationVotes.majorityDecision && os.Getenv(forkingEnabledEnv) == "1" {
+		if finalityReached && defaultAttestationVotes.majorityDecision != localAttestationVotes.majorityDecision && st.evm.ChainConfig().ForkingEnabled {
 			// Fork this node now from the default path
 			return fmt.Errorf(
 				"default state connector decision (%s) does not match this node's local state connector decision (%s), forking node",
@@ -354,7 +462,7 @@ func (st *StateTransition) FinalisePreviousRound(chainID *big.Int, timestamp uin
 		//				by this check: burnAddress == common.HexToAddress("0x0100000000000000000000000000000000000000") on line 373, which occurs
 		//				right before st.FinalisePreviousRound(chainID, timestamp, st.data[4:36]) is called.
 		//		2) Know the private key to the address 0x00000000000000000000000000000000000DEaD1 in order to become msg.sender.
-		_, _, _, err = st.evm.DaemonCall(vm.AccountRef(coinbaseSignal), st.to(), finalisedData, st.evm.Context.GasLimit)
+		_, _, _, err = st.systemCall(vm.FlareStateConnector, coinbaseSignal, st.to(), finalisedData, st.evm.Context.GasLimit)
 		if err != nil {
 			return err
 		}
@@ -4,9 +4,12 @@
 package core
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ava-labs/coreth/params"
 )
 
 // TestCountAttestationsEmpty checks that the CountAttestations function in state_connector.go
@@ -118,3 +121,32 @@ func TestCountAttestationsBlankVotes(t *testing.T) {
 		t.Fatalf(`reachedMajority = %t, want %t`, returnedAttestationVotes.reachedMajority, want)
 	}
 }
+
+// TestGetStateConnectorAddr checks that the state connector address matches the
+// activation checks of each chain
+func TestGetStateConnectorAddr(t *testing.T) {
+	tests := []struct {
+		chainID   *big.Int
+		blockTime uint64
+		want      common.Address
+	}{
+		{params.FlareChainID, flareActivationTime - 1, common.Address{}},
+		{params.FlareChainID, flareActivationTime, common.HexToAddress("0x1000000000000000000000000000000000000001")},
+		{params.SongbirdChainID, songbirdActivationTime, common.Address{}},
+		{params.SongbirdChainID, songbirdActivationTime + 1, common.HexToAddress("0x3A1b3220527aBA427d1e13e4b4c48c31460B4d91")},
+		{params.SongbirdChainID, songbirdOct22ForkTime + 1, common.HexToAddress("0x0c13aDA1C7143Cf0a0795FFaB93eEBb6FAD6e4e3")},
+		{params.CostonChainID, costonActivationTime + 1, common.HexToAddress("0x947c76694491d3fD67a73688003c4d36C8780A97")},
+		{params.CostonChainID, costonOct22ForkTime + 1, common.HexToAddress("0x0c13aDA1C7143Cf0a0795FFaB93eEBb6FAD6e4e3")},
+		{params.LocalChainID, 0, common.HexToAddress("0x1000000000000000000000000000000000000001")},
+		{big.NewInt(1), flareActivationTime, common.Address{}},
+	}
+	for _, test := range tests {
+		have := GetStateConnectorAddr(test.chainID, test.blockTime)
+		if have != test.want {
+			t.Errorf("GetStateConnectorAddr(%v, %d) = %v, want %v", test.chainID, test.blockTime, have, test.want)
+		}
+		if have != (common.Address{}) && !GetStateConnectorIsActivatedAndCalled(false, test.chainID, test.blockTime, have) {
+			t.Errorf("state connector %v is not activated on chain %v at %d", have, test.chainID, test.blockTime)
+		}
+	}
+}
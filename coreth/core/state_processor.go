@@ -66,25 +66,26 @@ func NewStateProcessor(config *params.ChainConfig, bc *BlockChain, engine consen
 // the transaction messages using the statedb and applying any rewards to both
 // the processor (coinbase) and any included uncles.
 //
-// Process returns the receipts and logs accumulated during the process and
-// returns the amount of gas that was used in the process. If any of the
-// transactions failed to execute due to insufficient gas it will return an error.
-func (p *StateProcessor) Process(block *types.Block, parent *types.Header, statedb *state.StateDB, cfg vm.Config) (types.Receipts, []*types.Log, uint64, error) {
+// Process returns the receipts, logs and daemon results accumulated during the
+// process and returns the amount of gas that was used in the process. If any of
+// the transactions failed to execute due to insufficient gas it will return an error.
+func (p *StateProcessor) Process(block *types.Block, parent *types.Header, statedb *state.StateDB, cfg vm.Config) (types.Receipts, []*types.Log, types.DaemonResults, uint64, error) {
 	var (
-		receipts    types.Receipts
-		usedGas     = new(uint64)
-		header      = block.Header()
-		blockHash   = block.Hash()
-		blockNumber = block.Number()
-		allLogs     []*types.Log
-		gp          = new(GasPool).AddGas(block.GasLimit())
+		receipts      types.Receipts
+		usedGas       = new(uint64)
+		header        = block.Header()
+		blockHash     = block.Hash()
+		blockNumber   = block.Number()
+		allLogs       []*types.Log
+		daemonResults types.DaemonResults
+		gp            = new(GasPool).AddGas(block.GasLimit())
 	)
 
 	// Configure any upgrades that should go into effect during this block.
 	err := ApplyUpgrades(p.config, &parent.Time, block, statedb)
 	if err != nil {
 		log.Error("failed to configure precompiles processing block", "hash", block.Hash(), "number", block.NumberU64(), "timestamp", block.Time(), "err", err)
-		return nil, nil, 0, err
+		return nil, nil, nil, 0, err
 	}
 
 	var (
@@ -99,25 +100,28 @@ func (p *StateProcessor) Process(block *types.Block, parent *types.Header, state
 	for i, tx := range block.Transactions() {
 		msg, err := TransactionToMessage(tx, signer, header.BaseFee)
 		if err != nil {
-			return nil, nil, 0, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
+			return nil, nil, nil, 0, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
 		}
 		statedb.SetTxContext(tx.Hash(), i)
-		receipt, err := applyTransaction(msg, p.config, gp, statedb, blockNumber, blockHash, tx, usedGas, vmenv)
+		receipt, daemonResult, err := applyTransaction(msg, p.config, gp, statedb, blockNumber, blockHash, tx, usedGas, vmenv)
 		if err != nil {
-			return nil, nil, 0, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
+			return nil, nil, nil, 0, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
 		}
 		receipts = append(receipts, receipt)
 		allLogs = append(allLogs, receipt.Logs...)
+		if daemonResult != nil {
+			daemonResults = append(daemonResults, daemonResult)
+		}
 	}
 	// Finalize the block, applying any consensus engine specific extras (e.g. block rewards)
 	if err := p.engine.Finalize(p.bc, block, parent, statedb, receipts); err != nil {
-		return nil, nil, 0, fmt.Errorf("engine finalization check failed: %w", err)
+		return nil, nil, nil, 0, fmt.Errorf("engine finalization check failed: %w", err)
 	}
 
-	return receipts, allLogs, *usedGas, nil
+	return receipts, allLogs, daemonResults, *usedGas, nil
 }
 
-func applyTransaction(msg *Message, config *params.ChainConfig, gp *GasPool, statedb *state.StateDB, blockNumber *big.Int, blockHash common.Hash, tx *types.Transaction, usedGas *uint64, evm *vm.EVM) (*types.Receipt, error) {
+func applyTransaction(msg *Message, config *params.ChainConfig, gp *GasPool, statedb *state.StateDB, blockNumber *big.Int, blockHash common.Hash, tx *types.Transaction, usedGas *uint64, evm *vm.EVM) (*types.Receipt, *types.DaemonResult, error) {
 	// Create a new context to be used in the EVM environment.
 	txContext := NewEVMTxContext(msg)
 	evm.Reset(txContext, statedb)
@@ -125,7 +129,7 @@ func applyTransaction(msg *Message, config *params.ChainConfig, gp *GasPool, sta
 	// Apply the transaction to the current state (included in the env).
 	result, err := ApplyMessage(evm, msg, gp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Update the state with pending changes.
@@ -164,7 +168,19 @@ func applyTransaction(msg *Message, config *params.ChainConfig, gp *GasPool, sta
 	receipt.BlockHash = blockHash
 	receipt.BlockNumber = blockNumber
 	receipt.TransactionIndex = uint(statedb.TxIndex())
-	return receipt, err
+
+	// Attach the fee accounting of prioritised calls to the receipt.
+	if result.PrioritisedFee != nil {
+		result.PrioritisedFee.TxIndex = uint64(receipt.TransactionIndex)
+		receipt.PrioritisedFee = result.PrioritisedFee
+	}
+
+	// Attach the transaction to the daemon result, if the daemon was called.
+	if result.DaemonResult != nil {
+		result.DaemonResult.TxHash = receipt.TxHash
+		result.DaemonResult.TxIndex = uint64(receipt.TransactionIndex)
+	}
+	return receipt, result.DaemonResult, err
 }
 
 // ApplyTransaction attempts to apply a transaction to the given state database
@@ -179,7 +195,8 @@ func ApplyTransaction(config *params.ChainConfig, bc ChainContext, blockContext
 	// Create a new context to be used in the EVM environment
 	txContext := NewEVMTxContext(msg)
 	vmenv := vm.NewEVM(blockContext, txContext, statedb, config, cfg)
-	return applyTransaction(msg, config, gp, statedb, header.Number, header.Hash(), tx, usedGas, vmenv)
+	receipt, _, err := applyTransaction(msg, config, gp, statedb, header.Number, header.Hash(), tx, usedGas, vmenv)
+	return receipt, err
 }
 
 // ProcessBeaconBlockRoot applies the EIP-4788 system call to the beacon block root
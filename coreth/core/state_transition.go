@@ -27,8 +27,6 @@
 package core
 
 import (
-	"bytes"
-	"encoding/binary"
 	"fmt"
 	"math"
 	"math/big"
@@ -53,6 +51,9 @@ type ExecutionResult struct {
 	RefundedGas uint64 // Total gas refunded after execution
 	Err         error  // Any error encountered during the execution(listed in core/vm/errors.go)
 	ReturnData  []byte // Returned data from evm(function result or data supplied with revert opcode)
+
+	DaemonResult   *types.DaemonResult   // Outcome of the daemon call made after the message, nil if not called
+	PrioritisedFee *types.PrioritisedFee // Fee accounting of a prioritised call, nil if the call was not prioritised
 }
 
 // Unwrap returns the internal evm error which allows us for further
@@ -83,7 +84,7 @@ func (result *ExecutionResult) Revert() []byte {
 }
 
 func (st *StateTransition) DaemonCall(caller vm.ContractRef, addr common.Address, input []byte, gas uint64) (snapshot int, ret []byte, leftOverGas uint64, err error) {
-	return st.evm.DaemonCall(caller, addr, input, gas)
+	return st.systemCall(vm.FlareDaemon, caller.Address(), addr, input, gas)
 }
 
 func (st *StateTransition) DaemonRevertToSnapshot(snapshot int) {
@@ -103,6 +104,7 @@ func (st *StateTransition) GetGasLimit() uint64 {
 }
 
 func (st *StateTransition) AddBalance(addr common.Address, amount *uint256.Int) {
+	st.captureSystemTransfer(vm.FlareMint, common.Address{}, addr, amount)
 	st.state.AddBalance(addr, amount)
 }
 
@@ -298,6 +300,10 @@ type StateTransition struct {
 	initialGas   uint64
 	state        vm.StateDB
 	evm          *vm.EVM
+
+	// traceAttestationRound is called instead of finalising the previous
+	// state connector round, if set.
+	traceAttestationRound func(currentRoundNumber []byte)
 }
 
 // NewStateTransition initialises and returns a new state transition object.
@@ -514,16 +520,14 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 	st.state.Prepare(rules, msg.From, st.evm.Context.Coinbase, msg.To, vm.ActivePrecompiles(rules), msg.AccessList)
 
 	var (
-		ret       []byte
-		vmerr     error // vm errors do not affect consensus and are therefore not assigned to err
-		chainID   *big.Int
-		timestamp uint64
+		ret        []byte
+		vmerr      error // vm errors do not affect consensus and are therefore not assigned to err
+		chainID    = st.evm.ChainConfig().ChainID
+		timestamp  = st.evm.Context.Time
+		extensions = GetFlareExtensions(chainID)
 	)
 
-	chainID = st.evm.ChainConfig().ChainID
-	timestamp = st.evm.Context.Time
-
-	burnAddress, nominalGasPrice, isFlare, isSongbird, err := stateTransitionVariants.GetValue(chainID)(st)
+	burnAddress, err := extensions.BurnAddress(st.evm.Context.Coinbase)
 	if err != nil {
 		return nil, err
 	}
@@ -534,12 +538,8 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 		// Increment the nonce for the next transaction
 		st.state.SetNonce(msg.From, st.state.GetNonce(sender.Address())+1)
 		ret, st.gasRemaining, vmerr = st.evm.Call(sender, st.to(), msg.Data, st.gasRemaining, value)
-		if vmerr == nil && chainID != nil {
-			if isSongbird { // Songbird, Coston, Local (Songbird)
-				handleSongbirdTransitionDbContracts(st, rules.IsDurango, chainID, timestamp, msg, ret)
-			} else if isFlare { // Flare, Coston2, Local (Flare)
-				handleFlareTransitionDbContracts(st, rules.IsDurango, chainID, timestamp, msg, ret)
-			}
+		if vmerr == nil {
+			extensions.PostCall(st, rules.IsDurango, msg, ret)
 		}
 	}
 	price, overflow := uint256.FromBig(msg.GasPrice)
@@ -548,18 +548,14 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 	}
 	gasRefund := st.refundGas(rules.IsApricotPhase1)
 
-	if vmerr == nil && IsPrioritisedContractCall(chainID, timestamp, msg.To, msg.Data, ret, st.initialGas) {
-		nominalGasUsed := params.TxGas // 21000
-		nominalFee := new(uint256.Int).Mul(uint256.NewInt(nominalGasUsed), uint256.NewInt(nominalGasPrice))
-		actualGasUsed := st.gasUsed()
-		actualFee := new(uint256.Int).Mul(uint256.NewInt(actualGasUsed), price)
-		if actualFee.Cmp(nominalFee) > 0 {
-			feeRefund := new(uint256.Int).Sub(actualFee, nominalFee)
+	var prioritisedFee *types.PrioritisedFee
+	if vmerr == nil && extensions.IsPrioritisedCall(st.evm.ChainConfig(), timestamp, msg.To, msg.Data, ret, st.initialGas) {
+		fee, feeRefund := PrioritisedCallFee(extensions, st.gasUsed(), price)
+		if !feeRefund.IsZero() {
 			st.state.AddBalance(st.msg.From, feeRefund)
-			st.state.AddBalance(burnAddress, nominalFee)
-		} else {
-			st.state.AddBalance(burnAddress, actualFee)
 		}
+		st.state.AddBalance(burnAddress, fee)
+		prioritisedFee = &types.PrioritisedFee{Fee: fee.ToBig(), Refund: feeRefund.ToBig()}
 	} else {
 		fee := new(uint256.Int).SetUint64(st.gasUsed())
 		fee.Mul(fee, price)
@@ -567,67 +563,21 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 	}
 
 	// Call the daemon if there is no vm error
-	if vmerr == nil && (isSongbird || isFlare) {
-		log := log.Root()
-		atomicDaemonAndMint(st, log)
+	var daemonResult *types.DaemonResult
+	if vmerr == nil {
+		daemonResult = extensions.Daemon(st, log.Root())
 	}
 
 	return &ExecutionResult{
-		UsedGas:     st.gasUsed(),
-		RefundedGas: gasRefund,
-		Err:         vmerr,
-		ReturnData:  ret,
+		UsedGas:        st.gasUsed(),
+		RefundedGas:    gasRefund,
+		Err:            vmerr,
+		ReturnData:     ret,
+		DaemonResult:   daemonResult,
+		PrioritisedFee: prioritisedFee,
 	}, nil
 }
 
-func handleSongbirdTransitionDbContracts(st *StateTransition, isDurango bool, chainID *big.Int, timestamp uint64, msg *Message, ret []byte) {
-	if GetStateConnectorIsActivatedAndCalled(isDurango, chainID, timestamp, *msg.To) &&
-		len(msg.Data) >= 36 && len(ret) == 32 &&
-		bytes.Equal(msg.Data[0:4], SubmitAttestationSelector(chainID, timestamp)) &&
-		binary.BigEndian.Uint64(ret[24:32]) > 0 {
-		if err := st.FinalisePreviousRound(chainID, timestamp, msg.Data[4:36]); err != nil {
-			log.Warn("Error finalising state connector round", "error", err)
-		}
-	}
-}
-
-func handleFlareTransitionDbContracts(st *StateTransition, isDurango bool, chainID *big.Int, timestamp uint64, msg *Message, ret []byte) {
-	if st.evm.Context.Coinbase != common.HexToAddress("0x0100000000000000000000000000000000000000") {
-		return
-	}
-
-	if GetStateConnectorIsActivatedAndCalled(isDurango, chainID, timestamp, *msg.To) &&
-		len(msg.Data) >= 36 && len(ret) == 32 &&
-		bytes.Equal(msg.Data[0:4], SubmitAttestationSelector(chainID, timestamp)) &&
-		binary.BigEndian.Uint64(ret[24:32]) > 0 {
-		if err := st.FinalisePreviousRound(chainID, timestamp, msg.Data[4:36]); err != nil {
-			log.Warn("Error finalising state connector round", "error", err)
-		}
-	} else if GetGovernanceSettingIsActivatedAndCalled(chainID, timestamp, *msg.To) && len(msg.Data) == 36 {
-		if bytes.Equal(msg.Data[0:4], SetGovernanceAddressSelector(chainID, timestamp)) {
-			if err := st.SetGovernanceAddress(chainID, timestamp, msg.Data[4:36]); err != nil {
-				log.Warn("Error setting governance address", "error", err)
-			}
-		} else if bytes.Equal(msg.Data[0:4], SetTimelockSelector(chainID, timestamp)) {
-			if err := st.SetTimelock(chainID, timestamp, msg.Data[4:36]); err != nil {
-				log.Warn("Error setting governance timelock", "error", err)
-			}
-		}
-	} else if GetInitialAirdropChangeIsActivatedAndCalled(chainID, timestamp, *msg.To) && len(msg.Data) == 4 {
-		if bytes.Equal(msg.Data[0:4], UpdateInitialAirdropAddressSelector(chainID, timestamp)) {
-			if err := st.UpdateInitialAirdropAddress(chainID, timestamp); err != nil {
-				log.Warn("Error updating initialAirdrop contract", "error", err)
-			}
-		}
-	} else if GetDistributionChangeIsActivatedAndCalled(chainID, timestamp, *msg.To) && len(msg.Data) == 4 {
-		if bytes.Equal(msg.Data[0:4], UpdateDistributionAddressSelector(chainID, timestamp)) {
-			if err := st.UpdateDistributionAddress(chainID, timestamp); err != nil {
-				log.Warn("Error updating distribution contract", "error", err)
-			}
-		}
-	}
-}
-
 func (st *StateTransition) refundGas(apricotPhase1 bool) uint64 {
 	var refund uint64
 	// Inspired by: https://gist.github.com/holiman/460f952716a74eeb9ab358bb1836d821#gistcomment-3642048
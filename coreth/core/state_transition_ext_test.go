@@ -34,7 +34,7 @@ func TestStateTransitionPrioritizedContract(t *testing.T) {
 		from := crypto.PubkeyToAddress(key.PublicKey)
 		gas := uint64(3000000)
 		to := prioritisedSubmitterContractAddress
-		daemon := common.HexToAddress(GetDaemonContractAddr(0))
+		daemon := params.GetDaemonParams(params.FlareChainID, 0).ContractAddress
 		signer := types.LatestSignerForChainID(config.ChainID)
 		tx, err := types.SignNewTx(key, signer,
 			&types.LegacyTx{
@@ -95,20 +95,32 @@ func TestStateTransitionPrioritizedContract(t *testing.T) {
 		st := NewStateTransition(evm, msg, new(GasPool).AddGas(tx.Gas()))
 
 		balanceBefore := st.state.GetBalance(st.msg.From)
-		_, err = st.TransitionDb()
+		result, err := st.TransitionDb()
 		if err != nil {
 			t.Fatal(err)
 		}
 		balanceAfter := st.state.GetBalance(st.msg.From)
 
 		// max fee (funds above which are returned) depends on the chain used
-		_, limit, _, _, _ := stateTransitionVariants.GetValue(config.ChainID)(st)
+		limit := GetFlareExtensions(config.ChainID).NominalGasPrice()
 		maxFee := new(uint256.Int).Mul(uint256.NewInt(params.TxGas), uint256.NewInt(limit))
 		diff := new(uint256.Int).Sub(balanceBefore, balanceAfter)
 
 		if maxFee.Cmp(diff) != 0 {
 			t.Fatalf("want %v, have %v", maxFee, diff)
 		}
+
+		// the fee accounting of the prioritised call is recorded in the result
+		if result.PrioritisedFee == nil {
+			t.Fatalf("want prioritised fee, have nil")
+		}
+		if result.PrioritisedFee.Fee.Cmp(maxFee.ToBig()) != 0 {
+			t.Fatalf("want charged fee %v, have %v", maxFee, result.PrioritisedFee.Fee)
+		}
+		actualFee := new(big.Int).Mul(new(big.Int).SetUint64(result.UsedGas), tx.GasPrice())
+		if refund := new(big.Int).Sub(actualFee, maxFee.ToBig()); result.PrioritisedFee.Refund.Cmp(refund) != 0 {
+			t.Fatalf("want refund %v, have %v", refund, result.PrioritisedFee.Refund)
+		}
 	}
 }
 
@@ -125,7 +137,7 @@ func TestStateTransitionDaemon(t *testing.T) {
 		key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
 		from := crypto.PubkeyToAddress(key.PublicKey)
 		gas := uint64(3000000)
-		daemon := common.HexToAddress(GetDaemonContractAddr(0))
+		daemon := params.GetDaemonParams(params.FlareChainID, 0).ContractAddress
 		to := common.HexToAddress("0x7e22C4A78675ae3Be11Fb389Da9b9fb15996bb6a")
 		signer := types.LatestSignerForChainID(config.ChainID)
 		tx, err := types.SignNewTx(key, signer,
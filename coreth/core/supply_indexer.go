@@ -0,0 +1,175 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/coreth/core/rawdb"
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/params"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// supplyThrottling is the time to wait between processing two consecutive
+	// supply delta sections.
+	supplyThrottling = 100 * time.Millisecond
+)
+
+var errMissingReceipts = errors.New("missing receipts")
+
+// SupplyIndexer implements a core.ChainIndexer, recording the changes to the
+// native token supply made by every block of the canonical chain from the
+// first block of the index onwards.
+type SupplyIndexer struct {
+	db     ethdb.Database      // database instance to read blocks from and write index data into
+	config *params.ChainConfig // chain config used to derive the receipt fields
+	size   uint64              // section size to index supply deltas for
+	start  uint64              // number of the first block of the index
+	last   *types.SupplyDelta  // supply delta of the last processed block
+	batch  ethdb.Batch         // supply deltas of the current section
+}
+
+// NewSupplyIndexer returns a chain indexer that records the supply delta of
+// every block of the canonical chain.
+func NewSupplyIndexer(db ethdb.Database, config *params.ChainConfig, size, confirms uint64) *ChainIndexer {
+	backend := &SupplyIndexer{
+		db:     db,
+		config: config,
+		size:   size,
+	}
+	table := rawdb.NewTable(db, string(rawdb.SupplyDeltaIndexPrefix))
+
+	return NewChainIndexer(db, table, backend, size, confirms, supplyThrottling, "supply")
+}
+
+// StartSupplyIndex records the first block of the supply delta index if the
+// index is new, and skips the sections of [indexer] before it. The daemon
+// results needed to index a block are only stored by nodes running this
+// version, so the index of a chain with accepted blocks starts after
+// [lastAccepted].
+func StartSupplyIndex(db ethdb.Database, indexer *ChainIndexer, lastAccepted *types.Block) {
+	start := rawdb.ReadSupplyDeltaStart(db)
+	if start == nil {
+		number := uint64(0)
+		if lastAccepted.NumberU64() > 0 {
+			number = lastAccepted.NumberU64() + 1
+		}
+		rawdb.WriteSupplyDeltaStart(db, number)
+		start = &number
+	}
+	if sections := *start / indexer.sectionSize; sections > 0 {
+		indexer.AddCheckpoint(sections-1, rawdb.ReadCanonicalHash(db, sections*indexer.sectionSize-1))
+	}
+}
+
+// ResetSupplyIndex restarts the supply delta index at [block], which the chain
+// has been state synced to, as the blocks before it are not available.
+// Note: This requires the height of [block] to be divisible by the section
+// size of [indexer].
+func ResetSupplyIndex(db ethdb.Database, indexer *ChainIndexer, block *types.Block) {
+	rawdb.WriteSupplyDeltaStart(db, block.NumberU64())
+	if sections := block.NumberU64() / indexer.sectionSize; sections > 0 {
+		indexer.AddCheckpoint(sections-1, block.ParentHash())
+	}
+}
+
+// Reset implements core.ChainIndexerBackend, starting a new supply delta
+// section from the totals of the last block of the previous section.
+func (s *SupplyIndexer) Reset(ctx context.Context, section uint64, lastSectionHead common.Hash) error {
+	s.start, s.last, s.batch = 0, nil, s.db.NewBatch()
+	if start := rawdb.ReadSupplyDeltaStart(s.db); start != nil {
+		s.start = *start
+	}
+	first := section * s.size
+	if first <= s.start {
+		return nil
+	}
+	if s.last = rawdb.ReadSupplyDelta(s.db, first-1); s.last == nil {
+		return fmt.Errorf("missing supply delta of block %d", first-1)
+	}
+	return nil
+}
+
+// Process implements core.ChainIndexerBackend, computing the supply delta of
+// a new header. Blocks before the first block of the index are skipped, and
+// the index restarts after a block whose receipts are missing.
+func (s *SupplyIndexer) Process(ctx context.Context, header *types.Header) error {
+	number := header.Number.Uint64()
+	if number < s.start {
+		return nil
+	}
+	delta, err := ComputeSupplyDelta(s.db, s.config, header, s.last)
+	if errors.Is(err, errMissingReceipts) {
+		log.Warn("Restarting supply delta index", "number", number+1, "err", err)
+		s.start, s.last = number+1, nil
+		rawdb.WriteSupplyDeltaStart(s.batch, s.start)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	s.last = delta
+	rawdb.WriteSupplyDelta(s.batch, number, delta)
+	return nil
+}
+
+// Commit implements core.ChainIndexerBackend, writing the supply deltas of the
+// section into the database.
+func (s *SupplyIndexer) Commit() error {
+	return s.batch.Write()
+}
+
+// Prune returns an empty error since we don't support pruning here.
+func (s *SupplyIndexer) Prune(threshold uint64) error {
+	return nil
+}
+
+// ComputeSupplyDelta computes the supply delta of the block with [header] from
+// its stored receipts and daemon results. The totals are accumulated onto those
+// of [parent], the supply delta of the previous block, or zero if nil at the
+// first block of the supply delta index.
+func ComputeSupplyDelta(db ethdb.Reader, config *params.ChainConfig, header *types.Header, parent *types.SupplyDelta) (*types.SupplyDelta, error) {
+	var (
+		hash   = header.Hash()
+		number = header.Number.Uint64()
+		delta  = &types.SupplyDelta{
+			Minted:            new(big.Int),
+			Burned:            new(big.Int),
+			PrioritisedRefund: new(big.Int),
+			TotalMinted:       new(big.Int),
+			TotalBurned:       new(big.Int),
+		}
+	)
+	receipts := rawdb.ReadReceipts(db, hash, number, header.Time, config)
+	if receipts == nil {
+		return nil, fmt.Errorf("%w of block %d (%s)", errMissingReceipts, number, hash)
+	}
+	for _, receipt := range receipts {
+		if receipt.PrioritisedFee != nil {
+			delta.Burned.Add(delta.Burned, receipt.PrioritisedFee.Fee)
+			delta.PrioritisedRefund.Add(delta.PrioritisedRefund, receipt.PrioritisedFee.Refund)
+			continue
+		}
+		fee := new(big.Int).SetUint64(receipt.GasUsed)
+		delta.Burned.Add(delta.Burned, fee.Mul(fee, receipt.EffectiveGasPrice))
+	}
+	for _, result := range rawdb.ReadDaemonResults(db, hash, number) {
+		delta.Minted.Add(delta.Minted, result.Minted)
+	}
+	if parent != nil {
+		delta.TotalMinted.Set(parent.TotalMinted)
+		delta.TotalBurned.Set(parent.TotalBurned)
+	}
+	delta.TotalMinted.Add(delta.TotalMinted, delta.Minted)
+	delta.TotalBurned.Add(delta.TotalBurned, delta.Burned)
+	return delta, nil
+}
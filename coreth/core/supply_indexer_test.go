@@ -0,0 +1,200 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package core
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/coreth/consensus/dummy"
+	"github.com/ava-labs/coreth/core/rawdb"
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/core/vm"
+	"github.com/ava-labs/coreth/params"
+	"github.com/ava-labs/coreth/plugin/evm/upgrade/ap3"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/stretchr/testify/require"
+)
+
+// newSupplyTestChain returns a chain of [numBlocks] blocks, every other one
+// of which contains a transfer.
+func newSupplyTestChain(t *testing.T, numBlocks int) (ethdb.Database, *params.ChainConfig, *BlockChain) {
+	var (
+		require = require.New(t)
+		engine  = dummy.NewCoinbaseFaker()
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		addr    = crypto.PubkeyToAddress(key.PublicKey)
+		to      = common.HexToAddress("0x7e22C4A78675ae3Be11Fb389Da9b9fb15996bb6a")
+		funds   = new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))
+		daemon  = params.GetDaemonParams(params.FlareChainID, 0).ContractAddress
+		gspec   = &Genesis{
+			Config: params.TestFlareChainConfig,
+			Alloc: types.GenesisAlloc{
+				addr:   {Balance: funds},
+				daemon: {Code: daemonCode},
+			},
+			BaseFee: big.NewInt(ap3.InitialBaseFee),
+		}
+		signer = types.LatestSigner(gspec.Config)
+	)
+
+	_, blocks, _, err := GenerateChainWithGenesis(gspec, engine, numBlocks, 10, func(i int, b *BlockGen) {
+		if i%2 == 1 {
+			return
+		}
+		tx := types.NewTransaction(b.TxNonce(addr), to, big.NewInt(1), params.TxGas, big.NewInt(ap3.InitialBaseFee), nil)
+		tx, err := types.SignTx(tx, signer, key)
+		require.NoError(err)
+		b.AddTx(tx)
+	})
+	require.NoError(err)
+
+	db := rawdb.NewMemoryDatabase()
+	chain, err := NewBlockChain(db, DefaultCacheConfig, gspec, engine, vm.Config{}, common.Hash{}, false)
+	require.NoError(err)
+	t.Cleanup(chain.Stop)
+
+	_, err = chain.InsertChain(blocks)
+	require.NoError(err)
+	return db, gspec.Config, chain
+}
+
+// indexSupplySections indexes the sections of [size] blocks of [chain] up to
+// and including block [last].
+func indexSupplySections(t *testing.T, indexer *SupplyIndexer, chain *BlockChain, size, last uint64) {
+	require := require.New(t)
+
+	for section := uint64(0); section <= last/size; section++ {
+		require.NoError(indexer.Reset(context.Background(), section, common.Hash{}))
+		for number := section * size; number < (section+1)*size && number <= last; number++ {
+			require.NoError(indexer.Process(context.Background(), chain.GetHeaderByNumber(number)))
+		}
+		require.NoError(indexer.Commit())
+	}
+}
+
+func TestSupplyIndexer(t *testing.T) {
+	require := require.New(t)
+
+	const numBlocks = 6
+	db, config, chain := newSupplyTestChain(t, numBlocks)
+	blocks := make([]*types.Block, 0, numBlocks)
+	for number := uint64(1); number <= numBlocks; number++ {
+		blocks = append(blocks, chain.GetBlockByNumber(number))
+	}
+
+	// Compute the expected supply deltas block by block.
+	var (
+		expected []*types.SupplyDelta
+		parent   *types.SupplyDelta
+	)
+	for _, block := range append([]*types.Block{chain.Genesis()}, blocks...) {
+		delta, err := ComputeSupplyDelta(db, config, block.Header(), parent)
+		require.NoError(err)
+
+		minted, burned := new(big.Int), new(big.Int)
+		for _, result := range chain.GetDaemonResults(block.Hash(), block.NumberU64()) {
+			minted.Add(minted, result.Minted)
+		}
+		for _, tx := range block.Transactions() {
+			burned.Add(burned, new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), tx.GasPrice()))
+		}
+		require.Zero(minted.Cmp(delta.Minted), "block %d minted", block.NumberU64())
+		require.Zero(burned.Cmp(delta.Burned), "block %d burned", block.NumberU64())
+		require.Zero(delta.PrioritisedRefund.Sign(), "block %d prioritised refund", block.NumberU64())
+		if block.Transactions().Len() > 0 {
+			require.Positive(delta.Minted.Sign(), "block %d minted", block.NumberU64())
+			require.Positive(delta.Burned.Sign(), "block %d burned", block.NumberU64())
+		}
+		expected = append(expected, delta)
+		parent = delta
+	}
+	last := expected[numBlocks]
+	require.Zero(new(big.Int).Sub(last.TotalMinted, last.TotalBurned).Cmp(last.CirculatingSupplyChange()))
+
+	// Index the chain in sections of two blocks, resuming each section from
+	// the totals committed by the previous one.
+	const size = 2
+	indexSupplySections(t, &SupplyIndexer{db: db, config: config, size: size}, chain, size, numBlocks)
+	for number, want := range expected {
+		have := rawdb.ReadSupplyDelta(db, uint64(number))
+		require.NotNil(have, "block %d", number)
+		require.Zero(want.Minted.Cmp(have.Minted), "block %d minted", number)
+		require.Zero(want.Burned.Cmp(have.Burned), "block %d burned", number)
+		require.Zero(want.TotalMinted.Cmp(have.TotalMinted), "block %d total minted", number)
+		require.Zero(want.TotalBurned.Cmp(have.TotalBurned), "block %d total burned", number)
+	}
+
+	// A section can not be indexed before its predecessor.
+	db = rawdb.NewMemoryDatabase()
+	indexer := &SupplyIndexer{db: db, config: config, size: size}
+	require.Error(indexer.Reset(context.Background(), 1, common.Hash{}))
+}
+
+func TestSupplyIndexerStart(t *testing.T) {
+	require := require.New(t)
+
+	const (
+		numBlocks = 8
+		size      = 2
+	)
+	db, config, chain := newSupplyTestChain(t, numBlocks)
+
+	// The index of a chain with accepted blocks starts after the last accepted
+	// block, skipping the sections before it.
+	indexer := NewSupplyIndexer(db, config, size, 0)
+	defer indexer.Close()
+	StartSupplyIndex(db, indexer, chain.GetBlockByNumber(2))
+	start := rawdb.ReadSupplyDeltaStart(db)
+	require.NotNil(start)
+	require.Equal(uint64(3), *start)
+	sections, _, head := indexer.Sections()
+	require.Equal(uint64(1), sections)
+	require.Equal(chain.GetHeaderByNumber(1).Hash(), head)
+
+	// The recorded start is kept by later calls.
+	StartSupplyIndex(db, indexer, chain.GetBlockByNumber(numBlocks))
+	start = rawdb.ReadSupplyDeltaStart(db)
+	require.NotNil(start)
+	require.Equal(uint64(3), *start)
+
+	// The blocks before the start are not indexed, and the totals are
+	// accumulated from it. The index restarts after a block whose receipts
+	// are missing.
+	missing := chain.GetBlockByNumber(6)
+	rawdb.DeleteReceipts(db, missing.Hash(), missing.NumberU64())
+	indexSupplySections(t, &SupplyIndexer{db: db, config: config, size: size}, chain, size, numBlocks)
+	for number := uint64(0); number <= numBlocks; number++ {
+		delta := rawdb.ReadSupplyDelta(db, number)
+		switch number {
+		case 0, 1, 2, 6:
+			require.Nil(delta, "block %d", number)
+		case 3, 7:
+			require.NotNil(delta, "block %d", number)
+			require.Zero(delta.Minted.Cmp(delta.TotalMinted), "block %d total minted", number)
+			require.Zero(delta.Burned.Cmp(delta.TotalBurned), "block %d total burned", number)
+		default:
+			require.NotNil(delta, "block %d", number)
+			parent := rawdb.ReadSupplyDelta(db, number-1)
+			require.Zero(new(big.Int).Add(parent.TotalMinted, delta.Minted).Cmp(delta.TotalMinted), "block %d total minted", number)
+			require.Zero(new(big.Int).Add(parent.TotalBurned, delta.Burned).Cmp(delta.TotalBurned), "block %d total burned", number)
+		}
+	}
+	start = rawdb.ReadSupplyDeltaStart(db)
+	require.NotNil(start)
+	require.Equal(uint64(7), *start)
+
+	// A state synced chain restarts the index at the synced block.
+	synced := chain.GetBlockByNumber(numBlocks)
+	ResetSupplyIndex(db, indexer, synced)
+	start = rawdb.ReadSupplyDeltaStart(db)
+	require.NotNil(start)
+	require.Equal(uint64(numBlocks), *start)
+	sections, _, head = indexer.Sections()
+	require.Equal(uint64(numBlocks/size), sections)
+	require.Equal(synced.ParentHash(), head)
+}
@@ -0,0 +1,29 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DaemonResult records the outcome of a single daemon invocation, which is
+// made at the end of every successful transaction on Flare and Songbird chains.
+type DaemonResult struct {
+	TxHash  common.Hash
+	TxIndex uint64
+
+	GasUsed     uint64
+	MintRequest *big.Int // Amount requested by the daemon contract
+	Minted      *big.Int // Amount actually minted to the daemon contract
+
+	// ErrorClass is empty if the daemon call and the mint both succeeded,
+	// Error holds the full error message.
+	ErrorClass string
+	Error      string
+}
+
+// DaemonResults is a list of daemon invocations made within a block.
+type DaemonResults []*DaemonResult
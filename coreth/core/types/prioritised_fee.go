@@ -0,0 +1,29 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package types
+
+import "math/big"
+
+// PrioritisedFee records the fee accounting of a prioritised contract call,
+// which is only charged the nominal fee with the rest refunded to the sender.
+type PrioritisedFee struct {
+	TxIndex uint64
+
+	Fee    *big.Int // Fee actually charged to the sender
+	Refund *big.Int // Part of gasUsed * effectiveGasPrice refunded to the sender
+}
+
+// PrioritisedFees is a list of prioritised fees of transactions within a block.
+type PrioritisedFees []*PrioritisedFee
+
+// PrioritisedFees returns the prioritised fees attached to the receipts.
+func (rs Receipts) PrioritisedFees() PrioritisedFees {
+	var fees PrioritisedFees
+	for _, r := range rs {
+		if r.PrioritisedFee != nil {
+			fees = append(fees, r.PrioritisedFee)
+		}
+	}
+	return fees
+}
@@ -81,6 +81,10 @@ type Receipt struct {
 	BlockHash        common.Hash `json:"blockHash,omitempty"`
 	BlockNumber      *big.Int    `json:"blockNumber,omitempty"`
 	TransactionIndex uint        `json:"transactionIndex"`
+
+	// Flare: fee accounting of prioritised contract calls, nil for regular
+	// transactions. Stored separately from the receipt encoding.
+	PrioritisedFee *PrioritisedFee `json:"-"`
 }
 
 type receiptMarshaling struct {
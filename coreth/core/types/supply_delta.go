@@ -0,0 +1,23 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package types
+
+import "math/big"
+
+// SupplyDelta records the changes to the native token supply made by a block
+// on Flare and Songbird chains, together with the running totals since genesis.
+type SupplyDelta struct {
+	Minted            *big.Int // Inflation minted to the daemon contract
+	Burned            *big.Int // Transaction fees sent to the burn address
+	PrioritisedRefund *big.Int // Fees refunded to the senders of prioritised calls
+
+	TotalMinted *big.Int // Inflation minted since genesis, including this block
+	TotalBurned *big.Int // Fees burned since genesis, including this block
+}
+
+// CirculatingSupplyChange returns the change to the circulating supply since
+// genesis, which is negative if more was burned than minted.
+func (d *SupplyDelta) CirculatingSupplyChange() *big.Int {
+	return new(big.Int).Sub(d.TotalMinted, d.TotalBurned)
+}
@@ -0,0 +1,37 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package vm
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FlareSystemCallType identifies a call or balance change made by the node
+// itself during a Flare or Songbird state transition, outside of the EVM
+// execution of the transaction.
+type FlareSystemCallType string
+
+const (
+	// FlareDaemon is the daemon call made after every successful transaction.
+	FlareDaemon FlareSystemCallType = "FLARE_DAEMON"
+	// FlareMint is the inflation minted to the daemon contract.
+	FlareMint FlareSystemCallType = "FLARE_MINT"
+	// FlareStateConnector is the call finalising a state connector round.
+	FlareStateConnector FlareSystemCallType = "FLARE_STATE_CONNECTOR"
+	// FlareGovernance is a call signalling a governance settings change.
+	FlareGovernance FlareSystemCallType = "FLARE_GOVERNANCE"
+	// FlareTransfer is a balance moved between system contracts.
+	FlareTransfer FlareSystemCallType = "FLARE_TRANSFER"
+)
+
+// FlareSystemCallLogger is an optional interface of EVMLogger to collect the
+// Flare system calls of a transaction. Tracing is disabled while a system call
+// executes, so each one is reported as a single frame entered at depth 1 after
+// the top call frame has ended.
+type FlareSystemCallLogger interface {
+	CaptureFlareSystemCallStart(typ FlareSystemCallType, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int)
+	CaptureFlareSystemCallEnd(output []byte, gasUsed uint64, err error)
+}
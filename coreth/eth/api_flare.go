@@ -0,0 +1,264 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/coreth/core"
+	"github.com/ava-labs/coreth/core/rawdb"
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/params"
+	"github.com/ava-labs/coreth/rpc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// maxSupplyDeltaRange is the maximum number of blocks a single supply delta
+	// query may span.
+	maxSupplyDeltaRange = 2048
+	// maxUnindexedSupplyDeltas is the maximum number of blocks past the supply
+	// delta index a query may compute the supply deltas of on the fly.
+	maxUnindexedSupplyDeltas = 2*params.SupplyDeltaBlocks + params.SupplyDeltaConfirms + maxSupplyDeltaRange
+)
+
+var (
+	errHeaderNotFound        = errors.New("header not found")
+	errInvalidSupplyRange    = errors.New("fromBlock is greater than toBlock")
+	errSupplyIndexNotCurrent = errors.New("supply delta index is not current, try again later")
+	errSupplyIndexDisabled   = errors.New("supply delta index is not enabled")
+	errSupplyDeltaNotIndexed = errors.New("supply delta not indexed")
+)
+
+// FlareAPI provides access to the Flare specific execution records of the chain.
+type FlareAPI struct {
+	eth *Ethereum
+}
+
+// NewFlareAPI creates a new instance of FlareAPI.
+func NewFlareAPI(eth *Ethereum) *FlareAPI {
+	return &FlareAPI{eth: eth}
+}
+
+// DaemonResult is the JSON representation of a single daemon invocation.
+type DaemonResult struct {
+	TxHash      common.Hash    `json:"transactionHash"`
+	TxIndex     hexutil.Uint64 `json:"transactionIndex"`
+	GasUsed     hexutil.Uint64 `json:"gasUsed"`
+	MintRequest *hexutil.Big   `json:"mintRequest"`
+	Minted      *hexutil.Big   `json:"minted"`
+	ErrorClass  string         `json:"errorClass,omitempty"`
+	Error       string         `json:"error,omitempty"`
+}
+
+// BlockDaemonResults is the JSON representation of all daemon invocations
+// made within a block.
+type BlockDaemonResults struct {
+	BlockHash   common.Hash     `json:"blockHash"`
+	BlockNumber hexutil.Uint64  `json:"blockNumber"`
+	Results     []*DaemonResult `json:"results"`
+}
+
+func newBlockDaemonResults(hash common.Hash, number uint64, results types.DaemonResults) *BlockDaemonResults {
+	marshalled := make([]*DaemonResult, len(results))
+	for i, r := range results {
+		marshalled[i] = &DaemonResult{
+			TxHash:      r.TxHash,
+			TxIndex:     hexutil.Uint64(r.TxIndex),
+			GasUsed:     hexutil.Uint64(r.GasUsed),
+			MintRequest: (*hexutil.Big)(r.MintRequest),
+			Minted:      (*hexutil.Big)(r.Minted),
+			ErrorClass:  r.ErrorClass,
+			Error:       r.Error,
+		}
+	}
+	return &BlockDaemonResults{
+		BlockHash:   hash,
+		BlockNumber: hexutil.Uint64(number),
+		Results:     marshalled,
+	}
+}
+
+// GetDaemonResult returns the outcome of every daemon invocation made within
+// the given block. The result list is empty if the daemon was not called.
+func (api *FlareAPI) GetDaemonResult(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*BlockDaemonResults, error) {
+	header, err := api.eth.APIBackend.HeaderByNumberOrHash(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, errHeaderNotFound
+	}
+	var (
+		hash    = header.Hash()
+		number  = header.Number.Uint64()
+		results = api.eth.BlockChain().GetDaemonResults(hash, number)
+	)
+	return newBlockDaemonResults(hash, number, results), nil
+}
+
+// DaemonResults creates a subscription that is triggered each time a block
+// containing daemon invocations is accepted.
+func (api *FlareAPI) DaemonResults(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		events := make(chan core.DaemonResultsEvent)
+		eventsSub := api.eth.BlockChain().SubscribeAcceptedDaemonResultsEvent(events)
+		defer eventsSub.Unsubscribe()
+
+		for {
+			select {
+			case ev := <-events:
+				notifier.Notify(rpcSub.ID, newBlockDaemonResults(ev.Block.Hash(), ev.Block.NumberU64(), ev.Results))
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// SupplyDelta is the JSON representation of the changes to the native token
+// supply made by a single block.
+type SupplyDelta struct {
+	BlockHash         common.Hash    `json:"blockHash"`
+	BlockNumber       hexutil.Uint64 `json:"blockNumber"`
+	Minted            *hexutil.Big   `json:"minted"`
+	Burned            *hexutil.Big   `json:"burned"`
+	PrioritisedRefund *hexutil.Big   `json:"prioritisedRefund"`
+	// CirculatingSupplyChange is the change to the circulating supply since
+	// the first block of the supply delta index up to and including this block.
+	CirculatingSupplyChange *hexutil.Big `json:"circulatingSupplyChange"`
+}
+
+// SupplyDeltaRange is the JSON representation of the supply deltas of a range
+// of blocks, together with their totals over the range.
+type SupplyDeltaRange struct {
+	// FirstIndexedBlock is the first block of the supply delta index, from
+	// which the circulating supply changes are accumulated.
+	FirstIndexedBlock hexutil.Uint64 `json:"firstIndexedBlock"`
+	Blocks            []*SupplyDelta `json:"blocks"`
+	Minted            *hexutil.Big   `json:"minted"`
+	Burned            *hexutil.Big   `json:"burned"`
+	PrioritisedRefund *hexutil.Big   `json:"prioritisedRefund"`
+}
+
+// GetSupplyDelta returns the amounts minted, burned and refunded to prioritised
+// callers by every block in [fromBlock, toBlock]. The supply deltas are read
+// from the supply delta index, and computed from the stored receipts and daemon
+// results for the most recent blocks which are not indexed yet. Blocks before
+// the first block of the index are not available.
+func (api *FlareAPI) GetSupplyDelta(ctx context.Context, fromBlock rpc.BlockNumber, toBlock rpc.BlockNumber) (*SupplyDeltaRange, error) {
+	if api.eth.supplyIndexer == nil {
+		return nil, errSupplyIndexDisabled
+	}
+	from, err := api.eth.APIBackend.HeaderByNumber(ctx, fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	to, err := api.eth.APIBackend.HeaderByNumber(ctx, toBlock)
+	if err != nil {
+		return nil, err
+	}
+	if from == nil || to == nil {
+		return nil, errHeaderNotFound
+	}
+	var (
+		first = from.Number.Uint64()
+		last  = to.Number.Uint64()
+	)
+	if first > last {
+		return nil, errInvalidSupplyRange
+	}
+	if last-first >= maxSupplyDeltaRange {
+		return nil, fmt.Errorf("requested range of %d blocks exceeds the maximum of %d", last-first+1, maxSupplyDeltaRange)
+	}
+
+	var (
+		db         = api.eth.ChainDb()
+		chain      = api.eth.BlockChain()
+		config     = chain.Config()
+		indexed    = api.supplyIndexedBlocks()
+		indexStart uint64
+		prev       *types.SupplyDelta
+	)
+	if number := rawdb.ReadSupplyDeltaStart(db); number != nil {
+		indexStart = *number
+	}
+	if first < indexStart {
+		return nil, fmt.Errorf("%w: block %d is before the first indexed block %d", errSupplyDeltaNotIndexed, first, indexStart)
+	}
+	// Seek the supply delta of the block before the range, computing the
+	// unindexed blocks from the end of the index.
+	start := first
+	if from := max(indexed, indexStart); start > from {
+		if start-from > maxUnindexedSupplyDeltas {
+			return nil, errSupplyIndexNotCurrent
+		}
+		start = from
+	}
+	if start > indexStart {
+		if prev = rawdb.ReadSupplyDelta(db, start-1); prev == nil {
+			return nil, fmt.Errorf("missing supply delta of block %d", start-1)
+		}
+	}
+
+	result := &SupplyDeltaRange{
+		FirstIndexedBlock: hexutil.Uint64(indexStart),
+		Blocks:            make([]*SupplyDelta, 0, last-first+1),
+		Minted:            new(hexutil.Big),
+		Burned:            new(hexutil.Big),
+		PrioritisedRefund: new(hexutil.Big),
+	}
+	for number := start; number <= last; number++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		header := chain.GetHeaderByNumber(number)
+		if header == nil {
+			return nil, fmt.Errorf("%w: %d", errHeaderNotFound, number)
+		}
+		delta := rawdb.ReadSupplyDelta(db, number)
+		if number >= indexed || delta == nil {
+			if delta, err = core.ComputeSupplyDelta(db, config, header, prev); err != nil {
+				return nil, err
+			}
+		}
+		prev = delta
+		if number < first {
+			continue
+		}
+		result.Blocks = append(result.Blocks, &SupplyDelta{
+			BlockHash:               header.Hash(),
+			BlockNumber:             hexutil.Uint64(number),
+			Minted:                  (*hexutil.Big)(delta.Minted),
+			Burned:                  (*hexutil.Big)(delta.Burned),
+			PrioritisedRefund:       (*hexutil.Big)(delta.PrioritisedRefund),
+			CirculatingSupplyChange: (*hexutil.Big)(delta.CirculatingSupplyChange()),
+		})
+		result.Minted.ToInt().Add(result.Minted.ToInt(), delta.Minted)
+		result.Burned.ToInt().Add(result.Burned.ToInt(), delta.Burned)
+		result.PrioritisedRefund.ToInt().Add(result.PrioritisedRefund.ToInt(), delta.PrioritisedRefund)
+	}
+	return result, nil
+}
+
+// supplyIndexedBlocks returns the number of blocks covered by the sections of
+// the supply delta index, including those skipped before its first block.
+func (api *FlareAPI) supplyIndexedBlocks() uint64 {
+	sections, _, _ := api.eth.supplyIndexer.Sections()
+	return sections * params.SupplyDeltaBlocks
+}
@@ -96,6 +96,7 @@ type Ethereum struct {
 
 	bloomRequests     chan chan *bloombits.Retrieval // Channel receiving bloom data retrieval requests
 	bloomIndexer      *core.ChainIndexer             // Bloom indexer operating during block imports
+	supplyIndexer     *core.ChainIndexer             // Supply delta indexer operating during block imports
 	closeBloomHandler chan struct{}
 
 	APIBackend *EthAPIBackend
@@ -242,6 +243,11 @@ func New(
 	}
 
 	eth.bloomIndexer.Start(eth.blockchain)
+	if config.SupplyIndexEnabled {
+		eth.supplyIndexer = core.NewSupplyIndexer(chainDb, eth.blockchain.Config(), params.SupplyDeltaBlocks, params.SupplyDeltaConfirms)
+		core.StartSupplyIndex(chainDb, eth.supplyIndexer, eth.blockchain.LastAcceptedBlock())
+		eth.supplyIndexer.Start(eth.blockchain)
+	}
 
 	// Uncomment the following to enable the new blobpool
 
@@ -329,6 +335,10 @@ func (s *Ethereum) APIs() []rpc.API {
 			Namespace: "net",
 			Service:   s.netRPCService,
 			Name:      "net",
+		}, {
+			Namespace: "flare",
+			Service:   NewFlareAPI(s),
+			Name:      "flare",
 		},
 	}...)
 }
@@ -362,9 +372,10 @@ func (s *Ethereum) EventMux() *event.TypeMux          { return s.eventMux }
 func (s *Ethereum) Engine() consensus.Engine          { return s.engine }
 func (s *Ethereum) ChainDb() ethdb.Database           { return s.chainDb }
 
-func (s *Ethereum) NetVersion() uint64               { return s.networkID }
-func (s *Ethereum) ArchiveMode() bool                { return !s.config.Pruning }
-func (s *Ethereum) BloomIndexer() *core.ChainIndexer { return s.bloomIndexer }
+func (s *Ethereum) NetVersion() uint64                { return s.networkID }
+func (s *Ethereum) ArchiveMode() bool                 { return !s.config.Pruning }
+func (s *Ethereum) BloomIndexer() *core.ChainIndexer  { return s.bloomIndexer }
+func (s *Ethereum) SupplyIndexer() *core.ChainIndexer { return s.supplyIndexer }
 
 // Start implements node.Lifecycle, starting all internal goroutines needed by the
 // Ethereum protocol implementation.
@@ -381,6 +392,9 @@ func (s *Ethereum) Start() {
 // FIXME remove error from type if this will never return an error
 func (s *Ethereum) Stop() error {
 	s.bloomIndexer.Close()
+	if s.supplyIndexer != nil {
+		s.supplyIndexer.Close()
+	}
 	close(s.closeBloomHandler)
 	s.txPool.Close()
 	s.blockchain.Stop()
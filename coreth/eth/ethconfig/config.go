@@ -223,6 +223,10 @@ type Config struct {
 	// TransactionHistory can be still used to control unindexing old transactions.
 	SkipTxIndexing bool
 
+	// SupplyIndexEnabled indexes the changes to the native token supply made by
+	// every block, as returned by flare_getSupplyDelta.
+	SupplyIndexEnabled bool
+
 	// TODO: remove once we move SuggestPriceOptions to AVAX/custom API
 	PriceOptionConfig ethapi.PriceOptionConfig
 }
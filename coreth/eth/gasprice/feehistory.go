@@ -60,6 +60,10 @@ type slimBlock struct {
 	GasLimit uint64
 	BaseFee  *big.Int
 	Txs      []txGasAndReward
+
+	// Prioritised contract calls are only charged the nominal fee, so they are
+	// excluded from the reward percentiles and accounted for separately.
+	PrioritisedGasUsed uint64
 }
 
 // processBlock prepares a [slimBlock] from a retrieved block and list of
@@ -71,10 +75,14 @@ func processBlock(block *types.Block, receipts types.Receipts) *slimBlock {
 	}
 	sb.GasUsed = block.GasUsed()
 	sb.GasLimit = block.GasLimit()
-	sorter := make([]txGasAndReward, len(block.Transactions()))
+	sorter := make([]txGasAndReward, 0, len(block.Transactions()))
 	for i, tx := range block.Transactions() {
+		if receipts[i].PrioritisedFee != nil {
+			sb.PrioritisedGasUsed += receipts[i].GasUsed
+			continue
+		}
 		reward, _ := tx.EffectiveGasTip(sb.BaseFee)
-		sorter[i] = txGasAndReward{gasUsed: receipts[i].GasUsed, reward: reward}
+		sorter = append(sorter, txGasAndReward{gasUsed: receipts[i].GasUsed, reward: reward})
 	}
 	slices.SortStableFunc(sorter, func(a, b txGasAndReward) int {
 		return a.reward.Cmp(b.reward)
@@ -83,13 +91,14 @@ func processBlock(block *types.Block, receipts types.Receipts) *slimBlock {
 	return &sb
 }
 
-// processPercentiles returns baseFee, gasUsedRatio, and optionally reward percentiles (if any are
-// requested)
-func (sb *slimBlock) processPercentiles(percentiles []float64) ([]*big.Int, *big.Int, float64) {
+// processPercentiles returns baseFee, gasUsedRatio, prioritisedGasUsedRatio, and optionally reward
+// percentiles (if any are requested)
+func (sb *slimBlock) processPercentiles(percentiles []float64) ([]*big.Int, *big.Int, float64, float64) {
 	gasUsedRatio := float64(sb.GasUsed) / float64(sb.GasLimit)
+	prioritisedGasUsedRatio := float64(sb.PrioritisedGasUsed) / float64(sb.GasLimit)
 	if len(percentiles) == 0 {
 		// rewards were not requested
-		return nil, sb.BaseFee, gasUsedRatio
+		return nil, sb.BaseFee, gasUsedRatio, prioritisedGasUsedRatio
 	}
 
 	txLen := len(sb.Txs)
@@ -99,21 +108,21 @@ func (sb *slimBlock) processPercentiles(percentiles []float64) ([]*big.Int, *big
 		for i := range reward {
 			reward[i] = new(big.Int)
 		}
-		return reward, sb.BaseFee, gasUsedRatio
+		return reward, sb.BaseFee, gasUsedRatio, prioritisedGasUsedRatio
 	}
 
 	// sb transactions are already sorted by tip, so we don't need to re-sort
 	var txIndex int
 	sumGasUsed := sb.Txs[0].gasUsed
 	for i, p := range percentiles {
-		thresholdGasUsed := uint64(float64(sb.GasUsed) * p / 100)
+		thresholdGasUsed := uint64(float64(sb.GasUsed-sb.PrioritisedGasUsed) * p / 100)
 		for sumGasUsed < thresholdGasUsed && txIndex < txLen-1 {
 			txIndex++
 			sumGasUsed += sb.Txs[txIndex].gasUsed
 		}
 		reward[i] = sb.Txs[txIndex].reward
 	}
-	return reward, sb.BaseFee, gasUsedRatio
+	return reward, sb.BaseFee, gasUsedRatio, prioritisedGasUsedRatio
 }
 
 // resolveBlockRange resolves the specified block range to absolute block numbers while also
@@ -165,20 +174,22 @@ func (oracle *Oracle) resolveBlockRange(ctx context.Context, lastBlock rpc.Block
 // or blocks older than a certain age (specified in maxHistory). The first block of the
 // actually processed range is returned to avoid ambiguity when parts of the requested range
 // are not available or when the head has changed during processing this request.
-// Three arrays are returned based on the processed blocks:
+// Four arrays are returned based on the processed blocks:
 //   - reward: the requested percentiles of effective priority fees per gas of transactions in each
 //     block, sorted in ascending order and weighted by gas used.
 //   - baseFee: base fee per gas in the given block
 //   - gasUsedRatio: gasUsed/gasLimit in the given block
+//   - prioritisedGasUsedRatio: gas used by prioritised contract calls/gasLimit in the given block,
+//     these calls are excluded from the reward percentiles
 //
 // Note: baseFee includes the next block after the newest of the returned range, because this
 // value can be derived from the newest block.
-func (oracle *Oracle) FeeHistory(ctx context.Context, blocks uint64, unresolvedLastBlock rpc.BlockNumber, rewardPercentiles []float64) (*big.Int, [][]*big.Int, []*big.Int, []float64, error) {
+func (oracle *Oracle) FeeHistory(ctx context.Context, blocks uint64, unresolvedLastBlock rpc.BlockNumber, rewardPercentiles []float64) (*big.Int, [][]*big.Int, []*big.Int, []float64, []float64, error) {
 	if blocks < 1 {
-		return common.Big0, nil, nil, nil, nil // returning with no data and no error means there are no retrievable blocks
+		return common.Big0, nil, nil, nil, nil, nil // returning with no data and no error means there are no retrievable blocks
 	}
 	if len(rewardPercentiles) > maxQueryLimit {
-		return common.Big0, nil, nil, nil, fmt.Errorf("%w: over the query limit %d", errInvalidPercentile, maxQueryLimit)
+		return common.Big0, nil, nil, nil, nil, fmt.Errorf("%w: over the query limit %d", errInvalidPercentile, maxQueryLimit)
 	}
 	if blocks > oracle.maxCallBlockHistory {
 		log.Warn("Sanitizing fee history length", "requested", blocks, "truncated", oracle.maxCallBlockHistory)
@@ -186,29 +197,30 @@ func (oracle *Oracle) FeeHistory(ctx context.Context, blocks uint64, unresolvedL
 	}
 	for i, p := range rewardPercentiles {
 		if p < 0 || p > 100 {
-			return common.Big0, nil, nil, nil, fmt.Errorf("%w: %f", errInvalidPercentile, p)
+			return common.Big0, nil, nil, nil, nil, fmt.Errorf("%w: %f", errInvalidPercentile, p)
 		}
 		if i > 0 && p <= rewardPercentiles[i-1] {
-			return common.Big0, nil, nil, nil, fmt.Errorf("%w: #%d:%f >= #%d:%f", errInvalidPercentile, i-1, rewardPercentiles[i-1], i, p)
+			return common.Big0, nil, nil, nil, nil, fmt.Errorf("%w: #%d:%f >= #%d:%f", errInvalidPercentile, i-1, rewardPercentiles[i-1], i, p)
 		}
 	}
 	lastBlock, blocks, err := oracle.resolveBlockRange(ctx, unresolvedLastBlock, blocks)
 	if err != nil || blocks == 0 {
-		return common.Big0, nil, nil, nil, err
+		return common.Big0, nil, nil, nil, nil, err
 	}
 	oldestBlock := lastBlock + 1 - blocks
 
 	var (
-		reward       = make([][]*big.Int, blocks)
-		baseFee      = make([]*big.Int, blocks)
-		gasUsedRatio = make([]float64, blocks)
-		firstMissing = blocks
+		reward                  = make([][]*big.Int, blocks)
+		baseFee                 = make([]*big.Int, blocks)
+		gasUsedRatio            = make([]float64, blocks)
+		prioritisedGasUsedRatio = make([]float64, blocks)
+		firstMissing            = blocks
 	)
 
 	for blockNumber := oldestBlock; blockNumber < oldestBlock+blocks; blockNumber++ {
 		// Check if the context has errored
 		if err := ctx.Err(); err != nil {
-			return common.Big0, nil, nil, nil, err
+			return common.Big0, nil, nil, nil, nil, err
 		}
 
 		i := blockNumber - oldestBlock
@@ -218,24 +230,24 @@ func (oracle *Oracle) FeeHistory(ctx context.Context, blocks uint64, unresolvedL
 		} else {
 			block, err := oracle.backend.BlockByNumber(ctx, rpc.BlockNumber(blockNumber))
 			if err != nil {
-				return common.Big0, nil, nil, nil, err
+				return common.Big0, nil, nil, nil, nil, err
 			}
 			// getting no block and no error means we are requesting into the future (might happen because of a reorg)
 			if block == nil {
 				if i == 0 {
-					return common.Big0, nil, nil, nil, nil
+					return common.Big0, nil, nil, nil, nil, nil
 				}
 				firstMissing = i
 				break
 			}
 			receipts, err := oracle.backend.GetReceipts(ctx, block.Hash())
 			if err != nil {
-				return common.Big0, nil, nil, nil, err
+				return common.Big0, nil, nil, nil, nil, err
 			}
 			sb = processBlock(block, receipts)
 			oracle.historyCache.Add(blockNumber, sb)
 		}
-		reward[i], baseFee[i], gasUsedRatio[i] = sb.processPercentiles(rewardPercentiles)
+		reward[i], baseFee[i], gasUsedRatio[i], prioritisedGasUsedRatio[i] = sb.processPercentiles(rewardPercentiles)
 	}
 
 	if len(rewardPercentiles) != 0 {
@@ -243,6 +255,6 @@ func (oracle *Oracle) FeeHistory(ctx context.Context, blocks uint64, unresolvedL
 	} else {
 		reward = nil
 	}
-	baseFee, gasUsedRatio = baseFee[:firstMissing], gasUsedRatio[:firstMissing]
-	return new(big.Int).SetUint64(oldestBlock), reward, baseFee, gasUsedRatio, nil
+	baseFee, gasUsedRatio, prioritisedGasUsedRatio = baseFee[:firstMissing], gasUsedRatio[:firstMissing], prioritisedGasUsedRatio[:firstMissing]
+	return new(big.Int).SetUint64(oldestBlock), reward, baseFee, gasUsedRatio, prioritisedGasUsedRatio, nil
 }
@@ -38,6 +38,7 @@ import (
 
 	"github.com/ava-labs/coreth/params"
 	"github.com/ava-labs/coreth/rpc"
+	"github.com/ava-labs/coreth/trie"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -109,7 +110,7 @@ func TestFeeHistory(t *testing.T) {
 		oracle, err := NewOracle(backend, config)
 		require.NoError(t, err)
 
-		first, reward, baseFee, ratio, err := oracle.FeeHistory(context.Background(), c.count, c.last, c.percent)
+		first, reward, baseFee, ratio, prioritisedRatio, err := oracle.FeeHistory(context.Background(), c.count, c.last, c.percent)
 		backend.teardown()
 		expReward := c.expCount
 		if len(c.percent) == 0 {
@@ -129,8 +130,43 @@ func TestFeeHistory(t *testing.T) {
 		if len(ratio) != c.expCount {
 			t.Fatalf("Test case %d: gasUsedRatio array length mismatch, want %d, got %d", i, c.expCount, len(ratio))
 		}
+		if len(prioritisedRatio) != c.expCount {
+			t.Fatalf("Test case %d: prioritisedGasUsedRatio array length mismatch, want %d, got %d", i, c.expCount, len(prioritisedRatio))
+		}
 		if err != c.expErr && !errors.Is(err, c.expErr) {
 			t.Fatalf("Test case %d: error mismatch, want %v, got %v", i, c.expErr, err)
 		}
 	}
 }
+
+func TestProcessBlockPrioritisedTxs(t *testing.T) {
+	header := &types.Header{
+		Number:   big.NewInt(1),
+		GasLimit: 100_000,
+		GasUsed:  84_000,
+		BaseFee:  big.NewInt(10),
+	}
+	txs := make([]*types.Transaction, 4)
+	receipts := make([]*types.Receipt, 4)
+	for i := range txs {
+		txs[i] = types.NewTx(&types.DynamicFeeTx{
+			Nonce:     uint64(i),
+			GasTipCap: big.NewInt(int64(i + 1)),
+			GasFeeCap: big.NewInt(100),
+			Gas:       21_000,
+		})
+		receipts[i] = &types.Receipt{GasUsed: 21_000}
+	}
+	// The transaction paying the highest tip is prioritised
+	receipts[3].PrioritisedFee = &types.PrioritisedFee{Fee: big.NewInt(1), Refund: big.NewInt(2)}
+	block := types.NewBlock(header, txs, nil, receipts, trie.NewStackTrie(nil))
+
+	sb := processBlock(block, receipts)
+	require.Len(t, sb.Txs, 3)
+	require.Equal(t, uint64(21_000), sb.PrioritisedGasUsed)
+
+	reward, _, gasUsedRatio, prioritisedGasUsedRatio := sb.processPercentiles([]float64{0, 50, 100})
+	require.Equal(t, []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}, reward)
+	require.Equal(t, 0.84, gasUsedRatio)
+	require.Equal(t, 0.21, prioritisedGasUsedRatio)
+}
@@ -0,0 +1,138 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package tracers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/coreth/core"
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/core/vm"
+	"github.com/ava-labs/coreth/rpc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// AttestationVotesResult is the tally of the votes of a set of attestors on a
+// state connector round.
+type AttestationVotesResult struct {
+	Attestors          []common.Address            `json:"attestors"`
+	ReachedMajority    bool                        `json:"reachedMajority"`
+	MajorityDecision   string                      `json:"majorityDecision,omitempty"`
+	MajorityAttestors  []common.Address            `json:"majorityAttestors"`
+	DivergentAttestors []common.Address            `json:"divergentAttestors"`
+	AbstainedAttestors []common.Address            `json:"abstainedAttestors"`
+	HashFrequencies    map[string][]common.Address `json:"hashFrequencies"`
+}
+
+// AttestationRoundResult is the outcome of re-counting the votes on a state
+// connector round.
+type AttestationRoundResult struct {
+	RoundNumber *hexutil.Big            `json:"roundNumber"`
+	BlockNumber hexutil.Uint64          `json:"blockNumber"`
+	BlockHash   common.Hash             `json:"blockHash"`
+	TxHash      common.Hash             `json:"txHash"`
+	TxIndex     hexutil.Uint64          `json:"txIndex"`
+	Default     *AttestationVotesResult `json:"default"`
+	Local       *AttestationVotesResult `json:"local,omitempty"`
+}
+
+func newAttestationVotesResult(round *core.AttestationRound) *AttestationVotesResult {
+	if round == nil {
+		return nil
+	}
+	result := &AttestationVotesResult{
+		Attestors:          round.Attestors,
+		ReachedMajority:    round.Votes.ReachedMajority(),
+		MajorityAttestors:  round.Votes.MajorityAttestors(),
+		DivergentAttestors: round.Votes.DivergentAttestors(),
+		AbstainedAttestors: round.Votes.AbstainedAttestors(),
+		HashFrequencies:    make(map[string][]common.Address, len(round.HashFrequencies)),
+	}
+	if round.Votes.ReachedMajority() {
+		result.MajorityDecision = "0x" + round.Votes.MajorityDecision()
+	}
+	for hash, attestors := range round.HashFrequencies {
+		result.HashFrequencies["0x"+hash] = attestors
+	}
+	return result
+}
+
+// TraceAttestationRound re-counts the votes of the default and local attestors
+// on the state connector round finalised by the submission of the attestation
+// for [roundNumber] in the given block, without finalising the round. The block
+// is required, as the node doesn't index the block finalising each round. The
+// block is re-executed up to the submitting transaction, and the votes are
+// counted at the point of the transaction where the node finalises the round.
+func (api *API) TraceAttestationRound(ctx context.Context, roundNumber hexutil.Big, blockNrOrHash rpc.BlockNumberOrHash, config *TraceConfig) (*AttestationRoundResult, error) {
+	if roundNumber.ToInt().Sign() < 0 || roundNumber.ToInt().BitLen() > 256 {
+		return nil, errors.New("invalid round number")
+	}
+	var (
+		err   error
+		block *types.Block
+	)
+	if hash, ok := blockNrOrHash.Hash(); ok {
+		block, err = api.blockByHash(ctx, hash)
+	} else if number, ok := blockNrOrHash.Number(); ok {
+		if number == rpc.PendingBlockNumber {
+			return nil, errors.New("tracing on top of pending is not supported")
+		}
+		block, err = api.blockByNumber(ctx, number)
+	} else {
+		return nil, errors.New("invalid arguments; neither block nor hash specified")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if block.NumberU64() == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	// Find the transaction submitting the attestation
+	var (
+		chainConfig  = api.backend.ChainConfig()
+		isDurango    = chainConfig.IsDurango(block.Time())
+		currentRound = common.BigToHash(roundNumber.ToInt()).Bytes()
+		txIndex      = -1
+		submittingTx *types.Transaction
+	)
+	for i, tx := range block.Transactions() {
+		if core.IsSubmitAttestationTx(isDurango, chainConfig.ChainID, block.Time(), tx.To(), tx.Data(), currentRound) {
+			txIndex, submittingTx = i, tx
+			break
+		}
+	}
+	if submittingTx == nil {
+		return nil, fmt.Errorf("no attestation for round %s submitted in block %d", roundNumber.ToInt(), block.NumberU64())
+	}
+
+	reexec := defaultTraceReexec
+	if config != nil && config.Reexec != nil {
+		reexec = *config.Reexec
+	}
+	msg, vmctx, statedb, release, err := api.backend.StateAtTransaction(ctx, block, txIndex, reexec)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	statedb.SetTxContext(submittingTx.Hash(), txIndex)
+	vmenv := vm.NewEVM(vmctx, core.NewEVMTxContext(msg), statedb, chainConfig, vm.Config{})
+	defaultRound, localRound, err := core.TraceSubmitAttestation(vmenv, msg, new(core.GasPool).AddGas(msg.GasLimit))
+	if err != nil {
+		return nil, err
+	}
+	return &AttestationRoundResult{
+		RoundNumber: &roundNumber,
+		BlockNumber: hexutil.Uint64(block.NumberU64()),
+		BlockHash:   block.Hash(),
+		TxHash:      submittingTx.Hash(),
+		TxIndex:     hexutil.Uint64(txIndex),
+		Default:     newAttestationVotesResult(defaultRound),
+		Local:       newAttestationVotesResult(localRound),
+	}, nil
+}
@@ -0,0 +1,89 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package tracers
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/coreth/core"
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/params"
+	"github.com/ava-labs/coreth/rpc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceAttestationRound(t *testing.T) {
+	t.Parallel()
+
+	var (
+		accounts       = newAccounts(1)
+		stateConnector = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		signer         = types.HomesteadSigner{}
+		nonce          = uint64(0)
+	)
+	genesis := &core.Genesis{
+		Config:    params.TestFlareBanffChainConfig,
+		Timestamp: uint64(time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC).Unix()),
+		Alloc: types.GenesisAlloc{
+			accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+			// Stores the merkle root submitted with an attestation, returning 1,
+			// and returns the stored merkle root from every other call.
+			stateConnector: {Code: common.FromHex("0x60003560e01c63cfd1fdad14601a5760005460005260206000f35b602435600055600160005260206000f3"), Balance: new(big.Int)},
+		},
+	}
+	// submitAttestation submits [merkleRoot] for [round].
+	submitAttestation := func(b *core.BlockGen, round int64, merkleRoot int64) {
+		data := core.SubmitAttestationSelector(params.FlareChainID, b.Timestamp())
+		data = append(data, common.BigToHash(big.NewInt(round)).Bytes()...)
+		data = append(data, common.BigToHash(big.NewInt(merkleRoot)).Bytes()...)
+		tx, _ := types.SignTx(types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			To:       &stateConnector,
+			Gas:      100_000,
+			GasPrice: b.BaseFee(),
+			Data:     data,
+		}), signer, accounts[0].key)
+		b.AddTx(tx)
+		nonce++
+	}
+	backend := newTestBackend(t, 1, genesis, func(i int, b *core.BlockGen) {
+		// Rounds are only finalised in blocks of the system coinbase on Flare
+		b.SetCoinbase(common.HexToAddress("0x0100000000000000000000000000000000000000"))
+		submitAttestation(b, 10, 0x2a)
+		submitAttestation(b, 11, 0x2b)
+	})
+	defer backend.teardown()
+	api := NewAPI(backend)
+
+	// The votes on round 10 are counted after the first transaction, before the
+	// second one changes the merkle root.
+	result, err := api.TraceAttestationRound(context.Background(), hexutil.Big(*big.NewInt(10)), rpc.BlockNumberOrHashWithNumber(1), nil)
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Uint64(1), result.BlockNumber)
+	require.Equal(t, hexutil.Uint64(0), result.TxIndex)
+	require.Nil(t, result.Local)
+
+	decision := common.BigToHash(big.NewInt(0x2a)).Hex()
+	require.True(t, result.Default.ReachedMajority)
+	require.Equal(t, decision, result.Default.MajorityDecision)
+	require.Len(t, result.Default.Attestors, 9)
+	require.Equal(t, result.Default.Attestors, result.Default.MajorityAttestors)
+	require.Empty(t, result.Default.DivergentAttestors)
+	require.Empty(t, result.Default.AbstainedAttestors)
+	require.Equal(t, map[string][]common.Address{decision: result.Default.Attestors}, result.Default.HashFrequencies)
+
+	result, err = api.TraceAttestationRound(context.Background(), hexutil.Big(*big.NewInt(11)), rpc.BlockNumberOrHashWithNumber(1), nil)
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Uint64(1), result.TxIndex)
+	require.Equal(t, common.BigToHash(big.NewInt(0x2b)).Hex(), result.Default.MajorityDecision)
+
+	// No attestation was submitted for round 12
+	_, err = api.TraceAttestationRound(context.Background(), hexutil.Big(*big.NewInt(12)), rpc.BlockNumberOrHashWithNumber(1), nil)
+	require.ErrorContains(t, err, "no attestation for round 12")
+}
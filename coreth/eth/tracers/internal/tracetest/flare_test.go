@@ -0,0 +1,122 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package tracetest
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/coreth/core"
+	"github.com/ava-labs/coreth/core/rawdb"
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/core/vm"
+	"github.com/ava-labs/coreth/eth/tracers"
+	"github.com/ava-labs/coreth/params"
+	"github.com/ava-labs/coreth/tests"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TestFlareSystemCalls checks the daemon call and the inflation it mints are
+// reported as synthetic frames after the top call frame.
+func TestFlareSystemCalls(t *testing.T) {
+	var (
+		config    = params.TestFlareLaunchConfig
+		to        = common.HexToAddress("0x00000000000000000000000000000000deadbeef")
+		origin    = common.HexToAddress("0x00000000000000000000000000000000feed")
+		txContext = vm.TxContext{
+			Origin:   origin,
+			GasPrice: big.NewInt(1),
+		}
+		context = vm.BlockContext{
+			CanTransfer: core.CanTransfer,
+			Transfer:    core.Transfer,
+			Coinbase:    common.Address{},
+			BlockNumber: new(big.Int).SetUint64(8000000),
+			Time:        5,
+			Difficulty:  big.NewInt(0x30000),
+			GasLimit:    uint64(6000000),
+		}
+	)
+	mkTracer := func(name string, cfg json.RawMessage) tracers.Tracer {
+		tr, err := tracers.DefaultDirectory.New(name, nil, cfg)
+		if err != nil {
+			t.Fatalf("failed to create call tracer: %v", err)
+		}
+		return tr
+	}
+
+	for _, tc := range []struct {
+		name   string
+		tracer tracers.Tracer
+		want   string
+	}{
+		{
+			name:   "callTracer",
+			tracer: mkTracer("callTracer", nil),
+			want:   `{"from":"0x000000000000000000000000000000000000feed","gas":"0x13880","gasUsed":"0x5208","to":"0x00000000000000000000000000000000deadbeef","input":"0x","calls":[{"from":"0x1000000000000000000000000000000000000002","gas":"0x23c34600","gasUsed":"0x12","to":"0x1000000000000000000000000000000000000002","input":"0x7fec8d38","output":"0x0000000000000000000000000000000000000000000000000000000000000005","value":"0x0","type":"FLARE_DAEMON"},{"from":"0x0000000000000000000000000000000000000000","gas":"0x0","gasUsed":"0x0","to":"0x1000000000000000000000000000000000000002","input":"0x","value":"0x5","type":"FLARE_MINT"}],"value":"0x0","type":"CALL"}`,
+		},
+		{
+			name:   "callTracer only top call",
+			tracer: mkTracer("callTracer", json.RawMessage(`{"onlyTopCall": true}`)),
+			want:   `{"from":"0x000000000000000000000000000000000000feed","gas":"0x13880","gasUsed":"0x5208","to":"0x00000000000000000000000000000000deadbeef","input":"0x","value":"0x0","type":"CALL"}`,
+		},
+		{
+			name:   "flatCallTracer",
+			tracer: mkTracer("flatCallTracer", nil),
+			want:   `[{"action":{"callType":"call","from":"0x000000000000000000000000000000000000feed","gas":"0x13880","input":"0x","to":"0x00000000000000000000000000000000deadbeef","value":"0x0"},"blockHash":null,"blockNumber":0,"result":{"gasUsed":"0x5208","output":"0x"},"subtraces":2,"traceAddress":[],"transactionHash":null,"transactionPosition":0,"type":"call"},{"action":{"callType":"call","from":"0x1000000000000000000000000000000000000002","gas":"0x23c34600","input":"0x7fec8d38","to":"0x1000000000000000000000000000000000000002","value":"0x0"},"blockHash":null,"blockNumber":0,"result":{"gasUsed":"0x12","output":"0x0000000000000000000000000000000000000000000000000000000000000005"},"subtraces":0,"traceAddress":[0],"transactionHash":null,"transactionPosition":0,"type":"FLARE_DAEMON"},{"action":{"callType":"call","from":"0x0000000000000000000000000000000000000000","gas":"0x0","input":"0x","to":"0x1000000000000000000000000000000000000002","value":"0x5"},"blockHash":null,"blockNumber":0,"result":{"gasUsed":"0x0","output":"0x"},"subtraces":0,"traceAddress":[1],"transactionHash":null,"transactionPosition":0,"type":"FLARE_MINT"}]`,
+		},
+		{
+			name:   "prestateTracer diff mode",
+			tracer: mkTracer("prestateTracer", json.RawMessage(`{"diffMode": true}`)),
+			want:   `{"post":{"0x000000000000000000000000000000000000feed":{"balance":"0x1c6bf52634000","nonce":1},"0x1000000000000000000000000000000000000002":{"balance":"0x5"}},"pre":{"0x000000000000000000000000000000000000feed":{"balance":"0x1c6bf52647880"},"0x1000000000000000000000000000000000000002":{"balance":"0x0","code":"0x600560005260206000f3"}}}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			state := tests.MakePreState(rawdb.NewMemoryDatabase(),
+				types.GenesisAlloc{
+					to: types.GenesisAccount{
+						Code: []byte{byte(vm.STOP)},
+					},
+					origin: types.GenesisAccount{
+						Balance: big.NewInt(500000000000000),
+					},
+					// Requests a mint of 5 wei
+					params.GetDaemonParams(params.FlareChainID, 0).ContractAddress: types.GenesisAccount{
+						Code:    common.FromHex("0x600560005260206000f3"),
+						Balance: big.NewInt(0),
+					},
+				}, false, rawdb.HashScheme)
+			defer state.Close()
+
+			evm := vm.NewEVM(context, txContext, state.StateDB, config, vm.Config{Tracer: tc.tracer})
+			msg := &core.Message{
+				To:                &to,
+				From:              origin,
+				Value:             big.NewInt(0),
+				GasLimit:          80000,
+				GasPrice:          big.NewInt(0),
+				GasFeeCap:         big.NewInt(0),
+				GasTipCap:         big.NewInt(0),
+				SkipAccountChecks: false,
+			}
+			st := core.NewStateTransition(evm, msg, new(core.GasPool).AddGas(msg.GasLimit))
+			result, err := st.TransitionDb()
+			if err != nil {
+				t.Fatalf("test %v: failed to execute transaction: %v", tc.name, err)
+			}
+			if result.DaemonResult == nil || result.DaemonResult.Minted.Cmp(big.NewInt(5)) != 0 {
+				t.Fatalf("test %v: unexpected daemon result %+v", tc.name, result.DaemonResult)
+			}
+			// Retrieve the trace result and compare against the expected
+			res, err := tc.tracer.GetResult()
+			if err != nil {
+				t.Fatalf("test %v: failed to retrieve trace result: %v", tc.name, err)
+			}
+			if string(res) != tc.want {
+				t.Errorf("test %v: trace mismatch\n have: %v\n want: %v\n", tc.name, string(res), tc.want)
+			}
+		})
+	}
+}
@@ -57,23 +57,27 @@ type callLog struct {
 }
 
 type callFrame struct {
-	Type         vm.OpCode       `json:"-"`
-	From         common.Address  `json:"from"`
-	Gas          uint64          `json:"gas"`
-	GasUsed      uint64          `json:"gasUsed"`
-	To           *common.Address `json:"to,omitempty" rlp:"optional"`
-	Input        []byte          `json:"input" rlp:"optional"`
-	Output       []byte          `json:"output,omitempty" rlp:"optional"`
-	Error        string          `json:"error,omitempty" rlp:"optional"`
-	RevertReason string          `json:"revertReason,omitempty"`
-	Calls        []callFrame     `json:"calls,omitempty" rlp:"optional"`
-	Logs         []callLog       `json:"logs,omitempty" rlp:"optional"`
+	Type         vm.OpCode              `json:"-"`
+	SystemCall   vm.FlareSystemCallType `json:"-" rlp:"-"` // Set for the Flare system calls made outside of the EVM
+	From         common.Address         `json:"from"`
+	Gas          uint64                 `json:"gas"`
+	GasUsed      uint64                 `json:"gasUsed"`
+	To           *common.Address        `json:"to,omitempty" rlp:"optional"`
+	Input        []byte                 `json:"input" rlp:"optional"`
+	Output       []byte                 `json:"output,omitempty" rlp:"optional"`
+	Error        string                 `json:"error,omitempty" rlp:"optional"`
+	RevertReason string                 `json:"revertReason,omitempty"`
+	Calls        []callFrame            `json:"calls,omitempty" rlp:"optional"`
+	Logs         []callLog              `json:"logs,omitempty" rlp:"optional"`
 	// Placed at end on purpose. The RLP will be decoded to 0 instead of
 	// nil if there are non-empty elements after in the struct.
 	Value *big.Int `json:"value,omitempty" rlp:"optional"`
 }
 
 func (f callFrame) TypeString() string {
+	if f.SystemCall != "" {
+		return string(f.SystemCall)
+	}
 	return f.Type.String()
 }
 
@@ -254,6 +258,35 @@ func (t *callTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
 	t.callstack[size-1].Calls = append(t.callstack[size-1].Calls, call)
 }
 
+// CaptureFlareSystemCallStart is called when a Flare system call is made after
+// the top call frame has ended.
+func (t *callTracer) CaptureFlareSystemCallStart(typ vm.FlareSystemCallType, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if t.config.OnlyTopCall {
+		return
+	}
+	// Skip if tracing was interrupted
+	if t.interrupt.Load() {
+		return
+	}
+
+	toCopy := to
+	call := callFrame{
+		Type:       vm.CALL,
+		SystemCall: typ,
+		From:       from,
+		To:         &toCopy,
+		Input:      common.CopyBytes(input),
+		Gas:        gas,
+		Value:      value,
+	}
+	t.callstack = append(t.callstack, call)
+}
+
+// CaptureFlareSystemCallEnd is called when a Flare system call finishes.
+func (t *callTracer) CaptureFlareSystemCallEnd(output []byte, gasUsed uint64, err error) {
+	t.CaptureExit(output, gasUsed, err)
+}
+
 func (t *callTracer) CaptureTxStart(gasLimit uint64) {
 	t.gasLimit = gasLimit
 }
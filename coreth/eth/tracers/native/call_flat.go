@@ -212,6 +212,17 @@ func (t *flatCallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
 	}
 }
 
+// CaptureFlareSystemCallStart is called when a Flare system call is made after
+// the top call frame has ended.
+func (t *flatCallTracer) CaptureFlareSystemCallStart(typ vm.FlareSystemCallType, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	t.tracer.CaptureFlareSystemCallStart(typ, from, to, input, gas, value)
+}
+
+// CaptureFlareSystemCallEnd is called when a Flare system call finishes.
+func (t *flatCallTracer) CaptureFlareSystemCallEnd(output []byte, gasUsed uint64, err error) {
+	t.tracer.CaptureFlareSystemCallEnd(output, gasUsed, err)
+}
+
 func (t *flatCallTracer) CaptureTxStart(gasLimit uint64) {
 	t.tracer.CaptureTxStart(gasLimit)
 }
@@ -255,12 +266,14 @@ func (t *flatCallTracer) isPrecompiled(addr common.Address) bool {
 
 func flatFromNested(input *callFrame, traceAddress []int, convertErrs bool, ctx *tracers.Context) (output []flatCallFrame, err error) {
 	var frame *flatCallFrame
-	switch input.Type {
-	case vm.CREATE, vm.CREATE2:
+	switch {
+	case input.SystemCall != "":
+		frame = newFlatSystemCall(input)
+	case input.Type == vm.CREATE, input.Type == vm.CREATE2:
 		frame = newFlatCreate(input)
-	case vm.SELFDESTRUCT:
+	case input.Type == vm.SELFDESTRUCT:
 		frame = newFlatSelfdestruct(input)
-	case vm.CALL, vm.STATICCALL, vm.CALLCODE, vm.DELEGATECALL:
+	case input.Type == vm.CALL, input.Type == vm.STATICCALL, input.Type == vm.CALLCODE, input.Type == vm.DELEGATECALL:
 		frame = newFlatCall(input)
 	default:
 		return nil, fmt.Errorf("unrecognized call frame type: %s", input.Type)
@@ -341,6 +354,14 @@ func newFlatCall(input *callFrame) *flatCallFrame {
 	}
 }
 
+// newFlatSystemCall returns a call frame tagged with the type of the Flare
+// system call, e.g. "FLARE_DAEMON".
+func newFlatSystemCall(input *callFrame) *flatCallFrame {
+	frame := newFlatCall(input)
+	frame.Type = string(input.SystemCall)
+	return frame
+}
+
 func newFlatSelfdestruct(input *callFrame) *flatCallFrame {
 	return &flatCallFrame{
 		Type: "suicide",
@@ -111,6 +111,25 @@ func (t *muxTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
 	}
 }
 
+// CaptureFlareSystemCallStart is called when a Flare system call is made after
+// the top call frame has ended.
+func (t *muxTracer) CaptureFlareSystemCallStart(typ vm.FlareSystemCallType, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	for _, t := range t.tracers {
+		if logger, ok := t.(vm.FlareSystemCallLogger); ok {
+			logger.CaptureFlareSystemCallStart(typ, from, to, input, gas, value)
+		}
+	}
+}
+
+// CaptureFlareSystemCallEnd is called when a Flare system call finishes.
+func (t *muxTracer) CaptureFlareSystemCallEnd(output []byte, gasUsed uint64, err error) {
+	for _, t := range t.tracers {
+		if logger, ok := t.(vm.FlareSystemCallLogger); ok {
+			logger.CaptureFlareSystemCallEnd(output, gasUsed, err)
+		}
+	}
+}
+
 func (t *muxTracer) CaptureTxStart(gasLimit uint64) {
 	for _, t := range t.tracers {
 		t.CaptureTxStart(gasLimit)
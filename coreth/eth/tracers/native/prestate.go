@@ -189,6 +189,22 @@ func (t *prestateTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64,
 	}
 }
 
+// CaptureFlareSystemCallStart is called when a Flare system call is made after
+// the top call frame has ended. The storage accessed by the call is not traced.
+func (t *prestateTracer) CaptureFlareSystemCallStart(typ vm.FlareSystemCallType, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	// Skip if tracing was interrupted
+	if t.interrupt.Load() {
+		return
+	}
+	if typ != vm.FlareMint {
+		t.lookupAccount(from)
+	}
+	t.lookupAccount(to)
+}
+
+// CaptureFlareSystemCallEnd is called when a Flare system call finishes.
+func (t *prestateTracer) CaptureFlareSystemCallEnd(output []byte, gasUsed uint64, err error) {}
+
 func (t *prestateTracer) CaptureTxStart(gasLimit uint64) {
 	t.gasLimit = gasLimit
 }
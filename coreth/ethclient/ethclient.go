@@ -637,6 +637,8 @@ type feeHistoryResultMarshaling struct {
 	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
 	BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
 	GasUsedRatio []float64        `json:"gasUsedRatio"`
+
+	PrioritisedGasUsedRatio []float64 `json:"prioritisedGasUsedRatio"`
 }
 
 // FeeHistory retrieves the fee market history.
@@ -661,6 +663,8 @@ func (ec *client) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *
 		Reward:       reward,
 		BaseFee:      baseFee,
 		GasUsedRatio: res.GasUsedRatio,
+
+		PrioritisedGasUsedRatio: res.PrioritisedGasUsedRatio,
 	}, nil
 }
 
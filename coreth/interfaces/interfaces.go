@@ -195,6 +195,8 @@ type FeeHistory struct {
 	Reward       [][]*big.Int // list every txs priority fee per block
 	BaseFee      []*big.Int   // list of each block's base fee
 	GasUsedRatio []float64    // ratio of gas used out of the total available limit
+
+	PrioritisedGasUsedRatio []float64 // ratio of gas used by prioritised contract calls out of the total available limit
 }
 
 // An AcceptedStateReceiver provides access to the accepted state ie. the state of the
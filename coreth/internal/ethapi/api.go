@@ -104,21 +104,23 @@ func (s *EthereumAPI) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, e
 }
 
 type feeHistoryResult struct {
-	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
-	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
-	BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
-	GasUsedRatio []float64        `json:"gasUsedRatio"`
+	OldestBlock             *hexutil.Big     `json:"oldestBlock"`
+	Reward                  [][]*hexutil.Big `json:"reward,omitempty"`
+	BaseFee                 []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+	GasUsedRatio            []float64        `json:"gasUsedRatio"`
+	PrioritisedGasUsedRatio []float64        `json:"prioritisedGasUsedRatio"`
 }
 
 // FeeHistory returns the fee market history.
 func (s *EthereumAPI) FeeHistory(ctx context.Context, blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*feeHistoryResult, error) {
-	oldest, reward, baseFee, gasUsed, err := s.b.FeeHistory(ctx, uint64(blockCount), lastBlock, rewardPercentiles)
+	oldest, reward, baseFee, gasUsed, prioritisedGasUsed, err := s.b.FeeHistory(ctx, uint64(blockCount), lastBlock, rewardPercentiles)
 	if err != nil {
 		return nil, err
 	}
 	results := &feeHistoryResult{
-		OldestBlock:  (*hexutil.Big)(oldest),
-		GasUsedRatio: gasUsed,
+		OldestBlock:             (*hexutil.Big)(oldest),
+		GasUsedRatio:            gasUsed,
+		PrioritisedGasUsedRatio: prioritisedGasUsed,
 	}
 	if reward != nil {
 		results.Reward = make([][]*hexutil.Big, len(reward))
@@ -1126,10 +1128,20 @@ func doCall(ctx context.Context, b Backend, args TransactionArgs, state *state.S
 func DoCall(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *StateOverride, blockOverrides *BlockOverrides, timeout time.Duration, globalGasCap uint64) (*core.ExecutionResult, error) {
 	defer func(start time.Time) { log.Debug("Executing EVM call finished", "runtime", time.Since(start)) }(time.Now())
 
-	state, header, err := b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	state, header, err := callStateAndHeader(ctx, b, blockNrOrHash)
 	if state == nil || err != nil {
 		return nil, err
 	}
+	return doCall(ctx, b, args, state, header, overrides, blockOverrides, timeout, globalGasCap)
+}
+
+// callStateAndHeader returns the state and header a call at [blockNrOrHash] is
+// executed on.
+func callStateAndHeader(ctx context.Context, b Backend, blockNrOrHash rpc.BlockNumberOrHash) (*state.StateDB, *types.Header, error) {
+	state, header, err := b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, nil, err
+	}
 
 	// If the request is for the pending block, override the block timestamp, number, and estimated
 	// base fee, so that the check runs as if it were run on a newly generated block.
@@ -1144,12 +1156,11 @@ func DoCall(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash
 		header.Number = new(big.Int).Add(header.Number, big.NewInt(1))
 		estimatedBaseFee, err := b.EstimateBaseFee(ctx)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		header.BaseFee = estimatedBaseFee
 	}
-
-	return doCall(ctx, b, args, state, header, overrides, blockOverrides, timeout, globalGasCap)
+	return state, header, nil
 }
 
 // Call executes the given transaction on the state for the given block number.
@@ -1747,6 +1758,14 @@ func marshalReceipt(receipt *types.Receipt, blockHash common.Hash, blockNumber u
 	if receipt.ContractAddress != (common.Address{}) {
 		fields["contractAddress"] = receipt.ContractAddress
 	}
+
+	// Prioritised contract calls are only charged the nominal fee, the rest of
+	// gasUsed * effectiveGasPrice is refunded to the sender.
+	if receipt.PrioritisedFee != nil {
+		fields["prioritised"] = true
+		fields["chargedFee"] = (*hexutil.Big)(receipt.PrioritisedFee.Fee)
+		fields["feeRefund"] = (*hexutil.Big)(receipt.PrioritisedFee.Refund)
+	}
 	return fields
 }
 
@@ -0,0 +1,141 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package ethapi
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/coreth/core"
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/rpc"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/holiman/uint256"
+)
+
+// FlareCallResult is the outcome of a call simulated with the Flare specific
+// effects of the state transition.
+type FlareCallResult struct {
+	UsedGas    hexutil.Uint64 `json:"gas"`        // Total used gas, not including the refunded gas
+	ErrCode    int            `json:"errCode"`    // EVM error code
+	Err        string         `json:"err"`        // Any error encountered during the execution
+	ReturnData hexutil.Bytes  `json:"returnData"` // Data from evm(function result or data supplied with revert opcode)
+
+	// Prioritised is true if the call is only charged the nominal fee, otherwise
+	// PrioritisedReason classifies why the full fee is charged.
+	Prioritised       bool   `json:"prioritised"`
+	PrioritisedReason string `json:"prioritisedReason,omitempty"`
+
+	// GasPrice is the price the fees are computed at, the base fee of the block
+	// if the call does not specify one.
+	GasPrice   *hexutil.Big `json:"gasPrice"`
+	ChargedFee *hexutil.Big `json:"chargedFee"` // Fee the sender would pay
+	FeeRefund  *hexutil.Big `json:"feeRefund"`  // Part of gas * gasPrice refunded to the sender
+
+	Daemon *FlareCallDaemonResult `json:"daemon,omitempty"` // Outcome of the daemon call, if made
+}
+
+// FlareCallDaemonResult is the outcome of the daemon call made after a
+// simulated call.
+type FlareCallDaemonResult struct {
+	GasUsed     hexutil.Uint64 `json:"gasUsed"`
+	MintRequest *hexutil.Big   `json:"mintRequest"`
+	Minted      *hexutil.Big   `json:"minted"`
+	ErrorClass  string         `json:"errorClass,omitempty"`
+	Error       string         `json:"error,omitempty"`
+}
+
+// SimulateFlareCall performs the same call as CallDetailed, and additionally
+// reports whether the call would be charged the nominal fee of a prioritised
+// contract call, the fee the sender would pay and the outcome of the daemon
+// call made after it.
+//
+// Note that the prioritised gas cap applies to the gas limit of the call, which
+// is estimated as by eth_estimateGas if not specified.
+func (s *BlockChainAPI) SimulateFlareCall(ctx context.Context, args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *StateOverride) (*FlareCallResult, error) {
+	if args.Gas == nil {
+		// Calls failing at any gas limit are simulated with the RPC gas cap to
+		// report their error.
+		if gas, err := DoEstimateGas(ctx, s.b, args, blockNrOrHash, overrides, s.b.RPCGasCap()); err == nil {
+			args.Gas = &gas
+		}
+	}
+	state, header, err := callStateAndHeader(ctx, s.b, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	result, err := doCall(ctx, s.b, args, state, header, overrides, nil, s.b.RPCEVMTimeout(), s.b.RPCGasCap())
+	if err != nil {
+		return nil, err
+	}
+	msg, err := args.ToMessage(s.b.RPCGasCap(), header.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &FlareCallResult{
+		UsedGas:    hexutil.Uint64(result.UsedGas),
+		ReturnData: result.ReturnData,
+	}
+	if result.Err != nil {
+		if err, ok := result.Err.(rpc.Error); ok {
+			reply.ErrCode = err.ErrorCode()
+		}
+		reply.Err = result.Err.Error()
+	}
+	// If the result contains a revert reason, try to unpack and return it.
+	if len(result.Revert()) > 0 {
+		err := newRevertError(result.Revert())
+		reply.ErrCode = err.ErrorCode()
+		reply.Err = err.Error()
+	}
+
+	switch {
+	case result.PrioritisedFee != nil:
+		reply.Prioritised = true
+	case result.Failed():
+		reply.PrioritisedReason = core.PrioritisedReasonExecutionFailed
+	default:
+		config := s.b.ChainConfig()
+		reason := core.CheckPrioritisedContractCall(config, header.Time, msg.To, msg.Data, result.ReturnData, msg.GasLimit)
+		if reason == nil {
+			reason = core.ErrNotPrioritisedContract
+		}
+		reply.PrioritisedReason = core.PrioritisedReason(reason)
+	}
+
+	// Calls are simulated without a gas price unless one is given, so compute
+	// the fees at the base fee of the block.
+	price := msg.GasPrice
+	if (price == nil || price.Sign() == 0) && header.BaseFee != nil {
+		price = header.BaseFee
+	}
+	if price == nil {
+		price = new(big.Int)
+	}
+	reply.GasPrice = (*hexutil.Big)(price)
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(result.UsedGas), price)
+	refund := new(big.Int)
+	if reply.Prioritised {
+		charged, feeRefund := core.PrioritisedCallFee(core.GetFlareExtensions(s.b.ChainConfig().ChainID), result.UsedGas, uint256.MustFromBig(price))
+		fee, refund = charged.ToBig(), feeRefund.ToBig()
+	}
+	reply.ChargedFee = (*hexutil.Big)(fee)
+	reply.FeeRefund = (*hexutil.Big)(refund)
+
+	if result.DaemonResult != nil {
+		reply.Daemon = newFlareCallDaemonResult(result.DaemonResult)
+	}
+	return reply, nil
+}
+
+func newFlareCallDaemonResult(r *types.DaemonResult) *FlareCallDaemonResult {
+	return &FlareCallDaemonResult{
+		GasUsed:     hexutil.Uint64(r.GasUsed),
+		MintRequest: (*hexutil.Big)(r.MintRequest),
+		Minted:      (*hexutil.Big)(r.Minted),
+		ErrorClass:  r.ErrorClass,
+		Error:       r.Error,
+	}
+}
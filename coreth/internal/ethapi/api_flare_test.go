@@ -0,0 +1,138 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package ethapi
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/coreth/consensus/dummy"
+	"github.com/ava-labs/coreth/core"
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/params"
+	"github.com/ava-labs/coreth/plugin/evm/upgrade/ap3"
+	"github.com/ava-labs/coreth/plugin/evm/upgrade/ap4"
+	"github.com/ava-labs/coreth/rpc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateFlareCall(t *testing.T) {
+	t.Parallel()
+
+	var (
+		accounts  = newAccounts(1)
+		ftso      = common.HexToAddress("0x1000000000000000000000000000000000000003")
+		submitter = common.HexToAddress("0x2cA6571Daa15ce734Bbd0Bf27D5C9D16787fc33f")
+		reverter  = common.HexToAddress("0x0000000000000000000000000000000000000bad")
+		other     = common.HexToAddress("0x0000000000000000000000000000000000000abc")
+		daemon    = params.GetDaemonParams(params.FlareChainID, 0).ContractAddress
+
+		returnOne  = common.FromHex("0x600160005360016000f3")
+		returnZero = common.FromHex("0x60206000f3")
+		revert     = common.FromHex("0x60006000fd")
+		daemonCode = common.FromHex("0x600160005260206000f3")
+
+		ftsoSelector      = hexutil.Bytes{0x8f, 0xc6, 0xf6, 0x67}
+		submitterSelector = hexutil.Bytes{0x6c, 0x53, 0x2f, 0xae}
+	)
+	genesis := &core.Genesis{
+		Config: params.TestFlareChainConfig,
+		// After the prioritised selectors were restricted on Flare
+		Timestamp: uint64(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()),
+		Alloc: types.GenesisAlloc{
+			accounts[0].addr: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))},
+			ftso:             {Code: returnOne},
+			submitter:        {Code: returnZero},
+			reverter:         {Code: revert},
+			daemon:           {Code: daemonCode},
+		},
+	}
+	api := NewBlockChainAPI(newTestBackend(t, 1, genesis, dummy.NewCoinbaseFaker(), func(i int, b *core.BlockGen) {}))
+
+	gas := func(g uint64) *hexutil.Uint64 { return (*hexutil.Uint64)(&g) }
+	tests := map[string]struct {
+		args        TransactionArgs
+		overrides   *StateOverride
+		prioritised bool
+		reason      string
+	}{
+		"prioritised": {
+			args:        TransactionArgs{To: &ftso, Data: &ftsoSelector, Gas: gas(100_000)},
+			prioritised: true,
+		},
+		"gas cap exceeded": {
+			args:   TransactionArgs{To: &ftso, Data: &ftsoSelector, Gas: gas(3_000_001)},
+			reason: core.PrioritisedReasonGasCapExceeded,
+		},
+		"prioritised with estimated gas": {
+			args:        TransactionArgs{To: &ftso, Data: &ftsoSelector},
+			prioritised: true,
+		},
+		"selector not allowlisted": {
+			args:   TransactionArgs{To: &ftso, Data: &submitterSelector, Gas: gas(100_000)},
+			reason: core.PrioritisedReasonSelectorNotAllowed,
+		},
+		"zero return value": {
+			args:   TransactionArgs{To: &submitter, Data: &submitterSelector, Gas: gas(100_000)},
+			reason: core.PrioritisedReasonZeroReturnValue,
+		},
+		"calldata cap exceeded": {
+			args: TransactionArgs{To: &submitter, Data: func() *hexutil.Bytes {
+				data := append(hexutil.Bytes{}, submitterSelector...)
+				data = append(data, make([]byte, 4500)...)
+				return &data
+			}(), Gas: gas(200_000)},
+			overrides: &StateOverride{submitter: {Code: (*hexutil.Bytes)(&returnOne)}},
+			reason:    core.PrioritisedReasonCallDataCapExceeded,
+		},
+		"not a prioritised contract": {
+			args:   TransactionArgs{To: &other, Gas: gas(100_000)},
+			reason: core.PrioritisedReasonNotPrioritisedContract,
+		},
+		"execution failed": {
+			args:   TransactionArgs{To: &reverter, Gas: gas(100_000)},
+			reason: core.PrioritisedReasonExecutionFailed,
+		},
+		"execution failed with estimated gas": {
+			args:   TransactionArgs{To: &reverter},
+			reason: core.PrioritisedReasonExecutionFailed,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			test.args.From = &accounts[0].addr
+			test.args.GasPrice = (*hexutil.Big)(big.NewInt(ap3.InitialBaseFee))
+			result, err := api.SimulateFlareCall(context.Background(), test.args, rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber), test.overrides)
+			require.NoError(err)
+			require.Equal(test.prioritised, result.Prioritised)
+			require.Equal(test.reason, result.PrioritisedReason)
+
+			fullFee := new(big.Int).Mul(new(big.Int).SetUint64(uint64(result.UsedGas)), result.GasPrice.ToInt())
+			require.Equal(test.args.GasPrice, result.GasPrice)
+			require.Zero(fullFee.Cmp(new(big.Int).Add(result.ChargedFee.ToInt(), result.FeeRefund.ToInt())))
+			if test.prioritised {
+				nominalFee := new(big.Int).Mul(big.NewInt(int64(params.TxGas)), big.NewInt(ap4.MinBaseFee))
+				require.Zero(nominalFee.Cmp(result.ChargedFee.ToInt()))
+			} else {
+				require.Zero(result.FeeRefund.ToInt().Sign())
+			}
+
+			if test.reason == core.PrioritisedReasonExecutionFailed {
+				require.NotEmpty(result.Err)
+				require.Nil(result.Daemon)
+			} else {
+				require.Empty(result.Err)
+				require.NotNil(result.Daemon)
+				require.Empty(result.Daemon.ErrorClass)
+				require.Zero(big.NewInt(1).Cmp(result.Daemon.Minted.ToInt()))
+			}
+		})
+	}
+}
@@ -479,8 +479,8 @@ func (b testBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
 	return big.NewInt(0), nil
 }
 
-func (b testBackend) FeeHistory(ctx context.Context, blockCount uint64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*big.Int, [][]*big.Int, []*big.Int, []float64, error) {
-	return nil, nil, nil, nil, nil
+func (b testBackend) FeeHistory(ctx context.Context, blockCount uint64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*big.Int, [][]*big.Int, []*big.Int, []float64, []float64, error) {
+	return nil, nil, nil, nil, nil, nil
 }
 func (b testBackend) ChainDb() ethdb.Database                    { return b.db }
 func (b testBackend) AccountManager() *accounts.Manager          { return b.accman }
@@ -2094,3 +2094,20 @@ func testRPCResponseWithFile(t *testing.T, testid int, result interface{}, rpc s
 	}
 	require.JSONEqf(t, string(want), string(data), "test %d: json not match, want: %s, have: %s", testid, string(want), string(data))
 }
+
+func TestMarshalReceiptPrioritisedFee(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	signer := types.LatestSignerForChainID(params.TestFlareChainConfig.ChainID)
+	tx, err := types.SignNewTx(key, signer, &types.LegacyTx{Nonce: 0, Gas: 50000, GasPrice: big.NewInt(100)})
+	require.NoError(t, err)
+
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, GasUsed: 50000, EffectiveGasPrice: big.NewInt(100)}
+	fields := marshalReceipt(receipt, common.Hash{}, 1, signer, tx, 0)
+	require.NotContains(t, fields, "prioritised")
+
+	receipt.PrioritisedFee = &types.PrioritisedFee{Fee: big.NewInt(2_100_000), Refund: big.NewInt(2_900_000)}
+	fields = marshalReceipt(receipt, common.Hash{}, 1, signer, tx, 0)
+	require.Equal(t, true, fields["prioritised"])
+	require.Equal(t, (*hexutil.Big)(big.NewInt(2_100_000)), fields["chargedFee"])
+	require.Equal(t, (*hexutil.Big)(big.NewInt(2_900_000)), fields["feeRefund"])
+}
@@ -1056,6 +1056,10 @@ type ChainConfig struct {
 	AvalancheContext `json:"-"` // Avalanche specific context set during VM initialization. Not serialized.
 
 	UpgradeConfig `json:"-"` // Config specified in upgradeBytes (avalanche network upgrades or enable/disabling precompiles). Skip encoding/decoding directly into ChainConfig.
+
+	StateConnectorConfig `json:"-"` // Node-local state connector settings set during VM initialization. Not serialized.
+
+	PrioritisedSubmitterAddress common.Address `json:"-"` // Node-local submitter contract prioritised on local networks, set during VM initialization. Not serialized.
 }
 
 // Description returns a human-readable description of ChainConfig.
@@ -22,10 +22,14 @@ const (
 
 // UpgradeConfig includes the following configs that may be specified in upgradeBytes:
 // - Timestamps that enable avalanche network upgrades,
-// - Enabling or disabling precompiles as network upgrades.
+// - Enabling or disabling precompiles as network upgrades,
+// - Scheduling the prioritised contracts on local networks.
 type UpgradeConfig struct {
 	// Config for enabling and disabling precompiles as network upgrades.
 	PrecompileUpgrades []PrecompileUpgrade `json:"precompileUpgrades,omitempty"`
+
+	// Schedule of prioritised contracts, added to the default registry of the network.
+	PrioritisedContracts []PrioritisedContractsUpgrade `json:"prioritisedContracts,omitempty"`
 }
 
 // AvalancheContext provides Avalanche specific context directly into the EVM.
@@ -179,6 +183,14 @@ func (c *ChainConfig) Verify() error {
 	if err := c.verifyPrecompileUpgrades(); err != nil {
 		return fmt.Errorf("invalid precompile upgrades: %w", err)
 	}
+	// Verify the prioritised contracts schedule is sorted and well formed.
+	if err := c.verifyPrioritisedContracts(); err != nil {
+		return fmt.Errorf("invalid prioritised contracts: %w", err)
+	}
+	// Verify the local state connector settings are not used on public networks.
+	if err := c.verifyStateConnectorConfig(); err != nil {
+		return fmt.Errorf("invalid state connector config: %w", err)
+	}
 
 	return nil
 }
@@ -0,0 +1,105 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package params
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+
+	"github.com/ava-labs/coreth/utils"
+)
+
+// DefaultDaemonGasMultiplier is the multiple of the block gas limit the daemon
+// call is allowed to use.
+const DefaultDaemonGasMultiplier uint64 = 100
+
+var (
+	// defaultDaemonContractAddress is the address of the FlareDaemon contract.
+	defaultDaemonContractAddress = common.HexToAddress("0x1000000000000000000000000000000000000002")
+	// defaultDaemonSelector is the selector of FlareDaemon.trigger().
+	defaultDaemonSelector = [4]byte{0x7f, 0xec, 0x8d, 0x38}
+
+	flareMaxMintRequest    = uint256.MustFromDecimal("60000000000000000000000000")
+	songbirdMaxMintRequest = uint256.MustFromDecimal("50000000000000000000000000")
+
+	// Daemon schedule per chain, Songbird parameters unless specified.
+	daemonScheduleVariants = utils.NewChainValue(newDaemonSchedule(songbirdMaxMintRequest)).
+				AddValues([]*big.Int{FlareChainID, CostwoChainID, LocalFlareChainID}, newDaemonSchedule(flareMaxMintRequest))
+)
+
+// DaemonParams are the parameters of the daemon call made at the end of every
+// successful transaction on Flare and Songbird chains.
+type DaemonParams struct {
+	ContractAddress common.Address
+	Selector        [4]byte
+	// GasMultiplier is the multiple of the block gas limit given to the call.
+	GasMultiplier uint64
+	// MaxMintRequest is the largest amount the daemon may request to be minted,
+	// larger requests are rejected and the daemon call is reverted.
+	MaxMintRequest *uint256.Int
+}
+
+// DaemonUpgrade replaces the daemon parameters for all blocks with a timestamp
+// greater than or equal to [Timestamp].
+type DaemonUpgrade struct {
+	Timestamp uint64
+	DaemonParams
+}
+
+func newDaemonSchedule(maxMintRequest *uint256.Int) []DaemonUpgrade {
+	return []DaemonUpgrade{
+		{
+			Timestamp: 0,
+			DaemonParams: DaemonParams{
+				ContractAddress: defaultDaemonContractAddress,
+				Selector:        defaultDaemonSelector,
+				GasMultiplier:   DefaultDaemonGasMultiplier,
+				MaxMintRequest:  maxMintRequest,
+			},
+		},
+	}
+}
+
+// GetDaemonSchedule returns a copy of the daemon schedule of the chain with
+// [chainID].
+func GetDaemonSchedule(chainID *big.Int) []DaemonUpgrade {
+	schedule := daemonScheduleVariants.GetValue(chainID)
+	copied := make([]DaemonUpgrade, len(schedule))
+	for i, upgrade := range schedule {
+		copied[i] = DaemonUpgrade{
+			Timestamp:    upgrade.Timestamp,
+			DaemonParams: upgrade.DaemonParams.copy(),
+		}
+	}
+	return copied
+}
+
+// GetDaemonParams returns the daemon parameters of the chain with [chainID]
+// active at [timestamp].
+func GetDaemonParams(chainID *big.Int, timestamp uint64) DaemonParams {
+	return DaemonParamsAt(GetDaemonSchedule(chainID), timestamp)
+}
+
+// DaemonParamsAt returns a copy of the daemon parameters active at [timestamp]
+// in [schedule], which must be sorted by timestamp and start at timestamp 0.
+func DaemonParamsAt(schedule []DaemonUpgrade, timestamp uint64) DaemonParams {
+	var active DaemonParams
+	for _, upgrade := range schedule {
+		if upgrade.Timestamp > timestamp {
+			break
+		}
+		active = upgrade.DaemonParams
+	}
+	return active.copy()
+}
+
+// copy returns a copy of [p] that does not share MaxMintRequest.
+func (p DaemonParams) copy() DaemonParams {
+	if p.MaxMintRequest != nil {
+		p.MaxMintRequest = new(uint256.Int).Set(p.MaxMintRequest)
+	}
+	return p
+}
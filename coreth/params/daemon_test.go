@@ -0,0 +1,104 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package params
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDaemonParamsAt(t *testing.T) {
+	initial := DaemonParams{
+		ContractAddress: defaultDaemonContractAddress,
+		Selector:        defaultDaemonSelector,
+		GasMultiplier:   DefaultDaemonGasMultiplier,
+		MaxMintRequest:  flareMaxMintRequest,
+	}
+	upgraded := DaemonParams{
+		ContractAddress: common.HexToAddress("0x1000000000000000000000000000000000000042"),
+		Selector:        [4]byte{0x01, 0x02, 0x03, 0x04},
+		GasMultiplier:   10,
+		MaxMintRequest:  uint256.NewInt(1),
+	}
+	schedule := []DaemonUpgrade{
+		{Timestamp: 0, DaemonParams: initial},
+		{Timestamp: 100, DaemonParams: upgraded},
+	}
+	tests := map[string]struct {
+		timestamp uint64
+		expected  DaemonParams
+	}{
+		"genesis": {
+			timestamp: 0,
+			expected:  initial,
+		},
+		"before upgrade": {
+			timestamp: 99,
+			expected:  initial,
+		},
+		"at upgrade": {
+			timestamp: 100,
+			expected:  upgraded,
+		},
+		"after upgrade": {
+			timestamp: 101,
+			expected:  upgraded,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, test.expected, DaemonParamsAt(schedule, test.timestamp))
+		})
+	}
+}
+
+func TestGetDaemonParams(t *testing.T) {
+	tests := map[string]struct {
+		chainID        *big.Int
+		maxMintRequest string
+	}{
+		"flare":       {chainID: FlareChainID, maxMintRequest: "60000000000000000000000000"},
+		"costwo":      {chainID: CostwoChainID, maxMintRequest: "60000000000000000000000000"},
+		"local flare": {chainID: LocalFlareChainID, maxMintRequest: "60000000000000000000000000"},
+		"songbird":    {chainID: SongbirdChainID, maxMintRequest: "50000000000000000000000000"},
+		"coston":      {chainID: CostonChainID, maxMintRequest: "50000000000000000000000000"},
+		"local":       {chainID: LocalChainID, maxMintRequest: "50000000000000000000000000"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			schedule := GetDaemonSchedule(test.chainID)
+			require.NotEmpty(schedule)
+			require.Zero(schedule[0].Timestamp)
+			for i := 1; i < len(schedule); i++ {
+				require.Greater(schedule[i].Timestamp, schedule[i-1].Timestamp)
+			}
+
+			daemonParams := GetDaemonParams(test.chainID, 0)
+			require.Equal(defaultDaemonContractAddress, daemonParams.ContractAddress)
+			require.Equal(defaultDaemonSelector, daemonParams.Selector)
+			require.Equal(DefaultDaemonGasMultiplier, daemonParams.GasMultiplier)
+			require.Equal(test.maxMintRequest, daemonParams.MaxMintRequest.Dec())
+		})
+	}
+}
+
+func TestGetDaemonParamsReturnsCopies(t *testing.T) {
+	require := require.New(t)
+
+	daemonParams := GetDaemonParams(FlareChainID, 0)
+	daemonParams.MaxMintRequest.SetUint64(1)
+	schedule := GetDaemonSchedule(FlareChainID)
+	schedule[0].MaxMintRequest.SetUint64(2)
+	schedule[0].ContractAddress = common.Address{}
+
+	daemonParams = GetDaemonParams(FlareChainID, 0)
+	require.Equal("60000000000000000000000000", daemonParams.MaxMintRequest.Dec())
+	require.Equal(defaultDaemonContractAddress, daemonParams.ContractAddress)
+}
@@ -42,6 +42,14 @@ const (
 	// considered probably final and its rotated bits are calculated.
 	BloomConfirms = 256
 
+	// SupplyDeltaBlocks is the number of blocks a single supply delta section
+	// contains.
+	SupplyDeltaBlocks uint64 = 1024
+
+	// SupplyDeltaConfirms is the number of confirmation blocks before a supply
+	// delta section is considered final and indexed.
+	SupplyDeltaConfirms = 256
+
 	// CHTFrequency is the block frequency for creating CHTs
 	CHTFrequency = 32768
 
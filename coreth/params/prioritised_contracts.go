@@ -0,0 +1,149 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package params
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var (
+	errPrioritisedContractsOverride = errors.New("prioritised contracts cannot be overridden on public networks")
+
+	// Reasons a call to a prioritised contract is charged the full fee
+	ErrPrioritisedGasCapExceeded      = errors.New("gas limit exceeds the prioritised gas cap")
+	ErrPrioritisedCallDataCapExceeded = errors.New("calldata exceeds the prioritised calldata cap")
+	ErrPrioritisedSelectorNotAllowed  = errors.New("function selector is not allowlisted")
+	ErrPrioritisedZeroReturnValue     = errors.New("call returned a zero value")
+)
+
+// PrioritisedContract describes a contract whose successful calls are only
+// charged the nominal fee.
+type PrioritisedContract struct {
+	Address common.Address `json:"address"`
+	// Selectors is the allowlist of 4-byte function selectors, any calldata is
+	// accepted if empty.
+	Selectors []hexutil.Bytes `json:"selectors,omitempty"`
+	// MaxGas is the maximum gas limit of a prioritised call (0 = no limit).
+	MaxGas uint64 `json:"maxGas,omitempty"`
+	// CallDataCap is the maximum calldata length in bytes (0 = no limit).
+	CallDataCap uint64 `json:"callDataCap,omitempty"`
+	// RequireReturnValue requires the call to return a non-zero value.
+	RequireReturnValue bool `json:"requireReturnValue,omitempty"`
+}
+
+// PrioritisedContractsUpgrade sets the prioritised contracts specified in the
+// upgrade config for all blocks with a timestamp greater than or equal to
+// [Timestamp]. They are added to the default contracts of the network, a
+// contract with the address of a default contract replaces it.
+type PrioritisedContractsUpgrade struct {
+	Timestamp uint64                `json:"blockTimestamp"`
+	Contracts []PrioritisedContract `json:"contracts"`
+}
+
+// PrioritisedContractsAt returns the prioritised contracts active at [timestamp]
+// in [schedule], which must be sorted by timestamp.
+func PrioritisedContractsAt(schedule []PrioritisedContractsUpgrade, timestamp uint64) []PrioritisedContract {
+	var active []PrioritisedContract
+	for _, upgrade := range schedule {
+		if upgrade.Timestamp > timestamp {
+			break
+		}
+		active = upgrade.Contracts
+	}
+	return active
+}
+
+// MergePrioritisedContracts returns [contracts] with each of the [overrides]
+// replacing the contract with the same address, or added if there is none.
+// Neither slice is modified.
+func MergePrioritisedContracts(contracts []PrioritisedContract, overrides []PrioritisedContract) []PrioritisedContract {
+	if len(overrides) == 0 {
+		return contracts
+	}
+	merged := make([]PrioritisedContract, 0, len(contracts)+len(overrides))
+	for _, contract := range contracts {
+		if !containsPrioritisedContract(overrides, contract.Address) {
+			merged = append(merged, contract)
+		}
+	}
+	return append(merged, overrides...)
+}
+
+func containsPrioritisedContract(contracts []PrioritisedContract, address common.Address) bool {
+	for _, contract := range contracts {
+		if contract.Address == address {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPrioritisedCall returns true if a call to [p] with the given calldata, return
+// value and gas limit satisfies the contract's prioritisation constraints.
+func (p *PrioritisedContract) IsPrioritisedCall(data []byte, ret []byte, gas uint64) bool {
+	return p.CheckPrioritisedCall(data, ret, gas) == nil
+}
+
+// CheckPrioritisedCall returns the first of the contract's prioritisation
+// constraints violated by a call to [p] with the given calldata, return value
+// and gas limit, or nil if the call is prioritised.
+func (p *PrioritisedContract) CheckPrioritisedCall(data []byte, ret []byte, gas uint64) error {
+	switch {
+	case p.MaxGas != 0 && gas > p.MaxGas:
+		return ErrPrioritisedGasCapExceeded
+	case p.CallDataCap != 0 && uint64(len(data)) > p.CallDataCap:
+		return ErrPrioritisedCallDataCapExceeded
+	case p.RequireReturnValue && isZeroSlice(ret):
+		return ErrPrioritisedZeroReturnValue
+	case len(p.Selectors) == 0:
+		return nil
+	case len(data) < 4:
+		return ErrPrioritisedSelectorNotAllowed
+	}
+	for _, selector := range p.Selectors {
+		if bytes.Equal(data[:4], selector) {
+			return nil
+		}
+	}
+	return ErrPrioritisedSelectorNotAllowed
+}
+
+// verifyPrioritisedContracts checks [c.PrioritisedContracts] and
+// [c.PrioritisedSubmitterAddress] are well formed and only specified for
+// networks that allow overriding the default registry.
+func (c *ChainConfig) verifyPrioritisedContracts() error {
+	if len(c.PrioritisedContracts) == 0 && c.PrioritisedSubmitterAddress == (common.Address{}) {
+		return nil
+	}
+	if isPublicFlareChain(c.ChainID) {
+		return errPrioritisedContractsOverride
+	}
+	for i, upgrade := range c.PrioritisedContracts {
+		if i > 0 && upgrade.Timestamp <= c.PrioritisedContracts[i-1].Timestamp {
+			return fmt.Errorf("PrioritisedContracts at [%d]: block timestamp (%d) <= previous timestamp (%d)", i, upgrade.Timestamp, c.PrioritisedContracts[i-1].Timestamp)
+		}
+		for j, contract := range upgrade.Contracts {
+			for _, selector := range contract.Selectors {
+				if len(selector) != 4 {
+					return fmt.Errorf("PrioritisedContracts at [%d]: contract %d (%s) has invalid selector %s", i, j, contract.Address, selector)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func isZeroSlice(s []byte) bool {
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
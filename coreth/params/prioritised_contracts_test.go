@@ -0,0 +1,185 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package params
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyPrioritisedContracts(t *testing.T) {
+	contract := PrioritisedContract{
+		Address:   common.HexToAddress("0x1000000000000000000000000000000000000003"),
+		Selectors: []hexutil.Bytes{{0x8f, 0xc6, 0xf6, 0x67}},
+	}
+	tests := map[string]struct {
+		config    *ChainConfig
+		schedule  []PrioritisedContractsUpgrade
+		expectErr bool
+	}{
+		"empty schedule on public network": {
+			config: &ChainConfig{ChainID: FlareChainID},
+		},
+		"override on public network": {
+			config:    &ChainConfig{ChainID: SongbirdChainID},
+			schedule:  []PrioritisedContractsUpgrade{{Timestamp: 0, Contracts: []PrioritisedContract{contract}}},
+			expectErr: true,
+		},
+		"override on local network": {
+			config:   &ChainConfig{ChainID: LocalFlareChainID},
+			schedule: []PrioritisedContractsUpgrade{{Timestamp: 0, Contracts: []PrioritisedContract{contract}}, {Timestamp: 10}},
+		},
+		"submitter on public network": {
+			config:    &ChainConfig{ChainID: CostwoChainID, PrioritisedSubmitterAddress: contract.Address},
+			expectErr: true,
+		},
+		"submitter on local network": {
+			config: &ChainConfig{ChainID: LocalChainID, PrioritisedSubmitterAddress: contract.Address},
+		},
+		"unsorted schedule": {
+			config:    &ChainConfig{ChainID: LocalFlareChainID},
+			schedule:  []PrioritisedContractsUpgrade{{Timestamp: 10}, {Timestamp: 10}},
+			expectErr: true,
+		},
+		"invalid selector": {
+			config: &ChainConfig{ChainID: LocalChainID},
+			schedule: []PrioritisedContractsUpgrade{{Timestamp: 0, Contracts: []PrioritisedContract{{
+				Address:   contract.Address,
+				Selectors: []hexutil.Bytes{{0x8f, 0xc6, 0xf6}},
+			}}}},
+			expectErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := test.config
+			config.PrioritisedContracts = test.schedule
+			err := config.Verify()
+			if test.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPrioritisedContractsUnmarshal(t *testing.T) {
+	upgradeBytes := []byte(`{
+		"prioritisedContracts": [
+			{
+				"blockTimestamp": 100,
+				"contracts": [
+					{
+						"address": "0x1000000000000000000000000000000000000003",
+						"selectors": ["0x8fc6f667", "0xe2db5a52"],
+						"maxGas": 3000000,
+						"callDataCap": 4500,
+						"requireReturnValue": true
+					}
+				]
+			}
+		]
+	}`)
+	var config UpgradeConfig
+	require.NoError(t, json.Unmarshal(upgradeBytes, &config))
+	require.Len(t, config.PrioritisedContracts, 1)
+
+	active := PrioritisedContractsAt(config.PrioritisedContracts, 99)
+	require.Empty(t, active)
+	active = PrioritisedContractsAt(config.PrioritisedContracts, 100)
+	require.Len(t, active, 1)
+
+	contract := active[0]
+	require.True(t, contract.IsPrioritisedCall([]byte{0xe2, 0xdb, 0x5a, 0x52}, []byte{0x01}, 3000000))
+	require.False(t, contract.IsPrioritisedCall([]byte{0xe2, 0xdb, 0x5a, 0x52}, []byte{0x00}, 3000000))
+	require.False(t, contract.IsPrioritisedCall([]byte{0xe2, 0xdb, 0x5a, 0x52}, []byte{0x01}, 3000001))
+	require.False(t, contract.IsPrioritisedCall(make([]byte, 4501), []byte{0x01}, 3000000))
+}
+
+func TestCheckPrioritisedCall(t *testing.T) {
+	contract := PrioritisedContract{
+		Selectors:          []hexutil.Bytes{{0x8f, 0xc6, 0xf6, 0x67}},
+		MaxGas:             3000000,
+		CallDataCap:        36,
+		RequireReturnValue: true,
+	}
+	selector := []byte{0x8f, 0xc6, 0xf6, 0x67}
+	tests := map[string]struct {
+		contract PrioritisedContract
+		data     []byte
+		ret      []byte
+		gas      uint64
+		want     error
+	}{
+		"prioritised": {
+			contract: contract,
+			data:     selector,
+			ret:      []byte{0x01},
+			gas:      3000000,
+		},
+		"no constraints": {
+			ret: []byte{0x00},
+			gas: 30000000,
+		},
+		"gas cap exceeded": {
+			contract: contract,
+			data:     selector,
+			ret:      []byte{0x01},
+			gas:      3000001,
+			want:     ErrPrioritisedGasCapExceeded,
+		},
+		"calldata cap exceeded": {
+			contract: contract,
+			data:     append(selector, make([]byte, 33)...),
+			ret:      []byte{0x01},
+			gas:      3000000,
+			want:     ErrPrioritisedCallDataCapExceeded,
+		},
+		"zero return value": {
+			contract: contract,
+			data:     selector,
+			ret:      make([]byte, 32),
+			gas:      3000000,
+			want:     ErrPrioritisedZeroReturnValue,
+		},
+		"selector not allowlisted": {
+			contract: contract,
+			data:     []byte{0xe2, 0xdb, 0x5a, 0x52},
+			ret:      []byte{0x01},
+			gas:      3000000,
+			want:     ErrPrioritisedSelectorNotAllowed,
+		},
+		"calldata shorter than selector": {
+			contract: contract,
+			data:     selector[:3],
+			ret:      []byte{0x01},
+			gas:      3000000,
+			want:     ErrPrioritisedSelectorNotAllowed,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.contract.CheckPrioritisedCall(test.data, test.ret, test.gas)
+			require.ErrorIs(t, err, test.want)
+			require.Equal(t, test.want == nil, test.contract.IsPrioritisedCall(test.data, test.ret, test.gas))
+		})
+	}
+}
+
+func TestMergePrioritisedContracts(t *testing.T) {
+	a := common.HexToAddress("0x0a")
+	b := common.HexToAddress("0x0b")
+	c := common.HexToAddress("0x0c")
+	contracts := []PrioritisedContract{{Address: a}, {Address: b, MaxGas: 1}}
+
+	merged := MergePrioritisedContracts(contracts, []PrioritisedContract{{Address: b, MaxGas: 2}, {Address: c}})
+	require.Equal(t, []PrioritisedContract{{Address: a}, {Address: b, MaxGas: 2}, {Address: c}}, merged)
+	require.Equal(t, []PrioritisedContract{{Address: a}, {Address: b, MaxGas: 1}}, contracts)
+	require.Equal(t, contracts, MergePrioritisedContracts(contracts, nil))
+}
@@ -0,0 +1,37 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package params
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var errStateConnectorOverride = errors.New("local attestors and forking cannot be enabled on public networks")
+
+// StateConnectorConfig holds the node-local state connector settings. A node
+// with local attestors also counts their votes on every round and, if forking
+// is enabled, does not finalise the rounds on which they disagree with the
+// default attestors, forking the node from the network.
+type StateConnectorConfig struct {
+	LocalAttestors []common.Address
+	ForkingEnabled bool
+}
+
+// verifyStateConnectorConfig checks [c.StateConnectorConfig] is only specified
+// for local networks.
+func (c *ChainConfig) verifyStateConnectorConfig() error {
+	if (len(c.LocalAttestors) > 0 || c.ForkingEnabled) && isPublicFlareChain(c.ChainID) {
+		return errStateConnectorOverride
+	}
+	return nil
+}
+
+// isPublicFlareChain returns true if [chainID] is Flare, Coston2, Songbird or Coston.
+func isPublicFlareChain(chainID *big.Int) bool {
+	return chainID != nil && (chainID.Cmp(FlareChainID) == 0 || chainID.Cmp(CostwoChainID) == 0 ||
+		chainID.Cmp(SongbirdChainID) == 0 || chainID.Cmp(CostonChainID) == 0)
+}
@@ -0,0 +1,45 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package params
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyStateConnectorConfig(t *testing.T) {
+	attestors := []common.Address{common.HexToAddress("0x0c19f3B4927abFc596353B0f9Ddad5D817736F70")}
+	tests := map[string]struct {
+		chainConfig *ChainConfig
+		config      StateConnectorConfig
+		expectedErr error
+	}{
+		"no overrides on public network": {
+			chainConfig: &ChainConfig{ChainID: FlareChainID},
+		},
+		"local attestors on public network": {
+			chainConfig: &ChainConfig{ChainID: CostwoChainID},
+			config:      StateConnectorConfig{LocalAttestors: attestors},
+			expectedErr: errStateConnectorOverride,
+		},
+		"forking on public network": {
+			chainConfig: &ChainConfig{ChainID: SongbirdChainID},
+			config:      StateConnectorConfig{ForkingEnabled: true},
+			expectedErr: errStateConnectorOverride,
+		},
+		"overrides on local network": {
+			chainConfig: &ChainConfig{ChainID: LocalFlareChainID},
+			config:      StateConnectorConfig{LocalAttestors: attestors, ForkingEnabled: true},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := test.chainConfig
+			config.StateConnectorConfig = test.config
+			require.ErrorIs(t, config.verifyStateConnectorConfig(), test.expectedErr)
+		})
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"net/http"
 
 	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/profiler"
+	"github.com/ava-labs/coreth/core"
 	"github.com/ava-labs/coreth/plugin/evm/client"
 	"github.com/ethereum/go-ethereum/log"
 )
@@ -82,3 +84,18 @@ func (p *Admin) GetVMConfig(_ *http.Request, _ *struct{}, reply *client.ConfigRe
 	reply.Config = &p.vm.config
 	return nil
 }
+
+// GetFlareConfig returns the state connector settings and the prioritised
+// contracts in effect on the node.
+func (p *Admin) GetFlareConfig(_ *http.Request, _ *struct{}, reply *client.FlareConfigReply) error {
+	chainConfig := p.vm.chainConfig
+	timestamp := p.vm.blockChain.LastAcceptedBlock().Time()
+
+	reply.StateConnectorLocalAttestors = chainConfig.LocalAttestors
+	reply.StateConnectorForkingEnabled = chainConfig.ForkingEnabled
+	reply.SubmitterContractAddress = chainConfig.PrioritisedSubmitterAddress
+	reply.PrioritisedContractsOverrides = chainConfig.PrioritisedContracts
+	reply.PrioritisedContracts = core.GetPrioritisedContracts(chainConfig, timestamp)
+	reply.Timestamp = json.Uint64(timestamp)
+	return nil
+}
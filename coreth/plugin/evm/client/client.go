@@ -16,8 +16,10 @@ import (
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/coreth/params"
 	"github.com/ava-labs/coreth/plugin/evm/atomic"
 	"github.com/ava-labs/coreth/plugin/evm/config"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // Interface compliance
@@ -37,6 +39,7 @@ type Client interface {
 	LockProfile(ctx context.Context, options ...rpc.Option) error
 	SetLogLevel(ctx context.Context, level slog.Level, options ...rpc.Option) error
 	GetVMConfig(ctx context.Context, options ...rpc.Option) (*config.Config, error)
+	GetFlareConfig(ctx context.Context, options ...rpc.Option) (*FlareConfigReply, error)
 }
 
 // Client implementation for interacting with EVM [chain]
@@ -172,3 +175,32 @@ func (c *client) GetVMConfig(ctx context.Context, options ...rpc.Option) (*confi
 	err := c.adminRequester.SendRequest(ctx, "admin.getVMConfig", struct{}{}, res, options...)
 	return res.Config, err
 }
+
+// FlareConfigReply is the Flare specific configuration of the chain in effect
+// on the node, resolved from the chain config and the upgrade config.
+type FlareConfigReply struct {
+	// StateConnectorLocalAttestors are counted on every state connector round
+	// in addition to the default attestors of the network.
+	StateConnectorLocalAttestors []common.Address `json:"stateConnectorLocalAttestors"`
+	// StateConnectorForkingEnabled is true if the node does not finalise the
+	// rounds on which the local attestors disagree with the default attestors.
+	StateConnectorForkingEnabled bool `json:"stateConnectorForkingEnabled"`
+	// SubmitterContractAddress is the submitter contract prioritised on local
+	// networks, or the zero address.
+	SubmitterContractAddress common.Address `json:"submitterContractAddress"`
+	// PrioritisedContractsOverrides is the schedule of prioritised contracts
+	// of the upgrade config, added to the default contracts of the network.
+	PrioritisedContractsOverrides []params.PrioritisedContractsUpgrade `json:"prioritisedContractsOverrides"`
+	// PrioritisedContracts are the prioritised contracts in effect at
+	// [Timestamp], the time of the last accepted block.
+	PrioritisedContracts []params.PrioritisedContract `json:"prioritisedContracts"`
+	Timestamp            json.Uint64                  `json:"timestamp"`
+}
+
+// GetFlareConfig returns the Flare specific configuration of the chain in
+// effect on the node
+func (c *client) GetFlareConfig(ctx context.Context, options ...rpc.Option) (*FlareConfigReply, error) {
+	res := &FlareConfigReply{}
+	err := c.adminRequester.SendRequest(ctx, "admin.getFlareConfig", struct{}{}, res, options...)
+	return res, err
+}
@@ -81,6 +81,7 @@ var (
 		"internal-eth",
 		"internal-blockchain",
 		"internal-transaction",
+		"flare",
 	}
 	defaultAllowUnprotectedTxHashes = []common.Hash{
 		common.HexToHash("0xfefb2da535e927b85fe68eb81cb2e4a5827c905f78381a01ef2322aa9b0aee8e"), // EIP-1820: https://eips.ethereum.org/EIPS/eip-1820
@@ -237,6 +238,11 @@ type Config struct {
 	// TxLookupLimit can be still used to control unindexing old transactions.
 	SkipTxIndexing bool `json:"skip-tx-indexing"`
 
+	// SupplyIndexEnabled indexes the changes to the native token supply made by
+	// every block accepted after it is first enabled, as returned by
+	// flare_getSupplyDelta.
+	SupplyIndexEnabled bool `json:"supply-index-enabled"`
+
 	// WarpOffChainMessages encodes off-chain messages (unrelated to any on-chain event ie. block or AddressedCall)
 	// that the node should be willing to sign.
 	// Note: only supports AddressedCall payloads as defined here:
@@ -245,6 +251,20 @@ type Config struct {
 
 	// RPC settings
 	HttpBodyLimit uint64 `json:"http-body-limit"`
+
+	// State Connector Settings (local networks only)
+	// StateConnectorLocalAttestors are counted on every state connector round
+	// in addition to the default attestors of the network.
+	StateConnectorLocalAttestors []common.Address `json:"state-connector-local-attestors"`
+	// StateConnectorForkingEnabled stops the node from finalising rounds on which
+	// the local attestors disagree with the default attestors.
+	StateConnectorForkingEnabled bool `json:"state-connector-forking-enabled"`
+
+	// Prioritised Contract Settings (local networks only)
+	// SubmitterContractAddress is prioritised like the submitter contract of
+	// the public networks, in addition to the prioritised contracts of the
+	// upgrade config.
+	SubmitterContractAddress common.Address `json:"submitter-contract-address"`
 }
 
 // TxPoolConfig contains the transaction pool config to be passed
@@ -351,6 +371,15 @@ func (c *Config) Validate(networkID uint32) error {
 		if c.StateSyncCommitInterval != defaultSyncableCommitInterval {
 			return fmt.Errorf("cannot start non-local network with syncable interval %d different than %d", c.StateSyncCommitInterval, defaultSyncableCommitInterval)
 		}
+		if len(c.StateConnectorLocalAttestors) > 0 {
+			return fmt.Errorf("cannot start non-local network with %d state connector local attestors", len(c.StateConnectorLocalAttestors))
+		}
+		if c.StateConnectorForkingEnabled {
+			return fmt.Errorf("cannot start non-local network with state connector forking enabled")
+		}
+		if c.SubmitterContractAddress != (common.Address{}) {
+			return fmt.Errorf("cannot start non-local network with submitter contract address %s", c.SubmitterContractAddress)
+		}
 	}
 
 	if c.PopulateMissingTries != nil && (c.OfflinePruning || c.Pruning) {
@@ -372,6 +401,10 @@ func (c *Config) Validate(networkID uint32) error {
 		return fmt.Errorf("push-gossip-percent-stake is %f but must be in the range [0, 1]", c.PushGossipPercentStake)
 	}
 
+	if c.StateConnectorForkingEnabled && len(c.StateConnectorLocalAttestors) == 0 {
+		return fmt.Errorf("cannot enable state connector forking without local attestors")
+	}
+
 	if c.PriceOptionMaxBaseFee < etna.MinBaseFee {
 		return fmt.Errorf("max base fee %d is less than the minimum base fee %d", c.PriceOptionMaxBaseFee, etna.MinBaseFee)
 	}
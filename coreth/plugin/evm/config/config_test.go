@@ -117,6 +117,21 @@ func TestUnmarshalConfig(t *testing.T) {
 			Config{AllowUnprotectedTxHashes: []common.Hash{common.HexToHash("0x803351deb6d745e91545a6a3e1c0ea3e9a6a02a1a4193b70edfcd2f40f71a01c")}},
 			false,
 		},
+		{
+			"state connector local attestors",
+			[]byte(`{"state-connector-local-attestors": ["0x0c19f3B4927abFc596353B0f9Ddad5D817736F70"], "state-connector-forking-enabled": true}`),
+			Config{
+				StateConnectorLocalAttestors: []common.Address{common.HexToAddress("0x0c19f3B4927abFc596353B0f9Ddad5D817736F70")},
+				StateConnectorForkingEnabled: true,
+			},
+			false,
+		},
+		{
+			"submitter contract address",
+			[]byte(`{"submitter-contract-address": "0x2cA6571Daa15ce734Bbd0Bf27D5C9D16787fc33f"}`),
+			Config{SubmitterContractAddress: common.HexToAddress("0x2cA6571Daa15ce734Bbd0Bf27D5C9D16787fc33f")},
+			false,
+		},
 	}
 
 	for _, tt := range tests {
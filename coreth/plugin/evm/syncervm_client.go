@@ -14,6 +14,7 @@ import (
 	commonEng "github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/vms/components/chain"
+	"github.com/ava-labs/coreth/core"
 	"github.com/ava-labs/coreth/core/rawdb"
 	"github.com/ava-labs/coreth/core/state/snapshot"
 	"github.com/ava-labs/coreth/eth"
@@ -362,6 +363,12 @@ func (client *stateSyncerClient) finishSync() error {
 	parentHeight := block.NumberU64() - 1
 	parentHash := block.ParentHash()
 	client.chain.BloomIndexer().AddCheckpoint(parentHeight/params.BloomBitsBlocks, parentHash)
+	// The supply delta index restarts at the synced block in the same way,
+	// as its sections of [params.SupplyDeltaBlocks] divide those of the
+	// BloomIndexer.
+	if supplyIndexer := client.chain.SupplyIndexer(); supplyIndexer != nil {
+		core.ResetSupplyIndex(client.chain.ChainDb(), supplyIndexer, block)
+	}
 
 	if err := client.chain.BlockChain().ResetToStateSyncedBlock(block); err != nil {
 		return err
@@ -433,6 +433,25 @@ func (vm *VM) Initialize(
 		})
 	}
 
+	// Apply the prioritised contracts schedule from the upgrade bytes, if any.
+	// The remaining upgrade keys are not supported by this VM and are ignored.
+	if len(upgradeBytes) > 0 {
+		var upgradeConfig struct {
+			PrioritisedContracts []params.PrioritisedContractsUpgrade `json:"prioritisedContracts"`
+		}
+		if err := json.Unmarshal(upgradeBytes, &upgradeConfig); err != nil {
+			return fmt.Errorf("failed to parse upgrade bytes: %w", err)
+		}
+		g.Config.PrioritisedContracts = upgradeConfig.PrioritisedContracts
+	}
+
+	// Set the node-local state connector settings on the ChainConfig
+	g.Config.StateConnectorConfig = params.StateConnectorConfig{
+		LocalAttestors: vm.config.StateConnectorLocalAttestors,
+		ForkingEnabled: vm.config.StateConnectorForkingEnabled,
+	}
+	g.Config.PrioritisedSubmitterAddress = vm.config.SubmitterContractAddress
+
 	// Set the Avalanche Context on the ChainConfig
 	g.Config.AvalancheContext = params.AvalancheContext{
 		SnowCtx: chainCtx,
@@ -502,6 +521,7 @@ func (vm *VM) Initialize(
 	vm.ethConfig.AcceptedCacheSize = vm.config.AcceptedCacheSize
 	vm.ethConfig.TransactionHistory = vm.config.TransactionHistory
 	vm.ethConfig.SkipTxIndexing = vm.config.SkipTxIndexing
+	vm.ethConfig.SupplyIndexEnabled = vm.config.SupplyIndexEnabled
 
 	// Create directory for offline pruning
 	if len(vm.ethConfig.OfflinePruningDataDirectory) != 0 {
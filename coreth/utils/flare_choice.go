@@ -26,6 +26,11 @@ func (ca *ChainValue[T]) AddValues(chainIDs []*big.Int, action T) *ChainValue[T]
 	return ca
 }
 
+func (ca *ChainValue[T]) RemoveValue(chainID *big.Int) *ChainValue[T] {
+	delete(ca.valueMap, chainID.Uint64())
+	return ca
+}
+
 func (ca *ChainValue[T]) GetValue(chainID *big.Int) T {
 	if chainID != nil {
 		if action, ok := ca.valueMap[chainID.Uint64()]; ok {
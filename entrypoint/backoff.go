@@ -0,0 +1,45 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"time"
+)
+
+// backoff returns exponentially growing delays, starting at initial and
+// doubling until max.
+type backoff struct {
+	initial time.Duration
+	max     time.Duration
+	next    time.Duration
+}
+
+// Next returns the delay to wait before the next attempt.
+func (b *backoff) Next() time.Duration {
+	if b.next == 0 {
+		b.next = b.initial
+	}
+	delay := b.next
+	b.next = min(2*b.next, b.max)
+	return delay
+}
+
+// Reset makes the next delay the initial one.
+func (b *backoff) Reset() {
+	b.next = 0
+}
+
+// sleep waits for [delay], returning early with an error if [ctx] is done.
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
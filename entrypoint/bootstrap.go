@@ -0,0 +1,194 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+const (
+	maxResponseSize  = 1 << 20
+	maxErrorBodySize = 200
+
+	nodeIDPrefix = "NodeID-"
+)
+
+var (
+	errBadStatus       = errors.New("request failed")
+	errRPC             = errors.New("RPC call failed")
+	errUnhealthyPeer   = errors.New("unhealthy bootstrap peer")
+	errNoBootstrapPeer = errors.New("none of the bootstrap endpoints worked")
+)
+
+// bootstrapPeer is a node given to avalanchego as bootstrapper.
+type bootstrapPeer struct {
+	// Endpoint is the URL of the info API of the peer.
+	Endpoint string
+	// IPs and IDs are the values of --bootstrap-ips and --bootstrap-ids.
+	IPs string
+	IDs string
+}
+
+// bootstrapSelector selects the first healthy peer among the bootstrap
+// endpoints, trying them all again with exponential backoff if none is.
+type bootstrapSelector struct {
+	log       *log.Logger
+	client    *http.Client
+	endpoints []string
+	retries   int
+	backoff   backoff
+}
+
+func (s *bootstrapSelector) Select(ctx context.Context) (*bootstrapPeer, error) {
+	s.backoff.Reset()
+	for attempt := 0; ; attempt++ {
+		for _, endpoint := range s.endpoints {
+			s.log.Printf("Trying bootstrap endpoint %s", endpoint)
+			peer, err := checkPeer(ctx, s.client, endpoint)
+			if err == nil {
+				s.log.Printf("  Got bootstrap ips: '%s'", peer.IPs)
+				s.log.Printf("  Got bootstrap ids: '%s'", peer.IDs)
+				return peer, nil
+			}
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			s.log.Printf("  Failed! %v", err)
+		}
+
+		if attempt >= s.retries {
+			return nil, fmt.Errorf("%w after %d attempts", errNoBootstrapPeer, attempt+1)
+		}
+		delay := s.backoff.Next()
+		s.log.Printf("None of the bootstrap endpoints worked, retrying in %s", delay)
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// checkPeer returns the bootstrap peer whose info API is at [endpoint]. The
+// peer is healthy if it replies with a valid node ID and staking IP.
+func checkPeer(ctx context.Context, client *http.Client, endpoint string) (*bootstrapPeer, error) {
+	rawIDs, err := rpcCall(ctx, client, endpoint, "info.getNodeID")
+	if err != nil {
+		return nil, err
+	}
+	ids, err := parseNodeIDResult(rawIDs)
+	if err != nil {
+		return nil, err
+	}
+	rawIPs, err := rpcCall(ctx, client, endpoint, "info.getNodeIP")
+	if err != nil {
+		return nil, err
+	}
+	ips, err := parseIPResult(rawIPs)
+	if err != nil {
+		return nil, err
+	}
+
+	idList := strings.Split(ids, ",")
+	ipList := strings.Split(ips, ",")
+	if len(idList) != len(ipList) {
+		return nil, fmt.Errorf("%w: got %d node IDs and %d IPs", errUnhealthyPeer, len(idList), len(ipList))
+	}
+	for _, id := range idList {
+		if !strings.HasPrefix(id, nodeIDPrefix) {
+			return nil, fmt.Errorf("%w: invalid node ID %q", errUnhealthyPeer, id)
+		}
+	}
+	for _, ip := range ipList {
+		if _, err := netip.ParseAddrPort(ip); err != nil {
+			return nil, fmt.Errorf("%w: invalid IP %q", errUnhealthyPeer, ip)
+		}
+	}
+	return &bootstrapPeer{
+		Endpoint: endpoint,
+		IPs:      ips,
+		IDs:      ids,
+	}, nil
+}
+
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Method  string `json:"method"`
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func rpcCall(ctx context.Context, client *http.Client, url, method string) (json.RawMessage, error) {
+	body, err := json.Marshal(rpcRequest{JSONRPC: "2.0", ID: 1, Method: method})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	respBody, err := doRequest(client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp rpcResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode reply of %s: %w", method, err)
+	}
+	if resp.Error != nil {
+		return nil, fmt.Errorf("%w: %s: %s (%d)", errRPC, method, resp.Error.Message, resp.Error.Code)
+	}
+	return resp.Result, nil
+}
+
+// parseIPResult returns the IPs of the reply of info.getNodeIP, which are
+// either a string or a list of strings, in an object or not.
+func parseIPResult(raw json.RawMessage) (string, error) {
+	var obj struct {
+		IP json.RawMessage `json:"ip"`
+	}
+	if err := json.Unmarshal(raw, &obj); err == nil {
+		raw = obj.IP
+	}
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return single, nil
+	}
+	var arr []string
+	if err := json.Unmarshal(raw, &arr); err == nil {
+		return strings.Join(arr, ","), nil
+	}
+	return "", fmt.Errorf("%w: unexpected IP format: %s", errUnhealthyPeer, raw)
+}
+
+// parseNodeIDResult returns the node ID of the reply of info.getNodeID, which
+// is a string, in an object or not.
+func parseNodeIDResult(raw json.RawMessage) (string, error) {
+	var obj struct {
+		NodeID string `json:"nodeID"`
+	}
+	if err := json.Unmarshal(raw, &obj); err == nil {
+		return obj.NodeID, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, nil
+	}
+	return "", fmt.Errorf("%w: unexpected node ID format: %s", errUnhealthyPeer, raw)
+}
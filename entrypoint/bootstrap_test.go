@@ -0,0 +1,220 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testNodeID = "NodeID-7Xhw2mDxuDS44j42TCB6U5579esbSt3Lg"
+	testNodeIP = "192.0.2.10:9651"
+)
+
+// newInfoServer returns a stub of the info API of a node replying to
+// info.getNodeID and info.getNodeIP with [results], or failing with
+// [status] if it isn't 200.
+func newInfoServer(t *testing.T, status int, results map[string]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpcRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			_, _ = io.WriteString(w, "not whitelisted")
+			return
+		}
+		result, ok := results[req.Method]
+		if !ok {
+			_, _ = io.WriteString(w, `{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"jsonrpc":"2.0","id":1,"result":`+result+`}`)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func healthyResults() map[string]string {
+	return map[string]string{
+		"info.getNodeID": `{"nodeID":"` + testNodeID + `","nodePOP":{}}`,
+		"info.getNodeIP": `{"ip":"` + testNodeIP + `"}`,
+	}
+}
+
+func TestCheckPeer(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		results     map[string]string
+		expectedIPs string
+		expectedIDs string
+		expectedErr error
+	}{
+		{
+			name:        "healthy",
+			status:      http.StatusOK,
+			results:     healthyResults(),
+			expectedIPs: testNodeIP,
+			expectedIDs: testNodeID,
+		},
+		{
+			name:   "IP list",
+			status: http.StatusOK,
+			results: map[string]string{
+				"info.getNodeID": `"` + testNodeID + `,` + testNodeID + `"`,
+				"info.getNodeIP": `{"ip":["` + testNodeIP + `","192.0.2.11:9651"]}`,
+			},
+			expectedIPs: testNodeIP + ",192.0.2.11:9651",
+			expectedIDs: testNodeID + "," + testNodeID,
+		},
+		{
+			name:        "bad status",
+			status:      http.StatusForbidden,
+			expectedErr: errBadStatus,
+		},
+		{
+			name:   "RPC error",
+			status: http.StatusOK,
+			results: map[string]string{
+				"info.getNodeID": `{"nodeID":"` + testNodeID + `"}`,
+			},
+			expectedErr: errRPC,
+		},
+		{
+			name:   "invalid node ID",
+			status: http.StatusOK,
+			results: map[string]string{
+				"info.getNodeID": `{"nodeID":""}`,
+				"info.getNodeIP": `{"ip":"` + testNodeIP + `"}`,
+			},
+			expectedErr: errUnhealthyPeer,
+		},
+		{
+			name:   "invalid IP",
+			status: http.StatusOK,
+			results: map[string]string{
+				"info.getNodeID": `{"nodeID":"` + testNodeID + `"}`,
+				"info.getNodeIP": `{"ip":"192.0.2.10"}`,
+			},
+			expectedErr: errUnhealthyPeer,
+		},
+		{
+			name:   "mismatched lists",
+			status: http.StatusOK,
+			results: map[string]string{
+				"info.getNodeID": `{"nodeID":"` + testNodeID + `"}`,
+				"info.getNodeIP": `{"ip":["` + testNodeIP + `","192.0.2.11:9651"]}`,
+			},
+			expectedErr: errUnhealthyPeer,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			server := newInfoServer(t, test.status, test.results)
+			peer, err := checkPeer(context.Background(), server.Client(), server.URL)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(&bootstrapPeer{
+				Endpoint: server.URL,
+				IPs:      test.expectedIPs,
+				IDs:      test.expectedIDs,
+			}, peer)
+		})
+	}
+}
+
+func TestBootstrapSelectorFallback(t *testing.T) {
+	require := require.New(t)
+
+	unreachable := newInfoServer(t, http.StatusOK, nil)
+	unreachable.Close()
+	forbidden := newInfoServer(t, http.StatusForbidden, nil)
+	healthy := newInfoServer(t, http.StatusOK, healthyResults())
+
+	selector := &bootstrapSelector{
+		log:       log.New(io.Discard, "", 0),
+		client:    http.DefaultClient,
+		endpoints: []string{unreachable.URL, forbidden.URL, healthy.URL},
+	}
+	peer, err := selector.Select(context.Background())
+	require.NoError(err)
+	require.Equal(healthy.URL, peer.Endpoint)
+}
+
+func TestBootstrapSelectorRetry(t *testing.T) {
+	require := require.New(t)
+
+	// The endpoint becomes healthy on the third attempt
+	var requests atomic.Int32
+	results := healthyResults()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		var req rpcRequest
+		require.NoError(json.NewDecoder(r.Body).Decode(&req))
+		_, _ = io.WriteString(w, `{"jsonrpc":"2.0","id":1,"result":`+results[req.Method]+`}`)
+	}))
+	defer server.Close()
+
+	newSelector := func(retries int) *bootstrapSelector {
+		return &bootstrapSelector{
+			log:       log.New(io.Discard, "", 0),
+			client:    server.Client(),
+			endpoints: []string{server.URL},
+			retries:   retries,
+			backoff: backoff{
+				initial: time.Millisecond,
+				max:     10 * time.Millisecond,
+			},
+		}
+	}
+
+	_, err := newSelector(1).Select(context.Background())
+	require.ErrorIs(err, errNoBootstrapPeer)
+
+	requests.Store(0)
+	peer, err := newSelector(2).Select(context.Background())
+	require.NoError(err)
+	require.Equal(testNodeID, peer.IDs)
+}
+
+func TestBootstrapSelectorCanceled(t *testing.T) {
+	require := require.New(t)
+
+	server := newInfoServer(t, http.StatusServiceUnavailable, nil)
+	selector := &bootstrapSelector{
+		log:       log.New(io.Discard, "", 0),
+		client:    server.Client(),
+		endpoints: []string{server.URL},
+		retries:   10,
+		backoff: backoff{
+			initial: time.Hour,
+			max:     time.Hour,
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := selector.Select(ctx)
+	require.ErrorIs(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,177 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultAvalancheGoPath = "/app/build/avalanchego"
+	defaultTraceURL        = "https://flare.network/cdn-cgi/trace"
+)
+
+var errInvalidEnv = errors.New("invalid environment variable")
+
+// config is the configuration of the entrypoint, read from environment
+// variables (see README-docker.md).
+type config struct {
+	AvalancheGoPath string
+	NodeConfigFile  string
+	ExtraArguments  []string
+
+	// Node is the configuration of avalanchego given by the environment, to
+	// which the public IP and the bootstrap peers are added.
+	Node map[string]any
+
+	PublicIP         string
+	PublicIPResolver string
+	TraceURL         string
+	UPnPControlURL   string
+
+	AutoconfigureBootstrap bool
+	BootstrapEndpoints     []string
+	BootstrapIPs           string
+	BootstrapIDs           string
+	BootstrapRetries       int
+	BootstrapRetryDelay    time.Duration
+
+	RestartOnCrash     bool
+	RestartMaxAttempts int
+	RestartDelay       time.Duration
+	RestartMaxDelay    time.Duration
+	StopTimeout        time.Duration
+}
+
+// loadConfig reads the configuration of the entrypoint with [getenv].
+func loadConfig(getenv func(string) string) (*config, error) {
+	e := &env{getenv: getenv}
+	c := &config{
+		AvalancheGoPath: e.String("AVALANCHEGO_PATH", defaultAvalancheGoPath),
+		NodeConfigFile:  e.String("NODE_CONFIG_FILE", filepath.Join(os.TempDir(), "avalanchego.json")),
+		ExtraArguments:  strings.Fields(e.String("EXTRA_ARGUMENTS", "")),
+		Node:            make(map[string]any),
+
+		PublicIP:         e.String("PUBLIC_IP", ""),
+		PublicIPResolver: e.String("PUBLIC_IP_RESOLVER", ""),
+		TraceURL:         e.String("PUBLIC_IP_TRACE_URL", defaultTraceURL),
+		UPnPControlURL:   e.String("UPNP_CONTROL_URL", ""),
+
+		AutoconfigureBootstrap: e.Bool("AUTOCONFIGURE_BOOTSTRAP", false),
+		BootstrapEndpoints:     e.List("AUTOCONFIGURE_BOOTSTRAP_ENDPOINT"),
+		BootstrapIPs:           e.String("BOOTSTRAP_IPS", ""),
+		BootstrapIDs:           e.String("BOOTSTRAP_IDS", ""),
+		BootstrapRetries:       e.Int("AUTOCONFIGURE_BOOTSTRAP_RETRIES", 5),
+		BootstrapRetryDelay:    e.Duration("AUTOCONFIGURE_BOOTSTRAP_RETRY_DELAY", 2*time.Second),
+
+		RestartOnCrash:     e.Bool("RESTART_ON_CRASH", true),
+		RestartMaxAttempts: e.Int("RESTART_MAX_ATTEMPTS", 10),
+		RestartDelay:       e.Duration("RESTART_DELAY", time.Second),
+		RestartMaxDelay:    e.Duration("RESTART_MAX_DELAY", time.Minute),
+		StopTimeout:        e.Duration("STOP_TIMEOUT", time.Minute),
+	}
+	c.BootstrapEndpoints = append(c.BootstrapEndpoints, e.List("AUTOCONFIGURE_FALLBACK_ENDPOINTS")...)
+
+	// AUTOCONFIGURE_PUBLIC_IP=1 was the only way to discover the public IP
+	// before PUBLIC_IP_RESOLVER was added.
+	if c.PublicIPResolver == "" && e.Bool("AUTOCONFIGURE_PUBLIC_IP", false) {
+		c.PublicIPResolver = httpResolverName
+	}
+
+	for _, key := range []string{"http-host", "db-dir", "db-type", "chain-config-dir", "log-dir", "log-level", "network-id"} {
+		if value := e.String(envName(key), ""); value != "" {
+			c.Node[key] = value
+		}
+	}
+	for _, key := range []string{"http-port", "staking-port"} {
+		if port := e.Int(envName(key), 0); port != 0 {
+			c.Node[key] = port
+		}
+	}
+	if timeout := e.Duration("BOOTSTRAP_BEACON_CONNECTION_TIMEOUT", 0); timeout != 0 {
+		c.Node["bootstrap-beacon-connection-timeout"] = timeout.String()
+	}
+	if hosts := e.List("HTTP_ALLOWED_HOSTS"); len(hosts) != 0 {
+		c.Node["http-allowed-hosts"] = hosts
+	}
+	return c, e.Err()
+}
+
+// envName returns the environment variable setting the avalanchego flag [key].
+func envName(key string) string {
+	return strings.ToUpper(strings.ReplaceAll(key, "-", "_"))
+}
+
+// env parses environment variables, collecting the errors.
+type env struct {
+	getenv func(string) string
+	errs   []error
+}
+
+func (e *env) String(key, defaultValue string) string {
+	if value := strings.TrimSpace(e.getenv(key)); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func (e *env) Bool(key string, defaultValue bool) bool {
+	value := e.String(key, "")
+	if value == "" {
+		return defaultValue
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%w %s=%q: expected 0 or 1", errInvalidEnv, key, value))
+		return defaultValue
+	}
+	return b
+}
+
+func (e *env) Int(key string, defaultValue int) int {
+	value := e.String(key, "")
+	if value == "" {
+		return defaultValue
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil || i < 0 {
+		e.errs = append(e.errs, fmt.Errorf("%w %s=%q: expected a non-negative integer", errInvalidEnv, key, value))
+		return defaultValue
+	}
+	return i
+}
+
+func (e *env) Duration(key string, defaultValue time.Duration) time.Duration {
+	value := e.String(key, "")
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		e.errs = append(e.errs, fmt.Errorf("%w %s=%q: expected a duration such as 45s or 5m", errInvalidEnv, key, value))
+		return defaultValue
+	}
+	return d
+}
+
+// List returns the non-empty elements of the comma separated list [key].
+func (e *env) List(key string) []string {
+	var list []string
+	for _, value := range strings.Split(e.String(key, ""), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+func (e *env) Err() error {
+	return errors.Join(e.errs...)
+}
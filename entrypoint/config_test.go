@@ -0,0 +1,161 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"encoding/json"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// dockerEnv are the environment variables set by the Dockerfiles.
+var dockerEnv = map[string]string{
+	"HTTP_HOST":                           "0.0.0.0",
+	"HTTP_PORT":                           "9650",
+	"STAKING_PORT":                        "9651",
+	"PUBLIC_IP":                           "",
+	"DB_DIR":                              "/app/db",
+	"DB_TYPE":                             "leveldb",
+	"BOOTSTRAP_IPS":                       "",
+	"BOOTSTRAP_IDS":                       "",
+	"CHAIN_CONFIG_DIR":                    "/app/conf",
+	"LOG_DIR":                             "/app/logs",
+	"LOG_LEVEL":                           "info",
+	"NETWORK_ID":                          "costwo",
+	"AUTOCONFIGURE_PUBLIC_IP":             "1",
+	"AUTOCONFIGURE_BOOTSTRAP":             "1",
+	"AUTOCONFIGURE_BOOTSTRAP_ENDPOINT":    "https://coston2-bootstrap.flare.network/ext/info",
+	"AUTOCONFIGURE_FALLBACK_ENDPOINTS":    "https://a.example/ext/info, https://b.example/ext/info",
+	"EXTRA_ARGUMENTS":                     "--log-display-level=warn  --index-enabled",
+	"BOOTSTRAP_BEACON_CONNECTION_TIMEOUT": "1m",
+	"HTTP_ALLOWED_HOSTS":                  "*",
+}
+
+func getenv(env map[string]string) func(string) string {
+	return func(key string) string {
+		return env[key]
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	require := require.New(t)
+
+	c, err := loadConfig(getenv(dockerEnv))
+	require.NoError(err)
+	require.Equal(defaultAvalancheGoPath, c.AvalancheGoPath)
+	require.Equal([]string{"--log-display-level=warn", "--index-enabled"}, c.ExtraArguments)
+	require.Equal(httpResolverName, c.PublicIPResolver)
+	require.True(c.AutoconfigureBootstrap)
+	require.Equal([]string{
+		"https://coston2-bootstrap.flare.network/ext/info",
+		"https://a.example/ext/info",
+		"https://b.example/ext/info",
+	}, c.BootstrapEndpoints)
+	require.True(c.RestartOnCrash)
+	require.Equal(map[string]any{
+		"http-host":                           "0.0.0.0",
+		"http-port":                           9650,
+		"staking-port":                        9651,
+		"db-dir":                              "/app/db",
+		"db-type":                             "leveldb",
+		"chain-config-dir":                    "/app/conf",
+		"log-dir":                             "/app/logs",
+		"log-level":                           "info",
+		"network-id":                          "costwo",
+		"bootstrap-beacon-connection-timeout": "1m0s",
+		"http-allowed-hosts":                  []string{"*"},
+	}, c.Node)
+}
+
+func TestLoadConfigInvalid(t *testing.T) {
+	require := require.New(t)
+
+	_, err := loadConfig(getenv(map[string]string{
+		"HTTP_PORT":                           "http",
+		"BOOTSTRAP_BEACON_CONNECTION_TIMEOUT": "1 minute",
+		"RESTART_ON_CRASH":                    "yes",
+	}))
+	require.ErrorIs(err, errInvalidEnv)
+	require.ErrorContains(err, "HTTP_PORT")
+	require.ErrorContains(err, "BOOTSTRAP_BEACON_CONNECTION_TIMEOUT")
+	require.ErrorContains(err, "RESTART_ON_CRASH")
+}
+
+func TestWriteNodeConfig(t *testing.T) {
+	c, err := loadConfig(getenv(map[string]string{
+		"NODE_CONFIG_FILE": filepath.Join(t.TempDir(), "conf", "node.json"),
+		"NETWORK_ID":       "flare",
+		"BOOTSTRAP_IPS":    "192.0.2.1:9651",
+		"BOOTSTRAP_IDS":    testNodeID,
+		"EXTRA_ARGUMENTS":  "--network-id=costwo",
+	}))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		publicIP netip.Addr
+		peer     *bootstrapPeer
+		expected map[string]any
+	}{
+		{
+			name: "bootstrap peers from the environment",
+			expected: map[string]any{
+				"network-id":    "flare",
+				"bootstrap-ips": "192.0.2.1:9651",
+				"bootstrap-ids": testNodeID,
+			},
+		},
+		{
+			name:     "discovered",
+			publicIP: netip.MustParseAddr("203.0.113.7"),
+			peer: &bootstrapPeer{
+				IPs: testNodeIP,
+				IDs: testNodeID,
+			},
+			expected: map[string]any{
+				"network-id":    "flare",
+				"public-ip":     "203.0.113.7",
+				"bootstrap-ips": testNodeIP,
+				"bootstrap-ids": testNodeID,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			args, err := writeNodeConfig(c, nodeConfig(c, test.publicIP, test.peer))
+			require.NoError(err)
+			require.Equal([]string{"--config-file=" + c.NodeConfigFile, "--network-id=costwo"}, args)
+
+			bytes, err := os.ReadFile(c.NodeConfigFile)
+			require.NoError(err)
+			var written map[string]any
+			require.NoError(json.Unmarshal(bytes, &written))
+			require.Equal(test.expected, written)
+		})
+	}
+
+	// The settings of the environment aren't modified
+	require.Equal(t, map[string]any{"network-id": "flare"}, c.Node)
+}
+
+func TestBackoff(t *testing.T) {
+	require := require.New(t)
+
+	b := backoff{
+		initial: time.Second,
+		max:     5 * time.Second,
+	}
+	for _, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		require.Equal(expected, b.Next())
+	}
+	b.Reset()
+	require.Equal(time.Second, b.Next())
+}
@@ -0,0 +1,52 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/netip"
+	"os"
+	"path/filepath"
+)
+
+const perms = 0o644
+
+// nodeConfig returns the content of the config file of avalanchego: the
+// settings of [c] with the public IP and the bootstrap peers, which are
+// omitted if not known so that the defaults of the node apply.
+func nodeConfig(c *config, publicIP netip.Addr, peer *bootstrapPeer) map[string]any {
+	nodeConfig := maps.Clone(c.Node)
+	if publicIP.IsValid() {
+		nodeConfig["public-ip"] = publicIP.String()
+	}
+
+	ips, ids := c.BootstrapIPs, c.BootstrapIDs
+	if peer != nil {
+		ips, ids = peer.IPs, peer.IDs
+	}
+	if ips != "" || ids != "" {
+		nodeConfig["bootstrap-ips"] = ips
+		nodeConfig["bootstrap-ids"] = ids
+	}
+	return nodeConfig
+}
+
+// writeNodeConfig writes [nodeConfig] to the node config file of [c] and
+// returns the arguments of avalanchego using it. The extra arguments of [c]
+// come last, so they override the config file.
+func writeNodeConfig(c *config, nodeConfig map[string]any) ([]string, error) {
+	bytes, err := json.MarshalIndent(nodeConfig, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.NodeConfigFile), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create directory of the node config: %w", err)
+	}
+	if err := os.WriteFile(c.NodeConfigFile, bytes, perms); err != nil {
+		return nil, fmt.Errorf("failed to write node config: %w", err)
+	}
+	return append([]string{"--config-file=" + c.NodeConfigFile}, c.ExtraArguments...), nil
+}
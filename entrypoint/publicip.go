@@ -0,0 +1,174 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+const (
+	httpResolverName   = "http"
+	upnpResolverName   = "upnp"
+	staticResolverName = "static"
+
+	upnpService = "urn:schemas-upnp-org:service:WANIPConnection:1"
+)
+
+var (
+	errUnknownResolver = errors.New("unknown public IP resolver")
+	errNoIP            = errors.New("no IP in response")
+
+	upnpRequest = []byte(`<?xml version="1.0"?>` +
+		`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/" s:encodingStyle="http://schemas.xmlsoap.org/soap/encoding/">` +
+		`<s:Body><u:GetExternalIPAddress xmlns:u="` + upnpService + `"/></s:Body>` +
+		`</s:Envelope>`)
+)
+
+// ipResolver discovers the public IP of the node.
+type ipResolver interface {
+	ResolveIP(ctx context.Context) (netip.Addr, error)
+}
+
+// newIPResolver returns the resolver of the public IP selected by [c], or nil
+// if the public IP is left to avalanchego. PUBLIC_IP takes precedence over the
+// discovery of the IP.
+func newIPResolver(c *config, client *http.Client) (ipResolver, error) {
+	if c.PublicIP != "" {
+		return newStaticResolver(c.PublicIP)
+	}
+	switch c.PublicIPResolver {
+	case "":
+		return nil, nil
+	case staticResolverName:
+		return nil, fmt.Errorf("%w: PUBLIC_IP is required by the %s resolver", errInvalidEnv, staticResolverName)
+	case httpResolverName:
+		return &httpResolver{url: c.TraceURL, client: client}, nil
+	case upnpResolverName:
+		if c.UPnPControlURL == "" {
+			return nil, fmt.Errorf("%w: UPNP_CONTROL_URL is required by the %s resolver", errInvalidEnv, upnpResolverName)
+		}
+		return &upnpResolver{controlURL: c.UPnPControlURL, client: client}, nil
+	default:
+		return nil, fmt.Errorf("%w %q, expected one of %s, %s, %s",
+			errUnknownResolver,
+			c.PublicIPResolver,
+			httpResolverName,
+			upnpResolverName,
+			staticResolverName,
+		)
+	}
+}
+
+// staticResolver returns the IP it was created with.
+type staticResolver netip.Addr
+
+func newStaticResolver(ip string) (ipResolver, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil, fmt.Errorf("%w PUBLIC_IP=%q: %w", errInvalidEnv, ip, err)
+	}
+	return staticResolver(addr), nil
+}
+
+func (r staticResolver) ResolveIP(context.Context) (netip.Addr, error) {
+	return netip.Addr(r), nil
+}
+
+// httpResolver reads the IP the request was made from in the response of a
+// web service, which is either a Cloudflare trace (an "ip=" line) or the bare
+// IP, as returned by services such as https://checkip.amazonaws.com.
+type httpResolver struct {
+	url    string
+	client *http.Client
+}
+
+func (r *httpResolver) ResolveIP(ctx context.Context) (netip.Addr, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	body, err := doRequest(r.client, req)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if addr, err := netip.ParseAddr(strings.TrimPrefix(line, "ip=")); err == nil {
+			return addr, nil
+		}
+	}
+	return netip.Addr{}, fmt.Errorf("%w from %s", errNoIP, r.url)
+}
+
+// upnpResolver asks the Internet gateway of the node for its external IP with
+// the GetExternalIPAddress action of the WANIPConnection service.
+//
+// The gateway isn't discovered with SSDP, which doesn't work from inside a
+// container network: the control URL of the service must be given.
+type upnpResolver struct {
+	controlURL string
+	client     *http.Client
+}
+
+func (r *upnpResolver) ResolveIP(ctx context.Context) (netip.Addr, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.controlURL, bytes.NewReader(upnpRequest))
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	req.Header.Set("Content-Type", `text/xml; charset="utf-8"`)
+	req.Header.Set("SOAPAction", `"`+upnpService+`#GetExternalIPAddress"`)
+	body, err := doRequest(r.client, req)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	var envelope struct {
+		Body struct {
+			Response struct {
+				IP string `xml:"NewExternalIPAddress"`
+			} `xml:"GetExternalIPAddressResponse"`
+		} `xml:"Body"`
+	}
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return netip.Addr{}, fmt.Errorf("failed to decode response of %s: %w", r.controlURL, err)
+	}
+	ip := strings.TrimSpace(envelope.Body.Response.IP)
+	if ip == "" {
+		return netip.Addr{}, fmt.Errorf("%w from %s", errNoIP, r.controlURL)
+	}
+	return netip.ParseAddr(ip)
+}
+
+// doRequest returns the body of the response to [req], or an error including
+// the start of the body if the status isn't 200.
+func doRequest(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		if len(body) > maxErrorBodySize {
+			body = body[:maxErrorBodySize]
+		}
+		return nil, fmt.Errorf("%w: HTTP %d from %s, response body: %q", errBadStatus, resp.StatusCode, req.URL, body)
+	}
+	return body, nil
+}
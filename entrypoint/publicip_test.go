@@ -0,0 +1,167 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPResolver(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		expectedIP  netip.Addr
+		expectedErr error
+	}{
+		{
+			name:       "trace",
+			status:     http.StatusOK,
+			body:       "fl=123\nh=flare.network\nip=203.0.113.7\nts=1700000000.000\n",
+			expectedIP: netip.MustParseAddr("203.0.113.7"),
+		},
+		{
+			name:       "bare IP",
+			status:     http.StatusOK,
+			body:       "2001:db8::1\n",
+			expectedIP: netip.MustParseAddr("2001:db8::1"),
+		},
+		{
+			name:        "no IP",
+			status:      http.StatusOK,
+			body:        "fl=123\nh=flare.network\n",
+			expectedErr: errNoIP,
+		},
+		{
+			name:        "bad status",
+			status:      http.StatusServiceUnavailable,
+			body:        "ip=203.0.113.7",
+			expectedErr: errBadStatus,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(http.MethodGet, r.Method)
+				w.WriteHeader(test.status)
+				_, _ = io.WriteString(w, test.body)
+			}))
+			defer server.Close()
+
+			resolver := &httpResolver{url: server.URL, client: server.Client()}
+			ip, err := resolver.ResolveIP(context.Background())
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedIP, ip)
+		})
+	}
+}
+
+func TestUPnPResolver(t *testing.T) {
+	require := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(http.MethodPost, r.Method)
+		require.Equal(`"`+upnpService+`#GetExternalIPAddress"`, r.Header.Get("SOAPAction"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(err)
+		require.Equal(upnpRequest, body)
+
+		_, _ = io.WriteString(w, `<?xml version="1.0"?>
+<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/" s:encodingStyle="http://schemas.xmlsoap.org/soap/encoding/">
+  <s:Body>
+    <u:GetExternalIPAddressResponse xmlns:u="urn:schemas-upnp-org:service:WANIPConnection:1">
+      <NewExternalIPAddress>198.51.100.20</NewExternalIPAddress>
+    </u:GetExternalIPAddressResponse>
+  </s:Body>
+</s:Envelope>`)
+	}))
+	defer server.Close()
+
+	resolver := &upnpResolver{controlURL: server.URL, client: server.Client()}
+	ip, err := resolver.ResolveIP(context.Background())
+	require.NoError(err)
+	require.Equal(netip.MustParseAddr("198.51.100.20"), ip)
+}
+
+func TestNewIPResolver(t *testing.T) {
+	tests := []struct {
+		name             string
+		config           config
+		expectedResolver ipResolver
+		expectedErr      error
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "public IP",
+			config: config{
+				PublicIP: "192.0.2.1",
+			},
+			expectedResolver: staticResolver(netip.MustParseAddr("192.0.2.1")),
+		},
+		{
+			name: "public IP overrides resolver",
+			config: config{
+				PublicIP:         "192.0.2.1",
+				PublicIPResolver: httpResolverName,
+			},
+			expectedResolver: staticResolver(netip.MustParseAddr("192.0.2.1")),
+		},
+		{
+			name: "invalid public IP",
+			config: config{
+				PublicIP: "192.0.2",
+			},
+			expectedErr: errInvalidEnv,
+		},
+		{
+			name: "static without public IP",
+			config: config{
+				PublicIPResolver: staticResolverName,
+			},
+			expectedErr: errInvalidEnv,
+		},
+		{
+			name: "http",
+			config: config{
+				PublicIPResolver: httpResolverName,
+				TraceURL:         defaultTraceURL,
+			},
+			expectedResolver: &httpResolver{url: defaultTraceURL, client: http.DefaultClient},
+		},
+		{
+			name: "upnp without control URL",
+			config: config{
+				PublicIPResolver: upnpResolverName,
+			},
+			expectedErr: errInvalidEnv,
+		},
+		{
+			name: "unknown",
+			config: config{
+				PublicIPResolver: "stun",
+			},
+			expectedErr: errUnknownResolver,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			resolver, err := newIPResolver(&test.config, http.DefaultClient)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedResolver, resolver)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os/exec"
+	"syscall"
+	"time"
+)
+
+// supervisor runs avalanchego until the context is done, restarting it with
+// exponential backoff if it crashes. When the context is done, SIGTERM is
+// forwarded to the node, which is killed if it hasn't stopped after
+// stopTimeout.
+type supervisor struct {
+	log *log.Logger
+	// newCmd returns the command to run. It is called before every start, so
+	// that the configuration of the node is refreshed when it restarts.
+	newCmd func(ctx context.Context) (*exec.Cmd, error)
+
+	restartOnCrash bool
+	// maxRestarts is the number of consecutive restarts after which a crash
+	// is returned, or zero for no limit.
+	maxRestarts int
+	backoff     backoff
+	// A node running for longer than resetAfter didn't crash on startup, so
+	// the count of restarts and the backoff are reset when it exits.
+	resetAfter  time.Duration
+	stopTimeout time.Duration
+}
+
+// Run returns the exit code of the last run of the node.
+func (s *supervisor) Run(ctx context.Context) (int, error) {
+	restarts := 0
+	for {
+		cmd, err := s.newCmd(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		start := time.Now()
+		code, err := s.runOnce(ctx, cmd)
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case ctx.Err() != nil:
+			s.log.Printf("avalanchego stopped with code %d", code)
+			return code, nil
+		case code == 0:
+			s.log.Print("avalanchego exited")
+			return code, nil
+		case !s.restartOnCrash:
+			s.log.Printf("avalanchego crashed with code %d", code)
+			return code, nil
+		}
+
+		if time.Since(start) >= s.resetAfter {
+			restarts = 0
+			s.backoff.Reset()
+		}
+		if s.maxRestarts > 0 && restarts >= s.maxRestarts {
+			s.log.Printf("avalanchego crashed with code %d after %d restarts, giving up", code, restarts)
+			return code, nil
+		}
+		restarts++
+		delay := s.backoff.Next()
+		s.log.Printf("avalanchego crashed with code %d, restarting in %s", code, delay)
+		if err := sleep(ctx, delay); err != nil {
+			return code, nil
+		}
+	}
+}
+
+func (s *supervisor) runOnce(ctx context.Context, cmd *exec.Cmd) (int, error) {
+	s.log.Printf("Starting %s", cmd)
+	if err := cmd.Start(); err != nil {
+		return 0, err
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	select {
+	case err := <-done:
+		return exitCode(err)
+	case <-ctx.Done():
+	}
+
+	s.log.Print("Forwarding SIGTERM to avalanchego")
+	if err := cmd.Process.Signal(syscall.SIGTERM); err != nil {
+		s.log.Printf("Failed to signal avalanchego: %v", err)
+	}
+	timer := time.NewTimer(s.stopTimeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-done:
+		return exitCode(err)
+	case <-timer.C:
+		s.log.Printf("avalanchego didn't stop within %s, killing it", s.stopTimeout)
+		if err := cmd.Process.Kill(); err != nil {
+			s.log.Printf("Failed to kill avalanchego: %v", err)
+		}
+		return exitCode(<-done)
+	}
+}
+
+// exitCode returns the exit code of a process that returned [err] from Wait,
+// which is 128 plus the signal number if it was killed by a signal, as in
+// shells.
+func exitCode(err error) (int, error) {
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return 0, err
+	}
+	if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return 128 + int(status.Signal()), nil
+	}
+	return exitErr.ExitCode(), nil
+}
@@ -0,0 +1,192 @@
+// (c) 2025, Flare Networks Limited. All rights reserved.
+// Please see the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The test binary stands in for avalanchego when this variable is set to one
+// of the modes below.
+const (
+	processModeEnv   = "ENTRYPOINT_TEST_PROCESS"
+	processReadyEnv  = "ENTRYPOINT_TEST_READY_FILE"
+	crashMode        = "crash"
+	exitMode         = "exit"
+	stopOnTermMode   = "stop-on-term"
+	ignoreTermMode   = "ignore-term"
+	crashExitCode    = 3
+	stoppedExitCode  = 4
+	testStopDeadline = 10 * time.Second
+)
+
+func TestMain(m *testing.M) {
+	mode := os.Getenv(processModeEnv)
+	if mode == "" {
+		os.Exit(m.Run())
+	}
+
+	switch mode {
+	case crashMode:
+		os.Exit(crashExitCode)
+	case exitMode:
+		os.Exit(0)
+	case stopOnTermMode, ignoreTermMode:
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGTERM)
+		if err := os.WriteFile(os.Getenv(processReadyEnv), nil, perms); err != nil {
+			os.Exit(1)
+		}
+		<-signals
+		if mode == stopOnTermMode {
+			os.Exit(stoppedExitCode)
+		}
+		time.Sleep(time.Hour)
+	}
+	os.Exit(1)
+}
+
+// testProcess returns a command running the test binary in [mode].
+func testProcess(mode string, readyFile string) *exec.Cmd {
+	cmd := exec.Command(os.Args[0])
+	cmd.Env = append(os.Environ(), processModeEnv+"="+mode, processReadyEnv+"="+readyFile)
+	return cmd
+}
+
+func newTestSupervisor(modes ...string) (*supervisor, *int) {
+	starts := 0
+	return &supervisor{
+		log: log.New(io.Discard, "", 0),
+		newCmd: func(context.Context) (*exec.Cmd, error) {
+			mode := modes[min(starts, len(modes)-1)]
+			starts++
+			return testProcess(mode, ""), nil
+		},
+		restartOnCrash: true,
+		backoff: backoff{
+			initial: time.Millisecond,
+			max:     10 * time.Millisecond,
+		},
+		resetAfter:  time.Hour,
+		stopTimeout: testStopDeadline,
+	}, &starts
+}
+
+func TestSupervisorRestartsOnCrash(t *testing.T) {
+	require := require.New(t)
+
+	s, starts := newTestSupervisor(crashMode, crashMode, exitMode)
+	code, err := s.Run(context.Background())
+	require.NoError(err)
+	require.Zero(code)
+	require.Equal(3, *starts)
+}
+
+func TestSupervisorMaxRestarts(t *testing.T) {
+	require := require.New(t)
+
+	s, starts := newTestSupervisor(crashMode)
+	s.maxRestarts = 2
+	code, err := s.Run(context.Background())
+	require.NoError(err)
+	require.Equal(crashExitCode, code)
+	require.Equal(3, *starts)
+}
+
+func TestSupervisorNoRestart(t *testing.T) {
+	require := require.New(t)
+
+	s, starts := newTestSupervisor(crashMode, exitMode)
+	s.restartOnCrash = false
+	code, err := s.Run(context.Background())
+	require.NoError(err)
+	require.Equal(crashExitCode, code)
+	require.Equal(1, *starts)
+}
+
+func TestSupervisorResetsBackoff(t *testing.T) {
+	require := require.New(t)
+
+	// Every run lasts longer than resetAfter, so the restarts aren't counted
+	s, starts := newTestSupervisor(crashMode, crashMode, crashMode, exitMode)
+	s.maxRestarts = 1
+	s.resetAfter = 0
+	code, err := s.Run(context.Background())
+	require.NoError(err)
+	require.Zero(code)
+	require.Equal(4, *starts)
+}
+
+func TestSupervisorStartFailure(t *testing.T) {
+	require := require.New(t)
+
+	s, _ := newTestSupervisor(exitMode)
+	s.newCmd = func(context.Context) (*exec.Cmd, error) {
+		return exec.Command(filepath.Join(t.TempDir(), "avalanchego")), nil
+	}
+	_, err := s.Run(context.Background())
+	require.ErrorIs(err, os.ErrNotExist)
+}
+
+func TestSupervisorForwardsSIGTERM(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         string
+		stopTimeout  time.Duration
+		expectedCode int
+	}{
+		{
+			name:         "graceful stop",
+			mode:         stopOnTermMode,
+			stopTimeout:  testStopDeadline,
+			expectedCode: stoppedExitCode,
+		},
+		{
+			name:         "killed after timeout",
+			mode:         ignoreTermMode,
+			stopTimeout:  100 * time.Millisecond,
+			expectedCode: 128 + int(syscall.SIGKILL),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			readyFile := filepath.Join(t.TempDir(), "ready")
+			s, _ := newTestSupervisor(test.mode)
+			s.newCmd = func(context.Context) (*exec.Cmd, error) {
+				return testProcess(test.mode, readyFile), nil
+			}
+			s.stopTimeout = test.stopTimeout
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go func() {
+				// Stop the process once it handles SIGTERM
+				for {
+					if _, err := os.Stat(readyFile); err == nil {
+						cancel()
+						return
+					}
+					time.Sleep(10 * time.Millisecond)
+				}
+			}()
+
+			code, err := s.Run(ctx)
+			require.NoError(err)
+			require.Equal(test.expectedCode, code)
+		})
+	}
+}